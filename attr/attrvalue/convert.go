@@ -0,0 +1,627 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Convert returns the value converted to the target type, following the
+// conversion rules of Terraform, including conversions which may fail
+// depending on the value:
+//
+//   - bool and number values convert to string values.
+//   - string values convert to number values if they contain a number, and
+//     to bool values if they contain "true", "false", "1", or "0".
+//   - list, set, and tuple values convert to list and set values, if each
+//     element converts to the element type. Set values remove duplicate
+//     elements after conversion.
+//   - list and set values convert to tuple values of the same length, if each
+//     element converts to the tuple element type.
+//   - map and object values convert to map values, if each element or
+//     attribute converts to the element type.
+//   - object values convert to object values, if the object has all the
+//     attributes of the target type and each converts to the attribute type.
+//     Other attributes are removed.
+//   - map values convert to object values, if the map has exactly the
+//     attributes of the target type as keys and each element converts to the
+//     attribute type.
+//
+// Conversions apply recursively to nested values. Null and unknown values are
+// converted to null and unknown values of the target type. Values which
+// already have the target type are returned unchanged. Number values converted
+// to Int64 or Int32 types must be whole numbers within range.
+//
+// Custom types are supported through the basetypes Valuable and Typable
+// interfaces, such as basetypes.ListValuable and basetypes.ListTypable.
+// Collection values of custom target types are created with the ValueFrom
+// method of the type, such as ValueFromList, while primitive values of custom
+// target types are created with the ValueFromTerraform method of the type.
+//
+// Error diagnostics, with the path of the value which could not be converted,
+// are returned if the conversion fails. Use ConvertSafe to only allow
+// conversions which cannot fail.
+func Convert(ctx context.Context, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	c := converter{unsafe: true}
+
+	return c.convert(ctx, path.Empty(), value, target)
+}
+
+// ConvertSafe is Convert, but only allows the conversions of Terraform which
+// cannot fail depending on the value, such as a number value to a string
+// value. Conversions of string values to number or bool values, list or set
+// values to tuple values, and map values to object values are not allowed.
+func ConvertSafe(ctx context.Context, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	c := converter{unsafe: false}
+
+	return c.convert(ctx, path.Empty(), value, target)
+}
+
+// converter implements Convert and ConvertSafe.
+type converter struct {
+	// unsafe enables conversions which may fail depending on the value.
+	unsafe bool
+}
+
+func (c converter) convert(ctx context.Context, valuePath path.Path, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	if value == nil || target == nil {
+		return nil, diag.Diagnostics{
+			conversionErrorDiagnostic(valuePath, "missing value or target type"),
+		}
+	}
+
+	valueType := value.Type(ctx)
+
+	if valueType.Equal(target) {
+		return value, nil
+	}
+
+	targetTfType := target.TerraformType(ctx)
+	valueTfType := valueType.TerraformType(ctx)
+
+	if !c.convertible(valueTfType, targetTfType) {
+		return nil, diag.Diagnostics{
+			conversionErrorDiagnostic(valuePath, fmt.Sprintf("cannot convert %s to %s", typeName(valueTfType), typeName(targetTfType))),
+		}
+	}
+
+	if value.IsNull() {
+		return valueFromTerraform(ctx, valuePath, target, tftypes.NewValue(targetTfType, nil))
+	}
+
+	if value.IsUnknown() {
+		return valueFromTerraform(ctx, valuePath, target, tftypes.NewValue(targetTfType, tftypes.UnknownValue))
+	}
+
+	switch {
+	case targetTfType.Is(tftypes.List{}):
+		return c.convertToList(ctx, valuePath, value, target)
+	case targetTfType.Is(tftypes.Set{}):
+		return c.convertToSet(ctx, valuePath, value, target)
+	case targetTfType.Is(tftypes.Tuple{}):
+		return c.convertToTuple(ctx, valuePath, value, target)
+	case targetTfType.Is(tftypes.Map{}):
+		return c.convertToMap(ctx, valuePath, value, target)
+	case targetTfType.Is(tftypes.Object{}):
+		return c.convertToObject(ctx, valuePath, value, target)
+	}
+
+	return convertPrimitive(ctx, valuePath, value, target)
+}
+
+// convertible returns true if values of the Terraform type can be converted to
+// the target Terraform type, ignoring any element or attribute types.
+func (c converter) convertible(from tftypes.Type, to tftypes.Type) bool {
+	switch {
+	case to.Is(tftypes.String):
+		return from.Is(tftypes.String) || from.Is(tftypes.Number) || from.Is(tftypes.Bool)
+	case to.Is(tftypes.Number), to.Is(tftypes.Bool):
+		return from.Is(to) || (c.unsafe && from.Is(tftypes.String))
+	case to.Is(tftypes.List{}), to.Is(tftypes.Set{}):
+		return from.Is(tftypes.List{}) || from.Is(tftypes.Set{}) || from.Is(tftypes.Tuple{})
+	case to.Is(tftypes.Tuple{}):
+		return from.Is(tftypes.Tuple{}) || (c.unsafe && (from.Is(tftypes.List{}) || from.Is(tftypes.Set{})))
+	case to.Is(tftypes.Map{}):
+		return from.Is(tftypes.Map{}) || from.Is(tftypes.Object{})
+	case to.Is(tftypes.Object{}):
+		return from.Is(tftypes.Object{}) || (c.unsafe && from.Is(tftypes.Map{}))
+	}
+
+	return false
+}
+
+// convertPrimitive converts a known bool, number, or string value.
+func convertPrimitive(ctx context.Context, valuePath path.Path, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, diag.Diagnostics{
+			conversionErrorDiagnostic(valuePath, err.Error()),
+		}
+	}
+
+	targetTfType := target.TerraformType(ctx)
+
+	if tfValue.Type().Is(targetTfType) {
+		return valueFromTerraform(ctx, valuePath, target, tfValue)
+	}
+
+	var result tftypes.Value
+
+	switch {
+	case targetTfType.Is(tftypes.String):
+		var s string
+
+		switch {
+		case tfValue.Type().Is(tftypes.Number):
+			var f *big.Float
+
+			if err := tfValue.As(&f); err != nil {
+				return nil, diag.Diagnostics{conversionErrorDiagnostic(valuePath, err.Error())}
+			}
+
+			s = f.Text('f', -1)
+		case tfValue.Type().Is(tftypes.Bool):
+			var b bool
+
+			if err := tfValue.As(&b); err != nil {
+				return nil, diag.Diagnostics{conversionErrorDiagnostic(valuePath, err.Error())}
+			}
+
+			s = fmt.Sprintf("%t", b)
+		}
+
+		result = tftypes.NewValue(tftypes.String, s)
+	case targetTfType.Is(tftypes.Number):
+		var s string
+
+		if err := tfValue.As(&s); err != nil {
+			return nil, diag.Diagnostics{conversionErrorDiagnostic(valuePath, err.Error())}
+		}
+
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return nil, diag.Diagnostics{
+				conversionErrorDiagnostic(valuePath, fmt.Sprintf("a number is required, got string %q", s)),
+			}
+		}
+
+		result = tftypes.NewValue(tftypes.Number, f)
+	case targetTfType.Is(tftypes.Bool):
+		var s string
+
+		if err := tfValue.As(&s); err != nil {
+			return nil, diag.Diagnostics{conversionErrorDiagnostic(valuePath, err.Error())}
+		}
+
+		switch s {
+		case "true", "1":
+			result = tftypes.NewValue(tftypes.Bool, true)
+		case "false", "0":
+			result = tftypes.NewValue(tftypes.Bool, false)
+		default:
+			return nil, diag.Diagnostics{
+				conversionErrorDiagnostic(valuePath, fmt.Sprintf("a bool is required, got string %q", s)),
+			}
+		}
+	}
+
+	return valueFromTerraform(ctx, valuePath, target, result)
+}
+
+// convertToList converts a known list, set, or tuple value to a list type.
+func (c converter) convertToList(ctx context.Context, valuePath path.Path, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	elementType, diags := elementTypeOf(valuePath, target)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	elements, diags := c.convertElements(ctx, valuePath, value, func(int) attr.Type { return elementType })
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	listValue, listDiags := basetypes.NewListValue(elementType, elements)
+
+	diags.Append(withPath(valuePath, listDiags)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	typable, ok := target.(basetypes.ListTypable)
+
+	if !ok {
+		return listValue, diags
+	}
+
+	result, resultDiags := typable.ValueFromList(ctx, listValue)
+
+	diags.Append(withPath(valuePath, resultDiags)...)
+
+	return result, diags
+}
+
+// convertToSet converts a known list, set, or tuple value to a set type.
+func (c converter) convertToSet(ctx context.Context, valuePath path.Path, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	elementType, diags := elementTypeOf(valuePath, target)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	elements, diags := c.convertElements(ctx, valuePath, value, func(int) attr.Type { return elementType })
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	uniqueElements := make([]attr.Value, 0, len(elements))
+
+	for _, element := range elements {
+		duplicate := false
+
+		for _, uniqueElement := range uniqueElements {
+			if element.Equal(uniqueElement) {
+				duplicate = true
+
+				break
+			}
+		}
+
+		if !duplicate {
+			uniqueElements = append(uniqueElements, element)
+		}
+	}
+
+	setValue, setDiags := basetypes.NewSetValue(elementType, uniqueElements)
+
+	diags.Append(withPath(valuePath, setDiags)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	typable, ok := target.(basetypes.SetTypable)
+
+	if !ok {
+		return setValue, diags
+	}
+
+	result, resultDiags := typable.ValueFromSet(ctx, setValue)
+
+	diags.Append(withPath(valuePath, resultDiags)...)
+
+	return result, diags
+}
+
+// convertToTuple converts a known list, set, or tuple value to a tuple type.
+func (c converter) convertToTuple(ctx context.Context, valuePath path.Path, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	typeWithElementTypes, ok := target.(attr.TypeWithElementTypes)
+
+	if !ok {
+		return nil, diag.Diagnostics{
+			conversionErrorDiagnostic(valuePath, fmt.Sprintf("target type %s does not implement attr.TypeWithElementTypes", target)),
+		}
+	}
+
+	elementTypes := typeWithElementTypes.ElementTypes()
+
+	elements, diags := c.convertElements(ctx, valuePath, value, func(index int) attr.Type {
+		if index >= len(elementTypes) {
+			return nil
+		}
+
+		return elementTypes[index]
+	})
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(elements) != len(elementTypes) {
+		return nil, diag.Diagnostics{
+			conversionErrorDiagnostic(valuePath, fmt.Sprintf("a tuple of %d elements is required, got %d elements", len(elementTypes), len(elements))),
+		}
+	}
+
+	tupleValue, tupleDiags := basetypes.NewTupleValue(elementTypes, elements)
+
+	diags.Append(withPath(valuePath, tupleDiags)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	typable, ok := target.(basetypes.TupleTypable)
+
+	if !ok {
+		return tupleValue, diags
+	}
+
+	result, resultDiags := typable.ValueFromTuple(ctx, tupleValue)
+
+	diags.Append(withPath(valuePath, resultDiags)...)
+
+	return result, diags
+}
+
+// convertElements converts the elements of a known list, set, or tuple value
+// to the element type at each index.
+func (c converter) convertElements(ctx context.Context, valuePath path.Path, value attr.Value, elementType func(int) attr.Type) ([]attr.Value, diag.Diagnostics) {
+	nested, diags := nestedValues(ctx, valuePath, value)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	elements := make([]attr.Value, 0, len(nested))
+
+	for index, n := range nested {
+		typ := elementType(index)
+
+		// Tuple length mismatches are reported by the caller.
+		if typ == nil {
+			elements = append(elements, n.value)
+
+			continue
+		}
+
+		element, elementDiags := c.convert(ctx, n.path, n.value, typ)
+
+		diags.Append(elementDiags...)
+
+		if elementDiags.HasError() {
+			continue
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements, diags
+}
+
+// convertToMap converts a known map or object value to a map type.
+func (c converter) convertToMap(ctx context.Context, valuePath path.Path, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	elementType, diags := elementTypeOf(valuePath, target)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	nested, diags := nestedValues(ctx, valuePath, value)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	elements := make(map[string]attr.Value, len(nested))
+
+	for _, n := range nested {
+		element, elementDiags := c.convert(ctx, n.path, n.value, elementType)
+
+		diags.Append(elementDiags...)
+
+		if elementDiags.HasError() {
+			continue
+		}
+
+		elements[nestedKey(n.path)] = element
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	mapValue, mapDiags := basetypes.NewMapValue(elementType, elements)
+
+	diags.Append(withPath(valuePath, mapDiags)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	typable, ok := target.(basetypes.MapTypable)
+
+	if !ok {
+		return mapValue, diags
+	}
+
+	result, resultDiags := typable.ValueFromMap(ctx, mapValue)
+
+	diags.Append(withPath(valuePath, resultDiags)...)
+
+	return result, diags
+}
+
+// convertToObject converts a known map or object value to an object type.
+func (c converter) convertToObject(ctx context.Context, valuePath path.Path, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	typeWithAttributeTypes, ok := target.(attr.TypeWithAttributeTypes)
+
+	if !ok {
+		return nil, diag.Diagnostics{
+			conversionErrorDiagnostic(valuePath, fmt.Sprintf("target type %s does not implement attr.TypeWithAttributeTypes", target)),
+		}
+	}
+
+	attributeTypes := typeWithAttributeTypes.AttributeTypes()
+
+	nested, diags := nestedValues(ctx, valuePath, value)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	fromMap := value.Type(ctx).TerraformType(ctx).Is(tftypes.Map{})
+	nestedByKey := make(map[string]attr.Value, len(nested))
+
+	for _, n := range nested {
+		key := nestedKey(n.path)
+
+		if _, ok := attributeTypes[key]; !ok {
+			if fromMap {
+				diags.Append(conversionErrorDiagnostic(valuePath, fmt.Sprintf("unexpected map key %q, which is not an attribute of the object type", key)))
+			}
+
+			continue
+		}
+
+		nestedByKey[key] = n.value
+	}
+
+	attributes := make(map[string]attr.Value, len(attributeTypes))
+
+	for _, name := range sortedTypeKeys(attributeTypes) {
+		attributeValue, ok := nestedByKey[name]
+
+		if !ok {
+			diags.Append(conversionErrorDiagnostic(valuePath, fmt.Sprintf("attribute %q is required", name)))
+
+			continue
+		}
+
+		attributePath := valuePath.AtName(name)
+
+		if fromMap {
+			attributePath = valuePath.AtMapKey(name)
+		}
+
+		attribute, attributeDiags := c.convert(ctx, attributePath, attributeValue, attributeTypes[name])
+
+		diags.Append(attributeDiags...)
+
+		if attributeDiags.HasError() {
+			continue
+		}
+
+		attributes[name] = attribute
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	objectValue, objectDiags := basetypes.NewObjectValue(attributeTypes, attributes)
+
+	diags.Append(withPath(valuePath, objectDiags)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	typable, ok := target.(basetypes.ObjectTypable)
+
+	if !ok {
+		return objectValue, diags
+	}
+
+	result, resultDiags := typable.ValueFromObject(ctx, objectValue)
+
+	diags.Append(withPath(valuePath, resultDiags)...)
+
+	return result, diags
+}
+
+// elementTypeOf returns the element type of a list, map, or set type.
+func elementTypeOf(valuePath path.Path, target attr.Type) (attr.Type, diag.Diagnostics) {
+	typeWithElementType, ok := target.(attr.TypeWithElementType)
+
+	if !ok || typeWithElementType.ElementType() == nil {
+		return nil, diag.Diagnostics{
+			conversionErrorDiagnostic(valuePath, fmt.Sprintf("target type %s does not implement attr.TypeWithElementType with an element type", target)),
+		}
+	}
+
+	return typeWithElementType.ElementType(), nil
+}
+
+// nestedKey returns the attribute name or map key of the last path step.
+func nestedKey(p path.Path) string {
+	lastStep, _ := p.Steps().LastStep()
+
+	switch step := lastStep.(type) {
+	case path.PathStepAttributeName:
+		return string(step)
+	case path.PathStepElementKeyString:
+		return string(step)
+	}
+
+	return ""
+}
+
+// sortedTypeKeys returns the keys of the map in sorted order.
+func sortedTypeKeys(m map[string]attr.Type) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// valueFromTerraform returns the value of the target type for the Terraform
+// value.
+func valueFromTerraform(ctx context.Context, valuePath path.Path, target attr.Type, tfValue tftypes.Value) (attr.Value, diag.Diagnostics) {
+	result, err := target.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		return nil, diag.Diagnostics{
+			conversionErrorDiagnostic(valuePath, err.Error()),
+		}
+	}
+
+	return result, nil
+}
+
+// typeName returns the Terraform type name used in conversion errors.
+func typeName(t tftypes.Type) string {
+	switch {
+	case t.Is(tftypes.Bool):
+		return "bool"
+	case t.Is(tftypes.Number):
+		return "number"
+	case t.Is(tftypes.String):
+		return "string"
+	case t.Is(tftypes.List{}):
+		return "list"
+	case t.Is(tftypes.Map{}):
+		return "map"
+	case t.Is(tftypes.Object{}):
+		return "object"
+	case t.Is(tftypes.Set{}):
+		return "set"
+	case t.Is(tftypes.Tuple{}):
+		return "tuple"
+	}
+
+	return t.String()
+}
+
+// conversionErrorDiagnostic returns an error diagnostic for a value which
+// could not be converted.
+func conversionErrorDiagnostic(valuePath path.Path, reason string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		valuePath,
+		"Value Conversion Error",
+		"An unexpected error was encountered while converting a value. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			reason,
+	)
+}
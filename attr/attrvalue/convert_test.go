@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrvalue"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		target        attr.Type
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"same-type": {
+			value:    types.StringValue("test"),
+			target:   types.StringType,
+			expected: types.StringValue("test"),
+		},
+		"number-to-string": {
+			value:    types.NumberValue(bigFloat(1.5)),
+			target:   types.StringType,
+			expected: types.StringValue("1.5"),
+		},
+		"int64-to-string": {
+			value:    types.Int64Value(12),
+			target:   types.StringType,
+			expected: types.StringValue("12"),
+		},
+		"bool-to-string": {
+			value:    types.BoolValue(true),
+			target:   types.StringType,
+			expected: types.StringValue("true"),
+		},
+		"string-to-number": {
+			value:    types.StringValue("1.5"),
+			target:   types.NumberType,
+			expected: types.NumberValue(bigFloat(1.5)),
+		},
+		"string-to-int64": {
+			value:    types.StringValue("12"),
+			target:   types.Int64Type,
+			expected: types.Int64Value(12),
+		},
+		"string-to-int64-not-integer": {
+			value:  types.StringValue("1.5"),
+			target: types.Int64Type,
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), "Value %!s(*big.Float=1.5) is not an integer."),
+			},
+		},
+		"string-to-number-whitespace": {
+			value:  types.StringValue(" 1 "),
+			target: types.NumberType,
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), `a number is required, got string " 1 "`),
+			},
+		},
+		"string-to-number-trailing-newline": {
+			value:  types.StringValue("1\n"),
+			target: types.NumberType,
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), `a number is required, got string "1\n"`),
+			},
+		},
+		"string-to-number-invalid": {
+			value:  types.StringValue("one"),
+			target: types.NumberType,
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), `a number is required, got string "one"`),
+			},
+		},
+		"string-to-bool": {
+			value:    types.StringValue("1"),
+			target:   types.BoolType,
+			expected: types.BoolValue(true),
+		},
+		"string-to-bool-false": {
+			value:    types.StringValue("false"),
+			target:   types.BoolType,
+			expected: types.BoolValue(false),
+		},
+		"string-to-bool-invalid": {
+			value:  types.StringValue("yes"),
+			target: types.BoolType,
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), `a bool is required, got string "yes"`),
+			},
+		},
+		"bool-to-number": {
+			value:  types.BoolValue(true),
+			target: types.NumberType,
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), "cannot convert bool to number"),
+			},
+		},
+		"string-to-list": {
+			value:  types.StringValue("test"),
+			target: types.ListType{ElemType: types.StringType},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), "cannot convert string to list"),
+			},
+		},
+		"null": {
+			value:    types.StringNull(),
+			target:   types.NumberType,
+			expected: types.NumberNull(),
+		},
+		"unknown": {
+			value:    types.ListUnknown(types.StringType),
+			target:   types.SetType{ElemType: types.BoolType},
+			expected: types.SetUnknown(types.BoolType),
+		},
+		"list-to-set": {
+			value: types.ListValueMust(types.NumberType, []attr.Value{
+				types.NumberValue(bigFloat(1)),
+				types.NumberValue(bigFloat(2)),
+				types.NumberValue(bigFloat(1)),
+			}),
+			target: types.SetType{ElemType: types.StringType},
+			expected: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("1"),
+				types.StringValue("2"),
+			}),
+		},
+		"set-to-list": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+			}),
+			target: types.ListType{ElemType: types.StringType},
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+			}),
+		},
+		"tuple-to-list": {
+			value: types.TupleValueMust([]attr.Type{types.StringType, types.NumberType}, []attr.Value{
+				types.StringValue("one"),
+				types.NumberValue(bigFloat(2)),
+			}),
+			target: types.ListType{ElemType: types.StringType},
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("2"),
+			}),
+		},
+		"list-to-tuple": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("true"),
+			}),
+			target: types.TupleType{ElemTypes: []attr.Type{types.StringType, types.BoolType}},
+			expected: types.TupleValueMust([]attr.Type{types.StringType, types.BoolType}, []attr.Value{
+				types.StringValue("one"),
+				types.BoolValue(true),
+			}),
+		},
+		"list-to-tuple-length": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+			}),
+			target: types.TupleType{ElemTypes: []attr.Type{types.StringType, types.BoolType}},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), "a tuple of 2 elements is required, got 1 elements"),
+			},
+		},
+		"list-element-error": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("true"),
+				types.StringValue("yes"),
+			}),
+			target: types.ListType{ElemType: types.BoolType},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty().AtListIndex(1), `a bool is required, got string "yes"`),
+			},
+		},
+		"object-to-map": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{"a": types.StringType, "b": types.NumberType},
+				map[string]attr.Value{"a": types.StringValue("one"), "b": types.NumberValue(bigFloat(2))},
+			),
+			target: types.MapType{ElemType: types.StringType},
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue("one"),
+				"b": types.StringValue("2"),
+			}),
+		},
+		"map-to-object": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue("one"),
+				"b": types.StringValue("2"),
+			}),
+			target: types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.StringType, "b": types.NumberType}},
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{"a": types.StringType, "b": types.NumberType},
+				map[string]attr.Value{"a": types.StringValue("one"), "b": types.NumberValue(bigFloat(2))},
+			),
+		},
+		"map-to-object-missing-key": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue("one"),
+			}),
+			target: types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.StringType, "b": types.NumberType}},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), `attribute "b" is required`),
+			},
+		},
+		"map-to-object-extra-key": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue("one"),
+				"c": types.StringValue("three"),
+			}),
+			target: types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.StringType}},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), `unexpected map key "c", which is not an attribute of the object type`),
+			},
+		},
+		"object-to-object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{"a": types.NumberType, "extra": types.BoolType},
+				map[string]attr.Value{"a": types.NumberValue(bigFloat(1)), "extra": types.BoolValue(true)},
+			),
+			target: types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.StringType}},
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{"a": types.StringType},
+				map[string]attr.Value{"a": types.StringValue("1")},
+			),
+		},
+		"object-to-object-missing-attribute": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{"a": types.NumberType},
+				map[string]attr.Value{"a": types.NumberValue(bigFloat(1))},
+			),
+			target: types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.StringType, "b": types.StringType}},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), `attribute "b" is required`),
+			},
+		},
+		"nested": {
+			value: types.ListValueMust(
+				types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.NumberType}},
+				[]attr.Value{
+					types.ObjectValueMust(
+						map[string]attr.Type{"a": types.NumberType},
+						map[string]attr.Value{"a": types.NumberValue(bigFloat(1))},
+					),
+					types.ObjectValueMust(
+						map[string]attr.Type{"a": types.NumberType},
+						map[string]attr.Value{"a": types.NumberNull()},
+					),
+				},
+			),
+			target: types.SetType{ElemType: types.MapType{ElemType: types.StringType}},
+			expected: types.SetValueMust(
+				types.MapType{ElemType: types.StringType},
+				[]attr.Value{
+					types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("1")}),
+					types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringNull()}),
+				},
+			),
+		},
+		"nested-error-path": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}},
+				map[string]attr.Value{"list": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})},
+			),
+			target: types.ObjectType{AttrTypes: map[string]attr.Type{"list": types.ListType{ElemType: types.NumberType}}},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Root("list").AtListIndex(0), `a number is required, got string "one"`),
+			},
+		},
+		"custom-source": {
+			value: testtypes.ListValueWithSemanticEquals{
+				ListValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			target: types.SetType{ElemType: types.StringType},
+			expected: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+			}),
+		},
+		"custom-target-collection": {
+			value: types.SetValueMust(types.NumberType, []attr.Value{
+				types.NumberValue(bigFloat(1)),
+			}),
+			target: testtypes.ListTypeWithSemanticEquals{
+				ListType:       types.ListType{ElemType: types.StringType},
+				SemanticEquals: true,
+			},
+			expected: testtypes.ListValueWithSemanticEquals{
+				ListValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("1")}),
+				SemanticEquals: true,
+			},
+		},
+		"custom-target-primitive": {
+			value: types.NumberValue(bigFloat(1)),
+			target: testtypes.StringTypeWithSemanticEquals{
+				SemanticEquals: true,
+			},
+			expected: testtypes.StringValueWithSemanticEquals{
+				StringValue:    types.StringValue("1"),
+				SemanticEquals: true,
+			},
+		},
+		"custom-target-element": {
+			value: types.ListValueMust(types.NumberType, []attr.Value{
+				types.NumberValue(bigFloat(1)),
+			}),
+			target: types.ListType{ElemType: testtypes.StringTypeWithSemanticEquals{}},
+			expected: types.ListValueMust(testtypes.StringTypeWithSemanticEquals{}, []attr.Value{
+				testtypes.StringValueWithSemanticEquals{StringValue: types.StringValue("1")},
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := attrvalue.Convert(context.Background(), testCase.value, testCase.target)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestConvertSafe(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		target        attr.Type
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"number-to-string": {
+			value:    types.NumberValue(bigFloat(1)),
+			target:   types.StringType,
+			expected: types.StringValue("1"),
+		},
+		"string-to-number": {
+			value:  types.StringValue("1"),
+			target: types.NumberType,
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), "cannot convert string to number"),
+			},
+		},
+		"string-to-bool": {
+			value:  types.StringValue("true"),
+			target: types.BoolType,
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), "cannot convert string to bool"),
+			},
+		},
+		"list-to-set": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			target:   types.SetType{ElemType: types.StringType},
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+		},
+		"list-to-tuple": {
+			value:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			target: types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), "cannot convert list to tuple"),
+			},
+		},
+		"map-to-object": {
+			value:  types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("one")}),
+			target: types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.StringType}},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty(), "cannot convert map to object"),
+			},
+		},
+		"nested-unsafe": {
+			value:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("1")}),
+			target: types.ListType{ElemType: types.NumberType},
+			expectedDiags: diag.Diagnostics{
+				conversionError(path.Empty().AtListIndex(0), "cannot convert string to number"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := attrvalue.ConvertSafe(context.Background(), testCase.value, testCase.target)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func conversionError(p path.Path, reason string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Value Conversion Error",
+		"An unexpected error was encountered while converting a value. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			reason,
+	)
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DifferenceKind describes how a value differs.
+type DifferenceKind int
+
+const (
+	// DifferenceKindInvalid is used when a DifferenceKind is not set.
+	DifferenceKindInvalid DifferenceKind = 0
+
+	// DifferenceKindChanged is used when the values at a path are not
+	// equal, including when one of the values is null or unknown.
+	DifferenceKindChanged DifferenceKind = 1
+
+	// DifferenceKindAdded is used when a value, such as an object
+	// attribute, list element, map element, or set element, is only in the
+	// second value.
+	DifferenceKindAdded DifferenceKind = 2
+
+	// DifferenceKindRemoved is used when a value, such as an object
+	// attribute, list element, map element, or set element, is only in the
+	// first value.
+	DifferenceKindRemoved DifferenceKind = 3
+
+	// DifferenceKindType is used when the values at a path have different
+	// types.
+	DifferenceKindType DifferenceKind = 4
+)
+
+// Difference is a difference between two values at a path.
+type Difference struct {
+	// Kind describes how the values differ.
+	Kind DifferenceKind
+
+	// Path is the path of the values, relative to the values given to
+	// Diff. Set elements use the path of the element value, so elements
+	// are reported as removed from the first set and added to the second.
+	Path path.Path
+
+	// Old is the value from the first value, or nil if the value was
+	// added.
+	Old attr.Value
+
+	// New is the value from the second value, or nil if the value was
+	// removed.
+	New attr.Value
+}
+
+// String returns a human-readable representation of the Difference, such as:
+//
+//	name: "old" => "new"
+func (d Difference) String() string {
+	pathString := d.Path.String()
+
+	if pathString == "" {
+		pathString = "(root)"
+	}
+
+	switch d.Kind {
+	case DifferenceKindAdded:
+		return fmt.Sprintf("%s: added %s", pathString, valueString(d.New))
+	case DifferenceKindRemoved:
+		return fmt.Sprintf("%s: removed %s", pathString, valueString(d.Old))
+	case DifferenceKindType:
+		return fmt.Sprintf("%s: type %s => %s", pathString, typeString(d.Old), typeString(d.New))
+	default:
+		return fmt.Sprintf("%s: %s => %s", pathString, valueString(d.Old), valueString(d.New))
+	}
+}
+
+// Differences is a collection of Difference.
+type Differences []Difference
+
+// String returns a human-readable representation of the Differences, with
+// one Difference per line, or an empty string if there are no Differences.
+func (d Differences) String() string {
+	lines := make([]string, 0, len(d))
+
+	for _, difference := range d {
+		lines = append(lines, difference.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Diff returns the differences between two values by path, which is
+// intended for test failure messages and debug logging. Values are compared
+// with their Equal method. Object, list, map, set, and tuple values are
+// compared by their nested values, where object attributes and map elements
+// are matched by name, list and tuple elements by index, and set elements by
+// value. Objects with different attribute types are compared by their
+// attributes, while other values with different types are a single
+// DifferenceKindType Difference.
+//
+// If there are no differences, the result is empty.
+func Diff(ctx context.Context, a, b attr.Value) Differences {
+	return diff(ctx, path.Empty(), a, b)
+}
+
+// diff returns the differences between two values at the path.
+func diff(ctx context.Context, valuePath path.Path, a, b attr.Value) Differences {
+	if a == nil || b == nil {
+		if a == nil && b == nil {
+			return nil
+		}
+
+		return Differences{{Kind: DifferenceKindChanged, Path: valuePath, Old: a, New: b}}
+	}
+
+	if a.Equal(b) {
+		return nil
+	}
+
+	_, aObject := a.(basetypes.ObjectValuable)
+	_, bObject := b.(basetypes.ObjectValuable)
+
+	if !a.Type(ctx).Equal(b.Type(ctx)) && !(aObject && bObject) {
+		return Differences{{Kind: DifferenceKindType, Path: valuePath, Old: a, New: b}}
+	}
+
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return Differences{{Kind: DifferenceKindChanged, Path: valuePath, Old: a, New: b}}
+	}
+
+	switch a.(type) {
+	case basetypes.ObjectValuable, basetypes.ListValuable, basetypes.MapValuable, basetypes.SetValuable, basetypes.TupleValuable:
+	default:
+		return Differences{{Kind: DifferenceKindChanged, Path: valuePath, Old: a, New: b}}
+	}
+
+	aNested, aDiags := nestedValues(ctx, valuePath, a)
+	bNested, bDiags := nestedValues(ctx, valuePath, b)
+
+	if aDiags.HasError() || bDiags.HasError() {
+		return Differences{{Kind: DifferenceKindChanged, Path: valuePath, Old: a, New: b}}
+	}
+
+	var result Differences
+
+	if _, ok := a.(basetypes.SetValuable); ok {
+		result = diffSetElements(aNested, bNested)
+	} else {
+		result = diffNestedValues(ctx, aNested, bNested)
+	}
+
+	// Values may not be equal without nested differences, such as custom
+	// types with additional data.
+	if len(result) == 0 {
+		return Differences{{Kind: DifferenceKindChanged, Path: valuePath, Old: a, New: b}}
+	}
+
+	return result
+}
+
+// diffNestedValues returns the differences between nested values which are
+// matched by path, such as object attributes or list elements.
+func diffNestedValues(ctx context.Context, aNested, bNested []nestedValue) Differences {
+	var result Differences
+
+	bByPath := make(map[string]nestedValue, len(bNested))
+
+	for _, b := range bNested {
+		bByPath[b.path.String()] = b
+	}
+
+	aPaths := make(map[string]struct{}, len(aNested))
+
+	for _, a := range aNested {
+		aPaths[a.path.String()] = struct{}{}
+
+		b, ok := bByPath[a.path.String()]
+
+		if !ok {
+			result = append(result, Difference{Kind: DifferenceKindRemoved, Path: a.path, Old: a.value})
+
+			continue
+		}
+
+		result = append(result, diff(ctx, a.path, a.value, b.value)...)
+	}
+
+	for _, b := range bNested {
+		if _, ok := aPaths[b.path.String()]; ok {
+			continue
+		}
+
+		result = append(result, Difference{Kind: DifferenceKindAdded, Path: b.path, New: b.value})
+	}
+
+	return result
+}
+
+// diffSetElements returns the differences between set elements, which are
+// matched by value. Elements only in the first set are reported before
+// elements only in the second set.
+func diffSetElements(aNested, bNested []nestedValue) Differences {
+	var result Differences
+
+	for _, a := range aNested {
+		if !containsNestedValue(bNested, a.value) {
+			result = append(result, Difference{Kind: DifferenceKindRemoved, Path: a.path, Old: a.value})
+		}
+	}
+
+	for _, b := range bNested {
+		if !containsNestedValue(aNested, b.value) {
+			result = append(result, Difference{Kind: DifferenceKindAdded, Path: b.path, New: b.value})
+		}
+	}
+
+	return result
+}
+
+// containsNestedValue returns true if a nested value is equal to the value.
+func containsNestedValue(nested []nestedValue, value attr.Value) bool {
+	for _, n := range nested {
+		if n.value.Equal(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// typeString returns the String of the value type, or <nil> for a nil value.
+func typeString(value attr.Value) string {
+	if value == nil {
+		return "<nil>"
+	}
+
+	return value.Type(context.Background()).String()
+}
+
+// valueString returns the String of the value, or <nil> for a nil value.
+func valueString(value attr.Value) string {
+	if value == nil {
+		return "<nil>"
+	}
+
+	return value.String()
+}
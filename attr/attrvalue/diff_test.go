@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrvalue"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	objectType := map[string]attr.Type{
+		"name": types.StringType,
+		"tags": types.MapType{ElemType: types.StringType},
+	}
+
+	testCases := map[string]struct {
+		a        attr.Value
+		b        attr.Value
+		expected attrvalue.Differences
+	}{
+		"nil": {
+			a:        nil,
+			b:        nil,
+			expected: nil,
+		},
+		"nil-value": {
+			a: nil,
+			b: types.StringValue("test"),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Empty(), New: types.StringValue("test")},
+			},
+		},
+		"equal": {
+			a:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			b:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			expected: nil,
+		},
+		"string": {
+			a: types.StringValue("old"),
+			b: types.StringValue("new"),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Empty(), Old: types.StringValue("old"), New: types.StringValue("new")},
+			},
+		},
+		"string-null": {
+			a: types.StringNull(),
+			b: types.StringValue("new"),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Empty(), Old: types.StringNull(), New: types.StringValue("new")},
+			},
+		},
+		"type": {
+			a: types.StringValue("1"),
+			b: types.Int64Value(1),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindType, Path: path.Empty(), Old: types.StringValue("1"), New: types.Int64Value(1)},
+			},
+		},
+		"type-custom": {
+			a: types.StringValue("test"),
+			b: testtypes.StringValueWithSemanticEquals{StringValue: types.StringValue("test")},
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindType, Path: path.Empty(), Old: types.StringValue("test"), New: testtypes.StringValueWithSemanticEquals{StringValue: types.StringValue("test")}},
+			},
+		},
+		"list": {
+			a: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+				types.StringValue("three"),
+			}),
+			b: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringUnknown(),
+			}),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Empty().AtListIndex(1), Old: types.StringValue("two"), New: types.StringUnknown()},
+				{Kind: attrvalue.DifferenceKindRemoved, Path: path.Empty().AtListIndex(2), Old: types.StringValue("three")},
+			},
+		},
+		"list-unknown": {
+			a: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			b: types.ListUnknown(types.StringType),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Empty(), Old: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}), New: types.ListUnknown(types.StringType)},
+			},
+		},
+		"list-element-type": {
+			a: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			b: types.ListValueMust(types.BoolType, []attr.Value{types.BoolValue(true)}),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindType, Path: path.Empty(), Old: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}), New: types.ListValueMust(types.BoolType, []attr.Value{types.BoolValue(true)})},
+			},
+		},
+		"set": {
+			a: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+			b: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("three"),
+				types.StringValue("one"),
+			}),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindRemoved, Path: path.Empty().AtSetValue(types.StringValue("two")), Old: types.StringValue("two")},
+				{Kind: attrvalue.DifferenceKindAdded, Path: path.Empty().AtSetValue(types.StringValue("three")), New: types.StringValue("three")},
+			},
+		},
+		"object": {
+			a: types.ObjectValueMust(objectType, map[string]attr.Value{
+				"name": types.StringValue("old"),
+				"tags": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"a": types.StringValue("1"),
+					"b": types.StringValue("2"),
+				}),
+			}),
+			b: types.ObjectValueMust(objectType, map[string]attr.Value{
+				"name": types.StringNull(),
+				"tags": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"b": types.StringValue("3"),
+					"c": types.StringValue("4"),
+				}),
+			}),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Root("name"), Old: types.StringValue("old"), New: types.StringNull()},
+				{Kind: attrvalue.DifferenceKindRemoved, Path: path.Root("tags").AtMapKey("a"), Old: types.StringValue("1")},
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Root("tags").AtMapKey("b"), Old: types.StringValue("2"), New: types.StringValue("3")},
+				{Kind: attrvalue.DifferenceKindAdded, Path: path.Root("tags").AtMapKey("c"), New: types.StringValue("4")},
+			},
+		},
+		"object-attribute-types": {
+			a: types.ObjectValueMust(
+				map[string]attr.Type{"name": types.StringType, "old": types.BoolType},
+				map[string]attr.Value{"name": types.StringValue("test"), "old": types.BoolValue(true)},
+			),
+			b: types.ObjectValueMust(
+				map[string]attr.Type{"name": types.Int64Type, "new": types.BoolType},
+				map[string]attr.Value{"name": types.Int64Value(1), "new": types.BoolValue(false)},
+			),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindType, Path: path.Root("name"), Old: types.StringValue("test"), New: types.Int64Value(1)},
+				{Kind: attrvalue.DifferenceKindRemoved, Path: path.Root("old"), Old: types.BoolValue(true)},
+				{Kind: attrvalue.DifferenceKindAdded, Path: path.Root("new"), New: types.BoolValue(false)},
+			},
+		},
+		"tuple": {
+			a: types.TupleValueMust(
+				[]attr.Type{types.StringType, types.BoolType},
+				[]attr.Value{types.StringValue("test"), types.BoolValue(true)},
+			),
+			b: types.TupleValueMust(
+				[]attr.Type{types.StringType, types.BoolType},
+				[]attr.Value{types.StringValue("test"), types.BoolValue(false)},
+			),
+			expected: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Empty().AtTupleIndex(1), Old: types.BoolValue(true), New: types.BoolValue(false)},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := attrvalue.Diff(context.Background(), testCase.a, testCase.b)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDifferencesString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		differences attrvalue.Differences
+		expected    string
+	}{
+		"nil": {
+			differences: nil,
+			expected:    "",
+		},
+		"changed-root": {
+			differences: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Empty(), Old: types.StringNull(), New: types.StringUnknown()},
+			},
+			expected: "(root): <null> => <unknown>",
+		},
+		"multiple": {
+			differences: attrvalue.Differences{
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Root("name"), Old: types.StringValue("old"), New: types.StringValue("new")},
+				{Kind: attrvalue.DifferenceKindType, Path: path.Root("id"), Old: types.StringValue("1"), New: types.Int64Value(1)},
+				{Kind: attrvalue.DifferenceKindRemoved, Path: path.Root("ports").AtSetValue(types.Int64Value(80)), Old: types.Int64Value(80)},
+				{Kind: attrvalue.DifferenceKindAdded, Path: path.Root("tags").AtMapKey("env"), New: types.StringValue("prod")},
+				{Kind: attrvalue.DifferenceKindChanged, Path: path.Root("list").AtListIndex(0), New: types.StringValue("new")},
+			},
+			expected: `name: "old" => "new"` + "\n" +
+				`id: type basetypes.StringType => basetypes.Int64Type` + "\n" +
+				`ports[Value(80)]: removed 80` + "\n" +
+				`tags["env"]: added "prod"` + "\n" +
+				`list[0]: <nil> => "new"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.differences.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package attrvalue contains functions for working with nested attr.Value,
+// such as walking and transforming all values within an object, list, map,
+// set, or tuple. Custom types are supported through the basetypes Valuable
+// and Typable interfaces, such as basetypes.ObjectValuable.
+//
+// This package is separate from the core attr package to prevent import
+// cycles.
+package attrvalue
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue_test
+
+import "math/big"
+
+func bigFloat(f float64) *big.Float {
+	return big.NewFloat(f)
+}
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// TransformFunc is called by Transform for each value with its path and
+// returns the replacement value. Returning a nil value keeps the given value.
+type TransformFunc func(path.Path, attr.Value) (attr.Value, diag.Diagnostics)
+
+// Transform calls the given function for the value and every nested value,
+// with the path of the value relative to the given value, and returns the
+// value with all replacements. Nested values are transformed before their
+// parent value, so the function receives parent values which already contain
+// the replaced nested values. Paths refer to the original values, such as
+// set element values before replacement.
+//
+// Replacement values must be of the same type as the original value, since
+// they must still conform to the type of any parent value. For example, a null
+// basetypes.ListValue can be replaced with an empty list of the same element
+// type.
+//
+// Custom types are supported through the basetypes Valuable and Typable
+// interfaces, such as basetypes.ObjectValuable and basetypes.ObjectTypable.
+// Values of custom types with replaced nested values are recreated with the
+// ValueFrom method of the type, such as ValueFromObject.
+//
+// If an error diagnostic is returned, the returned value should be ignored.
+func Transform(ctx context.Context, value attr.Value, fn TransformFunc) (attr.Value, diag.Diagnostics) {
+	return transform(ctx, path.Empty(), value, fn)
+}
+
+func transform(ctx context.Context, valuePath path.Path, value attr.Value, fn TransformFunc) (attr.Value, diag.Diagnostics) {
+	if value == nil {
+		return nil, nil
+	}
+
+	value, diags := transformNested(ctx, valuePath, value, fn)
+
+	if diags.HasError() {
+		return value, diags
+	}
+
+	result, fnDiags := fn(valuePath, value)
+
+	diags.Append(fnDiags...)
+
+	if result == nil {
+		return value, diags
+	}
+
+	return result, diags
+}
+
+// transformNested returns the value with all nested values transformed.
+func transformNested(ctx context.Context, valuePath path.Path, value attr.Value, fn TransformFunc) (attr.Value, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return value, nil
+	}
+
+	switch v := value.(type) {
+	case basetypes.ObjectValuable:
+		objectValue, diags := v.ToObjectValue(ctx)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		attributes := objectValue.Attributes()
+		newAttributes := make(map[string]attr.Value, len(attributes))
+		changed := false
+
+		for _, name := range sortedKeys(attributes) {
+			newAttribute, attributeDiags := transform(ctx, valuePath.AtName(name), attributes[name], fn)
+
+			diags.Append(attributeDiags...)
+
+			if diags.HasError() {
+				return value, diags
+			}
+
+			changed = changed || !newAttribute.Equal(attributes[name])
+			newAttributes[name] = newAttribute
+		}
+
+		if !changed {
+			return value, diags
+		}
+
+		newObjectValue, newDiags := basetypes.NewObjectValue(objectValue.AttributeTypes(ctx), newAttributes)
+
+		diags.Append(withPath(valuePath, newDiags)...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		typable, ok := value.Type(ctx).(basetypes.ObjectTypable)
+
+		if !ok {
+			return newObjectValue, diags
+		}
+
+		result, resultDiags := typable.ValueFromObject(ctx, newObjectValue)
+
+		diags.Append(resultDiags...)
+
+		return result, diags
+	case basetypes.ListValuable:
+		listValue, diags := v.ToListValue(ctx)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		newElements, changed, elementsDiags := transformElements(ctx, listValue.Elements(), valuePath.AtListIndex, fn)
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() || !changed {
+			return value, diags
+		}
+
+		newListValue, newDiags := basetypes.NewListValue(listValue.ElementType(ctx), newElements)
+
+		diags.Append(withPath(valuePath, newDiags)...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		typable, ok := value.Type(ctx).(basetypes.ListTypable)
+
+		if !ok {
+			return newListValue, diags
+		}
+
+		result, resultDiags := typable.ValueFromList(ctx, newListValue)
+
+		diags.Append(resultDiags...)
+
+		return result, diags
+	case basetypes.MapValuable:
+		mapValue, diags := v.ToMapValue(ctx)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		elements := mapValue.Elements()
+		newElements := make(map[string]attr.Value, len(elements))
+		changed := false
+
+		for _, key := range sortedKeys(elements) {
+			newElement, elementDiags := transform(ctx, valuePath.AtMapKey(key), elements[key], fn)
+
+			diags.Append(elementDiags...)
+
+			if diags.HasError() {
+				return value, diags
+			}
+
+			changed = changed || !newElement.Equal(elements[key])
+			newElements[key] = newElement
+		}
+
+		if !changed {
+			return value, diags
+		}
+
+		newMapValue, newDiags := basetypes.NewMapValue(mapValue.ElementType(ctx), newElements)
+
+		diags.Append(withPath(valuePath, newDiags)...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		typable, ok := value.Type(ctx).(basetypes.MapTypable)
+
+		if !ok {
+			return newMapValue, diags
+		}
+
+		result, resultDiags := typable.ValueFromMap(ctx, newMapValue)
+
+		diags.Append(resultDiags...)
+
+		return result, diags
+	case basetypes.SetValuable:
+		setValue, diags := v.ToSetValue(ctx)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		elements := setValue.Elements()
+		elementPath := func(index int) path.Path {
+			return valuePath.AtSetValue(elements[index])
+		}
+
+		newElements, changed, elementsDiags := transformElements(ctx, elements, elementPath, fn)
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() || !changed {
+			return value, diags
+		}
+
+		newSetValue, newDiags := basetypes.NewSetValue(setValue.ElementType(ctx), newElements)
+
+		diags.Append(withPath(valuePath, newDiags)...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		typable, ok := value.Type(ctx).(basetypes.SetTypable)
+
+		if !ok {
+			return newSetValue, diags
+		}
+
+		result, resultDiags := typable.ValueFromSet(ctx, newSetValue)
+
+		diags.Append(resultDiags...)
+
+		return result, diags
+	case basetypes.TupleValuable:
+		tupleValue, diags := v.ToTupleValue(ctx)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		newElements, changed, elementsDiags := transformElements(ctx, tupleValue.Elements(), valuePath.AtTupleIndex, fn)
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() || !changed {
+			return value, diags
+		}
+
+		newTupleValue, newDiags := basetypes.NewTupleValue(tupleValue.ElementTypes(ctx), newElements)
+
+		diags.Append(withPath(valuePath, newDiags)...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		typable, ok := value.Type(ctx).(basetypes.TupleTypable)
+
+		if !ok {
+			return newTupleValue, diags
+		}
+
+		result, resultDiags := typable.ValueFromTuple(ctx, newTupleValue)
+
+		diags.Append(resultDiags...)
+
+		return result, diags
+	}
+
+	return value, nil
+}
+
+// transformElements transforms the given list, set, or tuple elements and
+// returns whether any element was changed.
+func transformElements(ctx context.Context, elements []attr.Value, elementPath func(int) path.Path, fn TransformFunc) ([]attr.Value, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newElements := make([]attr.Value, 0, len(elements))
+	changed := false
+
+	for index, element := range elements {
+		newElement, elementDiags := transform(ctx, elementPath(index), element, fn)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		changed = changed || !newElement.Equal(element)
+		newElements = append(newElements, newElement)
+	}
+
+	return newElements, changed, diags
+}
+
+// withPath adds the path to diagnostics without a path, such as those
+// returned when creating a value with replaced nested values of the wrong
+// type.
+func withPath(valuePath path.Path, diags diag.Diagnostics) diag.Diagnostics {
+	if len(diags) == 0 {
+		return diags
+	}
+
+	result := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		if _, ok := d.(diag.DiagnosticWithPath); ok {
+			result = append(result, d)
+
+			continue
+		}
+
+		result = append(result, diag.WithPath(valuePath, d))
+	}
+
+	return result
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrvalue"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTransform(t *testing.T) {
+	t.Parallel()
+
+	lowercase := func(_ path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+		s, ok := v.(types.String)
+
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return v, nil
+		}
+
+		return types.StringValue(strings.ToLower(s.ValueString())), nil
+	}
+
+	nullListToEmpty := func(_ path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+		l, ok := v.(types.List)
+
+		if !ok || !l.IsNull() {
+			return v, nil
+		}
+
+		return types.ListValueMust(l.ElementType(context.Background()), []attr.Value{}), nil
+	}
+
+	testCases := map[string]struct {
+		value         attr.Value
+		fn            attrvalue.TransformFunc
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value:    nil,
+			fn:       lowercase,
+			expected: nil,
+		},
+		"string": {
+			value:    types.StringValue("TEST"),
+			fn:       lowercase,
+			expected: types.StringValue("test"),
+		},
+		"nil-result": {
+			value: types.StringValue("TEST"),
+			fn: func(_ path.Path, _ attr.Value) (attr.Value, diag.Diagnostics) {
+				return nil, nil
+			},
+			expected: types.StringValue("TEST"),
+		},
+		"list": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ONE"), types.StringNull()}),
+			fn:       lowercase,
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringNull()}),
+		},
+		"map": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"KEY": types.StringValue("ONE"),
+			}),
+			fn: lowercase,
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"KEY": types.StringValue("one"),
+			}),
+		},
+		"set": {
+			value:    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ONE"), types.StringValue("Two")}),
+			fn:       lowercase,
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringValue("two")}),
+		},
+		"tuple": {
+			value: types.TupleValueMust([]attr.Type{types.StringType, types.BoolType}, []attr.Value{
+				types.StringValue("ONE"),
+				types.BoolValue(true),
+			}),
+			fn: lowercase,
+			expected: types.TupleValueMust([]attr.Type{types.StringType, types.BoolType}, []attr.Value{
+				types.StringValue("one"),
+				types.BoolValue(true),
+			}),
+		},
+		"object-null-list-to-empty": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"list":   types.ListType{ElemType: types.StringType},
+					"nested": types.ObjectType{AttrTypes: map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}}},
+				},
+				map[string]attr.Value{
+					"list": types.ListNull(types.StringType),
+					"nested": types.ObjectValueMust(
+						map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}},
+						map[string]attr.Value{"list": types.ListNull(types.StringType)},
+					),
+				},
+			),
+			fn: nullListToEmpty,
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"list":   types.ListType{ElemType: types.StringType},
+					"nested": types.ObjectType{AttrTypes: map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}}},
+				},
+				map[string]attr.Value{
+					"list": types.ListValueMust(types.StringType, []attr.Value{}),
+					"nested": types.ObjectValueMust(
+						map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}},
+						map[string]attr.Value{"list": types.ListValueMust(types.StringType, []attr.Value{})},
+					),
+				},
+			),
+		},
+		"object-unchanged": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{"bool": types.BoolType},
+				map[string]attr.Value{"bool": types.BoolValue(true)},
+			),
+			fn: lowercase,
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{"bool": types.BoolType},
+				map[string]attr.Value{"bool": types.BoolValue(true)},
+			),
+		},
+		"custom-list": {
+			value: testtypes.ListValueWithSemanticEquals{
+				ListValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ONE")}),
+				SemanticEquals: true,
+			},
+			fn: lowercase,
+			expected: testtypes.ListValueWithSemanticEquals{
+				ListValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+				SemanticEquals: true,
+			},
+		},
+		"custom-object": {
+			value: testtypes.ObjectValueWithSemanticEquals{
+				ObjectValue: types.ObjectValueMust(
+					map[string]attr.Type{"string": types.StringType},
+					map[string]attr.Value{"string": types.StringValue("ONE")},
+				),
+			},
+			fn: lowercase,
+			expected: testtypes.ObjectValueWithSemanticEquals{
+				ObjectValue: types.ObjectValueMust(
+					map[string]attr.Type{"string": types.StringType},
+					map[string]attr.Value{"string": types.StringValue("one")},
+				),
+			},
+		},
+		"parent-receives-transformed-elements": {
+			value: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ONE")}),
+			fn: func(p path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				if l, ok := v.(types.List); ok {
+					return types.ListValueMust(types.StringType, append(l.Elements(), types.StringValue("two"))), nil
+				}
+
+				return lowercase(p, v)
+			},
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringValue("two")}),
+		},
+		"wrong-type": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}},
+				map[string]attr.Value{"list": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})},
+			),
+			fn: func(_ path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				if _, ok := v.(types.String); ok {
+					return types.BoolValue(true), nil
+				}
+
+				return v, nil
+			},
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{"list": types.ListType{ElemType: types.StringType}},
+				map[string]attr.Value{"list": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list"),
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (0) Element Type: basetypes.BoolType",
+				),
+			},
+		},
+		"diagnostics": {
+			value: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			fn: func(p path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				var diags diag.Diagnostics
+
+				if _, ok := v.(types.String); ok {
+					diags.AddAttributeWarning(p, "test summary", "test detail")
+				}
+
+				return v, diags
+			},
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Empty().AtListIndex(0), "test summary", "test detail"),
+			},
+		},
+		"diagnostics-error": {
+			value: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ONE")}),
+			fn: func(p path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				var diags diag.Diagnostics
+
+				if _, ok := v.(types.String); ok {
+					diags.AddAttributeError(p, "test summary", "test detail")
+
+					return types.StringValue("replaced"), diags
+				}
+
+				t.Errorf("unexpected call for parent value after error")
+
+				return v, diags
+			},
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ONE")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(0), "test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := attrvalue.Transform(context.Background(), testCase.value, testCase.fn)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
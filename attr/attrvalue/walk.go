@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// WalkFunc is called by Walk for each value with its path. Returning true
+// skips any nested values of the value.
+type WalkFunc func(path.Path, attr.Value) (skip bool)
+
+// Walk calls the given function for the value and every nested value, with
+// the path of the value relative to the given value. Parent values are visited
+// before their nested values. Object attributes and map elements are visited
+// in sorted order, while list, set, and tuple elements are visited in index
+// order. Null and unknown values have no nested values.
+//
+// Custom types are supported through the basetypes Valuable interfaces, such
+// as basetypes.ObjectValuable. Diagnostics are returned if a conversion of a
+// custom type fails, in which case its nested values are not visited.
+func Walk(ctx context.Context, value attr.Value, fn WalkFunc) diag.Diagnostics {
+	return walk(ctx, path.Empty(), value, fn)
+}
+
+func walk(ctx context.Context, valuePath path.Path, value attr.Value, fn WalkFunc) diag.Diagnostics {
+	if value == nil {
+		return nil
+	}
+
+	if fn(valuePath, value) {
+		return nil
+	}
+
+	nested, diags := nestedValues(ctx, valuePath, value)
+
+	for _, n := range nested {
+		diags.Append(walk(ctx, n.path, n.value, fn)...)
+	}
+
+	return diags
+}
+
+// IsFullyKnown returns true if the value and all nested values are known.
+// Nested values of custom types which cannot be converted are not checked.
+func IsFullyKnown(ctx context.Context, value attr.Value) bool {
+	known := true
+
+	_ = Walk(ctx, value, func(_ path.Path, v attr.Value) bool {
+		if v.IsUnknown() {
+			known = false
+		}
+
+		return !known
+	})
+
+	return known
+}
+
+// ContainsNull returns true if the value or any nested value is null. Nested
+// values of custom types which cannot be converted are not checked.
+func ContainsNull(ctx context.Context, value attr.Value) bool {
+	found := false
+
+	_ = Walk(ctx, value, func(_ path.Path, v attr.Value) bool {
+		if v.IsNull() {
+			found = true
+		}
+
+		return found
+	})
+
+	return found
+}
+
+// nestedValue is a value nested within another value.
+type nestedValue struct {
+	path  path.Path
+	value attr.Value
+}
+
+// nestedValues returns the values nested within the given object, list, map,
+// set, or tuple value, in the same order as Walk.
+func nestedValues(ctx context.Context, valuePath path.Path, value attr.Value) ([]nestedValue, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var result []nestedValue
+
+	switch value := value.(type) {
+	case basetypes.ObjectValuable:
+		objectValue, diags := value.ToObjectValue(ctx)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		attributes := objectValue.Attributes()
+
+		for _, name := range sortedKeys(attributes) {
+			result = append(result, nestedValue{path: valuePath.AtName(name), value: attributes[name]})
+		}
+
+		return result, diags
+	case basetypes.ListValuable:
+		listValue, diags := value.ToListValue(ctx)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for index, element := range listValue.Elements() {
+			result = append(result, nestedValue{path: valuePath.AtListIndex(index), value: element})
+		}
+
+		return result, diags
+	case basetypes.MapValuable:
+		mapValue, diags := value.ToMapValue(ctx)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elements := mapValue.Elements()
+
+		for _, key := range sortedKeys(elements) {
+			result = append(result, nestedValue{path: valuePath.AtMapKey(key), value: elements[key]})
+		}
+
+		return result, diags
+	case basetypes.SetValuable:
+		setValue, diags := value.ToSetValue(ctx)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, element := range setValue.Elements() {
+			result = append(result, nestedValue{path: valuePath.AtSetValue(element), value: element})
+		}
+
+		return result, diags
+	case basetypes.TupleValuable:
+		tupleValue, diags := value.ToTupleValue(ctx)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for index, element := range tupleValue.Elements() {
+			result = append(result, nestedValue{path: valuePath.AtTupleIndex(index), value: element})
+		}
+
+		return result, diags
+	}
+
+	return nil, nil
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys(m map[string]attr.Value) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
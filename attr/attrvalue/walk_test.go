@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrvalue_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrvalue"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	type visit struct {
+		Path  path.Path
+		Value attr.Value
+	}
+
+	testCases := map[string]struct {
+		value         attr.Value
+		skip          func(path.Path) bool
+		expected      []visit
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"string": {
+			value: types.StringValue("test"),
+			expected: []visit{
+				{Path: path.Empty(), Value: types.StringValue("test")},
+			},
+		},
+		"list-null": {
+			value: types.ListNull(types.StringType),
+			expected: []visit{
+				{Path: path.Empty(), Value: types.ListNull(types.StringType)},
+			},
+		},
+		"list-unknown": {
+			value: types.ListUnknown(types.StringType),
+			expected: []visit{
+				{Path: path.Empty(), Value: types.ListUnknown(types.StringType)},
+			},
+		},
+		"list": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringNull(),
+			}),
+			expected: []visit{
+				{
+					Path: path.Empty(),
+					Value: types.ListValueMust(types.StringType, []attr.Value{
+						types.StringValue("one"),
+						types.StringNull(),
+					}),
+				},
+				{Path: path.Empty().AtListIndex(0), Value: types.StringValue("one")},
+				{Path: path.Empty().AtListIndex(1), Value: types.StringNull()},
+			},
+		},
+		"map": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"b": types.StringValue("two"),
+				"a": types.StringValue("one"),
+			}),
+			expected: []visit{
+				{
+					Path: path.Empty(),
+					Value: types.MapValueMust(types.StringType, map[string]attr.Value{
+						"b": types.StringValue("two"),
+						"a": types.StringValue("one"),
+					}),
+				},
+				{Path: path.Empty().AtMapKey("a"), Value: types.StringValue("one")},
+				{Path: path.Empty().AtMapKey("b"), Value: types.StringValue("two")},
+			},
+		},
+		"set": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+			}),
+			expected: []visit{
+				{
+					Path: path.Empty(),
+					Value: types.SetValueMust(types.StringType, []attr.Value{
+						types.StringValue("one"),
+					}),
+				},
+				{Path: path.Empty().AtSetValue(types.StringValue("one")), Value: types.StringValue("one")},
+			},
+		},
+		"tuple": {
+			value: types.TupleValueMust([]attr.Type{types.StringType, types.BoolType}, []attr.Value{
+				types.StringValue("one"),
+				types.BoolValue(true),
+			}),
+			expected: []visit{
+				{
+					Path: path.Empty(),
+					Value: types.TupleValueMust([]attr.Type{types.StringType, types.BoolType}, []attr.Value{
+						types.StringValue("one"),
+						types.BoolValue(true),
+					}),
+				},
+				{Path: path.Empty().AtTupleIndex(0), Value: types.StringValue("one")},
+				{Path: path.Empty().AtTupleIndex(1), Value: types.BoolValue(true)},
+			},
+		},
+		"object-nested": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"list": types.ListType{ElemType: types.StringType},
+					"bool": types.BoolType,
+				},
+				map[string]attr.Value{
+					"list": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+					"bool": types.BoolUnknown(),
+				},
+			),
+			expected: []visit{
+				{
+					Path: path.Empty(),
+					Value: types.ObjectValueMust(
+						map[string]attr.Type{
+							"list": types.ListType{ElemType: types.StringType},
+							"bool": types.BoolType,
+						},
+						map[string]attr.Value{
+							"list": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+							"bool": types.BoolUnknown(),
+						},
+					),
+				},
+				{Path: path.Root("bool"), Value: types.BoolUnknown()},
+				{Path: path.Root("list"), Value: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})},
+				{Path: path.Root("list").AtListIndex(0), Value: types.StringValue("one")},
+			},
+		},
+		"object-skip": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"list": types.ListType{ElemType: types.StringType},
+				},
+				map[string]attr.Value{
+					"list": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+				},
+			),
+			skip: func(p path.Path) bool {
+				return p.Equal(path.Root("list"))
+			},
+			expected: []visit{
+				{
+					Path: path.Empty(),
+					Value: types.ObjectValueMust(
+						map[string]attr.Type{
+							"list": types.ListType{ElemType: types.StringType},
+						},
+						map[string]attr.Value{
+							"list": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+						},
+					),
+				},
+				{Path: path.Root("list"), Value: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})},
+			},
+		},
+		"custom-list": {
+			value: testtypes.ListValueWithSemanticEquals{
+				ListValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: []visit{
+				{
+					Path: path.Empty(),
+					Value: testtypes.ListValueWithSemanticEquals{
+						ListValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+					},
+				},
+				{Path: path.Empty().AtListIndex(0), Value: types.StringValue("one")},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []visit
+
+			diags := attrvalue.Walk(context.Background(), testCase.value, func(p path.Path, v attr.Value) bool {
+				got = append(got, visit{Path: p, Value: v})
+
+				return testCase.skip != nil && testCase.skip(p)
+			})
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected bool
+	}{
+		"known": {
+			value:    types.StringValue("test"),
+			expected: true,
+		},
+		"null": {
+			value:    types.StringNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    types.StringUnknown(),
+			expected: false,
+		},
+		"list-known": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringNull()}),
+			expected: true,
+		},
+		"list-nested-unknown": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringUnknown()}),
+			expected: false,
+		},
+		"object-deeply-nested-unknown": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"map": types.MapType{ElemType: types.SetType{ElemType: types.StringType}},
+				},
+				map[string]attr.Value{
+					"map": types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{
+						"key": types.SetUnknown(types.StringType),
+					}),
+				},
+			),
+			expected: false,
+		},
+		"custom-list-nested-unknown": {
+			value: testtypes.ListValueWithSemanticEquals{
+				ListValue: types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := attrvalue.IsFullyKnown(context.Background(), testCase.value)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestContainsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected bool
+	}{
+		"known": {
+			value:    types.StringValue("test"),
+			expected: false,
+		},
+		"null": {
+			value:    types.StringNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    types.StringUnknown(),
+			expected: false,
+		},
+		"list-known": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringUnknown()}),
+			expected: false,
+		},
+		"list-nested-null": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringNull()}),
+			expected: true,
+		},
+		"tuple-nested-null": {
+			value: types.TupleValueMust([]attr.Type{types.StringType, types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.BoolType}}}, []attr.Value{
+				types.StringValue("one"),
+				types.ObjectValueMust(map[string]attr.Type{"a": types.BoolType}, map[string]attr.Value{"a": types.BoolNull()}),
+			}),
+			expected: true,
+		},
+		"custom-object-nested-null": {
+			value: testtypes.ObjectValueWithSemanticEquals{
+				ObjectValue: types.ObjectValueMust(map[string]attr.Type{"a": types.BoolType}, map[string]attr.Value{"a": types.BoolNull()}),
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := attrvalue.ContainsNull(context.Background(), testCase.value)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,681 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// jsonEnvelopeType is the envelope property containing the String of
+	// the value type.
+	jsonEnvelopeType = "@type"
+
+	// jsonEnvelopeUnknown is the envelope property containing the paths of
+	// unknown values.
+	jsonEnvelopeUnknown = "@unknown"
+
+	// jsonEnvelopeValue is the envelope property containing the Terraform
+	// JSON value encoding.
+	jsonEnvelopeValue = "@value"
+)
+
+// jsonEnvelope is the JSON encoding of a Value by MarshalJSON.
+type jsonEnvelope struct {
+	Type    string  `json:"@type"`
+	Value   any     `json:"@value"`
+	Unknown [][]any `json:"@unknown,omitempty"`
+}
+
+// MarshalJSON returns the JSON encoding of the Value, which UnmarshalJSON
+// converts back into an equal Value. The encoding is an envelope object,
+// such as:
+//
+//	{
+//	  "@type": "types.ListType[basetypes.StringType]",
+//	  "@unknown": [[1]],
+//	  "@value": ["one", null]
+//	}
+//
+// The "@value" property is the Terraform JSON value encoding, as used in
+// state and plan JSON output, except unknown values are encoded as null.
+// Numbers are encoded with their shortest decimal representation at their
+// precision, such as 0.1 or 1e+30, and decoded with the 512 bit precision
+// used by Terraform. Numbers from Terraform are therefore decoded exactly,
+// while a number created with less precision, such as Float64Value(0.1), is
+// decoded as the number Terraform would send for the same configuration.
+//
+// The "@unknown" property contains the path of each unknown value, where each
+// path step is an object attribute name, map key, or list, set, or tuple
+// element index. It is omitted if the Value does not contain unknown values.
+//
+// The "@type" property is the String of the Value type, which may be a custom
+// type. UnmarshalJSON returns an error if it does not match the given type.
+func MarshalJSON(ctx context.Context, value Value) ([]byte, error) {
+	if value == nil {
+		return nil, errors.New("cannot marshal nil Value")
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert Value to Terraform value: %w", err)
+	}
+
+	typ := value.Type(ctx)
+	encoder := &jsonEncoder{}
+
+	jsonValue, err := encoder.encode(tfValue, typ.TerraformType(ctx), []any{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jsonEnvelope{
+		Type:    typ.String(),
+		Value:   jsonValue,
+		Unknown: encoder.unknown,
+	})
+}
+
+// UnmarshalJSON returns a Value of the given Type from the JSON encoding
+// returned by MarshalJSON.
+//
+// The envelope is optional, so the data may instead be the Terraform JSON
+// value encoding of a wholly known value, such as a hand written test fixture.
+// Data is only treated as an envelope if it is a JSON object with an "@value"
+// property and no properties other than "@type", "@unknown", and "@value".
+func UnmarshalJSON(ctx context.Context, typ Type, data []byte) (Value, error) {
+	if typ == nil {
+		return nil, errors.New("cannot unmarshal JSON without a Type")
+	}
+
+	jsonValue, err := decodeJSON(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := &jsonDecoder{
+		unknown: make(map[string]bool),
+	}
+
+	if envelope, ok := jsonValue.(map[string]any); ok && isJSONEnvelope(envelope) {
+		if envelopeType, ok := envelope[jsonEnvelopeType]; ok {
+			if envelopeType != typ.String() {
+				return nil, fmt.Errorf("JSON value type %v does not match type %s", envelopeType, typ)
+			}
+		}
+
+		if err := decoder.addUnknownPaths(envelope[jsonEnvelopeUnknown]); err != nil {
+			return nil, err
+		}
+
+		jsonValue = envelope[jsonEnvelopeValue]
+	}
+
+	tfValue, err := decoder.decode(jsonValue, typ.TerraformType(ctx), []any{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for key, found := range decoder.unknown {
+		if !found {
+			return nil, fmt.Errorf("unknown value path %s does not exist in the value", key)
+		}
+	}
+
+	value, err := typ.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert Terraform value to %s: %w", typ, err)
+	}
+
+	return value, nil
+}
+
+// jsonEncoder converts Terraform values into values for the encoding/json
+// package, saving the paths of unknown values.
+type jsonEncoder struct {
+	unknown [][]any
+}
+
+// encode returns the Terraform JSON value encoding of the Terraform value.
+// The type is the type expected at the path, which may be dynamic.
+func (e *jsonEncoder) encode(value tftypes.Value, typ tftypes.Type, steps []any) (any, error) {
+	if typ.Is(tftypes.DynamicPseudoType) {
+		valueType := value.Type()
+
+		if valueType.Is(tftypes.DynamicPseudoType) {
+			if !value.IsKnown() {
+				e.unknown = append(e.unknown, steps)
+			}
+
+			return nil, nil
+		}
+
+		jsonType, err := valueType.MarshalJSON()
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to encode type %s: %w", jsonPathString(steps), valueType, err)
+		}
+
+		jsonValue, err := e.encode(value, valueType, steps)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{
+			"type":  json.RawMessage(jsonType),
+			"value": jsonValue,
+		}, nil
+	}
+
+	if !value.IsKnown() {
+		e.unknown = append(e.unknown, steps)
+
+		return nil, nil
+	}
+
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return nil, fmt.Errorf("%s: %w", jsonPathString(steps), err)
+		}
+
+		return s, nil
+	case typ.Is(tftypes.Number):
+		var f big.Float
+
+		if err := value.As(&f); err != nil {
+			return nil, fmt.Errorf("%s: %w", jsonPathString(steps), err)
+		}
+
+		if f.IsInf() {
+			return nil, fmt.Errorf("%s: cannot encode infinite number", jsonPathString(steps))
+		}
+
+		return json.Number(jsonNumberText(&f)), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		if err := value.As(&b); err != nil {
+			return nil, fmt.Errorf("%s: %w", jsonPathString(steps), err)
+		}
+
+		return b, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, fmt.Errorf("%s: %w", jsonPathString(steps), err)
+		}
+
+		result := make([]any, 0, len(elements))
+
+		for idx, element := range elements {
+			var elementType tftypes.Type
+
+			switch typ := typ.(type) {
+			case tftypes.List:
+				elementType = typ.ElementType
+			case tftypes.Set:
+				elementType = typ.ElementType
+			case tftypes.Tuple:
+				if idx >= len(typ.ElementTypes) {
+					return nil, fmt.Errorf("%s: tuple has more elements than element types", jsonPathString(steps))
+				}
+
+				elementType = typ.ElementTypes[idx]
+			}
+
+			jsonElement, err := e.encode(element, elementType, jsonPathAppend(steps, idx))
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, jsonElement)
+		}
+
+		return result, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, fmt.Errorf("%s: %w", jsonPathString(steps), err)
+		}
+
+		result := make(map[string]any, len(elements))
+
+		for _, key := range sortedMapKeys(elements) {
+			var elementType tftypes.Type
+
+			switch typ := typ.(type) {
+			case tftypes.Map:
+				elementType = typ.ElementType
+			case tftypes.Object:
+				elementType = typ.AttributeTypes[key]
+			}
+
+			if elementType == nil {
+				return nil, fmt.Errorf("%s: object has unexpected attribute %q", jsonPathString(steps), key)
+			}
+
+			jsonElement, err := e.encode(elements[key], elementType, jsonPathAppend(steps, key))
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = jsonElement
+		}
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("%s: unsupported type %s", jsonPathString(steps), typ)
+}
+
+// jsonDecoder converts values from the encoding/json package into Terraform
+// values, replacing values at the paths of unknown values.
+type jsonDecoder struct {
+	// unknown is the paths of unknown values and whether the path was found.
+	unknown map[string]bool
+}
+
+// addUnknownPaths saves the paths of unknown values from an envelope.
+func (d *jsonDecoder) addUnknownPaths(paths any) error {
+	if paths == nil {
+		return nil
+	}
+
+	pathsList, ok := paths.([]any)
+
+	if !ok {
+		return fmt.Errorf("invalid %s property, expected array of paths", jsonEnvelopeUnknown)
+	}
+
+	for _, p := range pathsList {
+		steps, ok := p.([]any)
+
+		if !ok {
+			return fmt.Errorf("invalid %s path %v, expected array of path steps", jsonEnvelopeUnknown, p)
+		}
+
+		normalized := make([]any, 0, len(steps))
+
+		for _, step := range steps {
+			switch step := step.(type) {
+			case string:
+				normalized = append(normalized, step)
+			case json.Number:
+				idx, err := step.Int64()
+
+				if err != nil || idx < 0 {
+					return fmt.Errorf("invalid %s path step %s, expected element index", jsonEnvelopeUnknown, step)
+				}
+
+				normalized = append(normalized, int(idx))
+			default:
+				return fmt.Errorf("invalid %s path step %v, expected string or element index", jsonEnvelopeUnknown, step)
+			}
+		}
+
+		d.unknown[jsonPathString(normalized)] = false
+	}
+
+	return nil
+}
+
+// isUnknown returns true if the path is the path of an unknown value.
+func (d *jsonDecoder) isUnknown(steps []any) bool {
+	key := jsonPathString(steps)
+
+	if _, ok := d.unknown[key]; !ok {
+		return false
+	}
+
+	d.unknown[key] = true
+
+	return true
+}
+
+// decode returns the Terraform value of the given type from the Terraform
+// JSON value encoding.
+func (d *jsonDecoder) decode(data any, typ tftypes.Type, steps []any) (tftypes.Value, error) {
+	if typ.Is(tftypes.DynamicPseudoType) {
+		if data == nil {
+			if d.isUnknown(steps) {
+				return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+			}
+
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		dynamic, ok := data.(map[string]any)
+
+		if !ok || dynamic["type"] == nil {
+			return tftypes.Value{}, fmt.Errorf("%s: expected object with type and value properties for dynamic value", jsonPathString(steps))
+		}
+
+		valueType, err := typeFromJSON(dynamic["type"])
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", jsonPathString(steps), err)
+		}
+
+		return d.decode(dynamic["value"], valueType, steps)
+	}
+
+	if d.isUnknown(steps) {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if data == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		s, ok := data.(string)
+
+		if !ok {
+			return tftypes.Value{}, jsonUnexpectedError(steps, typ, data)
+		}
+
+		return tftypes.NewValue(typ, s), nil
+	case typ.Is(tftypes.Number):
+		n, ok := data.(json.Number)
+
+		if !ok {
+			return tftypes.Value{}, jsonUnexpectedError(steps, typ, data)
+		}
+
+		f, _, err := big.ParseFloat(n.String(), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: unable to parse number %s: %w", jsonPathString(steps), n, err)
+		}
+
+		return tftypes.NewValue(typ, f), nil
+	case typ.Is(tftypes.Bool):
+		b, ok := data.(bool)
+
+		if !ok {
+			return tftypes.Value{}, jsonUnexpectedError(steps, typ, data)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		jsonElements, ok := data.([]any)
+
+		if !ok {
+			return tftypes.Value{}, jsonUnexpectedError(steps, typ, data)
+		}
+
+		if tuple, ok := typ.(tftypes.Tuple); ok && len(tuple.ElementTypes) != len(jsonElements) {
+			return tftypes.Value{}, fmt.Errorf("%s: expected %d tuple elements, got %d", jsonPathString(steps), len(tuple.ElementTypes), len(jsonElements))
+		}
+
+		elements := make([]tftypes.Value, 0, len(jsonElements))
+
+		for idx, jsonElement := range jsonElements {
+			var elementType tftypes.Type
+
+			switch typ := typ.(type) {
+			case tftypes.List:
+				elementType = typ.ElementType
+			case tftypes.Set:
+				elementType = typ.ElementType
+			case tftypes.Tuple:
+				elementType = typ.ElementTypes[idx]
+			}
+
+			element, err := d.decode(jsonElement, elementType, jsonPathAppend(steps, idx))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elements = append(elements, element)
+		}
+
+		return newTerraformValue(typ, elements, steps)
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		jsonElements, ok := data.(map[string]any)
+
+		if !ok {
+			return tftypes.Value{}, jsonUnexpectedError(steps, typ, data)
+		}
+
+		elements := make(map[string]tftypes.Value, len(jsonElements))
+
+		for _, key := range sortedMapKeys(jsonElements) {
+			var elementType tftypes.Type
+
+			switch typ := typ.(type) {
+			case tftypes.Map:
+				elementType = typ.ElementType
+			case tftypes.Object:
+				elementType = typ.AttributeTypes[key]
+			}
+
+			if elementType == nil {
+				return tftypes.Value{}, fmt.Errorf("%s: unexpected attribute %q", jsonPathString(steps), key)
+			}
+
+			element, err := d.decode(jsonElements[key], elementType, jsonPathAppend(steps, key))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elements[key] = element
+		}
+
+		// Missing object attributes are null, matching Terraform.
+		if object, ok := typ.(tftypes.Object); ok {
+			for name, attributeType := range object.AttributeTypes {
+				if _, ok := elements[name]; !ok {
+					elements[name] = tftypes.NewValue(attributeType, nil)
+				}
+			}
+		}
+
+		return newTerraformValue(typ, elements, steps)
+	}
+
+	return tftypes.Value{}, fmt.Errorf("%s: unsupported type %s", jsonPathString(steps), typ)
+}
+
+// decodeJSON returns the JSON data decoded into values for the encoding/json
+// package, with numbers decoded as json.Number to prevent precision loss.
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var result any
+
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("unable to decode JSON: %w", err)
+	}
+
+	if decoder.More() {
+		return nil, errors.New("unable to decode JSON: unexpected data after value")
+	}
+
+	return result, nil
+}
+
+// isJSONEnvelope returns true if the decoded JSON object is an envelope.
+func isJSONEnvelope(object map[string]any) bool {
+	if _, ok := object[jsonEnvelopeValue]; !ok {
+		return false
+	}
+
+	for key := range object {
+		switch key {
+		case jsonEnvelopeType, jsonEnvelopeUnknown, jsonEnvelopeValue:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// jsonNumberText returns the shortest decimal representation of the number
+// which rounds to the same number at its precision. Numbers from Terraform
+// have 512 bit precision, the same as the decoder, so they decode exactly.
+func jsonNumberText(f *big.Float) string {
+	return f.Text('g', -1)
+}
+
+// jsonPathAppend returns a copy of the path steps with the step added, so
+// paths saved by the encoder do not share backing arrays.
+func jsonPathAppend(steps []any, step any) []any {
+	result := make([]any, 0, len(steps)+1)
+	result = append(result, steps...)
+
+	return append(result, step)
+}
+
+// jsonPathString returns the JSON encoding of the path steps, which is used
+// for error messages and as a comparable key.
+func jsonPathString(steps []any) string {
+	// Path steps are only strings and integers, which cannot fail to encode.
+	result, _ := json.Marshal(steps)
+
+	return string(result)
+}
+
+// jsonUnexpectedError returns an error for decoded JSON which does not match
+// the expected type.
+func jsonUnexpectedError(steps []any, typ tftypes.Type, data any) error {
+	return fmt.Errorf("%s: unexpected JSON %T for type %s", jsonPathString(steps), data, typ)
+}
+
+// newTerraformValue returns tftypes.NewValue, converting its panics into
+// errors.
+func newTerraformValue(typ tftypes.Type, value any, steps []any) (result tftypes.Value, err error) {
+	if err := tftypes.ValidateValue(typ, value); err != nil {
+		return tftypes.Value{}, fmt.Errorf("%s: %w", jsonPathString(steps), err)
+	}
+
+	return tftypes.NewValue(typ, value), nil
+}
+
+// typeFromJSON returns a Terraform type from its decoded JSON encoding, such
+// as "string" or ["list","string"].
+func typeFromJSON(data any) (tftypes.Type, error) {
+	switch data := data.(type) {
+	case string:
+		switch data {
+		case "bool":
+			return tftypes.Bool, nil
+		case "dynamic":
+			return tftypes.DynamicPseudoType, nil
+		case "number":
+			return tftypes.Number, nil
+		case "string":
+			return tftypes.String, nil
+		}
+	case []any:
+		if len(data) < 2 {
+			break
+		}
+
+		kind, _ := data[0].(string)
+
+		switch kind {
+		case "list", "map", "set":
+			elementType, err := typeFromJSON(data[1])
+
+			if err != nil {
+				return nil, err
+			}
+
+			switch kind {
+			case "list":
+				return tftypes.List{ElementType: elementType}, nil
+			case "map":
+				return tftypes.Map{ElementType: elementType}, nil
+			default:
+				return tftypes.Set{ElementType: elementType}, nil
+			}
+		case "object":
+			jsonAttributeTypes, ok := data[1].(map[string]any)
+
+			if !ok {
+				break
+			}
+
+			attributeTypes := make(map[string]tftypes.Type, len(jsonAttributeTypes))
+
+			for name, jsonAttributeType := range jsonAttributeTypes {
+				attributeType, err := typeFromJSON(jsonAttributeType)
+
+				if err != nil {
+					return nil, err
+				}
+
+				attributeTypes[name] = attributeType
+			}
+
+			return tftypes.Object{AttributeTypes: attributeTypes}, nil
+		case "tuple":
+			jsonElementTypes, ok := data[1].([]any)
+
+			if !ok {
+				break
+			}
+
+			elementTypes := make([]tftypes.Type, 0, len(jsonElementTypes))
+
+			for _, jsonElementType := range jsonElementTypes {
+				elementType, err := typeFromJSON(jsonElementType)
+
+				if err != nil {
+					return nil, err
+				}
+
+				elementTypes = append(elementTypes, elementType)
+			}
+
+			return tftypes.Tuple{ElementTypes: elementTypes}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported JSON type %v", data)
+}
+
+// sortedMapKeys returns the keys of the map in sorted order.
+func sortedMapKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      string
+		expectedError string
+	}{
+		"nil": {
+			value:         nil,
+			expectedError: "cannot marshal nil Value",
+		},
+		"string": {
+			value:    types.StringValue("test"),
+			expected: `{"@type":"basetypes.StringType","@value":"test"}`,
+		},
+		"string-null": {
+			value:    types.StringNull(),
+			expected: `{"@type":"basetypes.StringType","@value":null}`,
+		},
+		"string-unknown": {
+			value:    types.StringUnknown(),
+			expected: `{"@type":"basetypes.StringType","@value":null,"@unknown":[[]]}`,
+		},
+		"string-custom": {
+			value: testtypes.StringValueWithSemanticEquals{
+				StringValue:    types.StringValue("test"),
+				SemanticEquals: true,
+			},
+			expected: `{"@type":"StringTypeWithSemanticEquals(true)","@value":"test"}`,
+		},
+		"number": {
+			value:    types.NumberValue(big.NewFloat(1.5)),
+			expected: `{"@type":"basetypes.NumberType","@value":1.5}`,
+		},
+		"number-float64-precision": {
+			value:    types.NumberValue(big.NewFloat(0.1)),
+			expected: `{"@type":"basetypes.NumberType","@value":0.1}`,
+		},
+		"number-terraform-precision": {
+			value:    types.NumberValue(bigFloat512("0.1")),
+			expected: `{"@type":"basetypes.NumberType","@value":0.1}`,
+		},
+		"number-large": {
+			value:    types.NumberValue(big.NewFloat(1e30)),
+			expected: `{"@type":"basetypes.NumberType","@value":1e+30}`,
+		},
+		"float64": {
+			value:    types.Float64Value(0.1),
+			expected: `{"@type":"basetypes.Float64Type","@value":0.1}`,
+		},
+		"int64": {
+			value:    types.Int64Value(1234567890123),
+			expected: `{"@type":"basetypes.Int64Type","@value":1.234567890123e+12}`,
+		},
+		"bool": {
+			value:    types.BoolValue(true),
+			expected: `{"@type":"basetypes.BoolType","@value":true}`,
+		},
+		"list": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringUnknown(),
+				types.StringNull(),
+			}),
+			expected: `{"@type":"types.ListType[basetypes.StringType]","@value":["one",null,null],"@unknown":[[1]]}`,
+		},
+		"list-unknown": {
+			value:    types.ListUnknown(types.StringType),
+			expected: `{"@type":"types.ListType[basetypes.StringType]","@value":null,"@unknown":[[]]}`,
+		},
+		"set": {
+			value: types.SetValueMust(types.BoolType, []attr.Value{
+				types.BoolValue(true),
+				types.BoolUnknown(),
+			}),
+			expected: `{"@type":"types.SetType[basetypes.BoolType]","@value":[true,null],"@unknown":[[1]]}`,
+		},
+		"map": {
+			value: types.MapValueMust(types.Int64Type, map[string]attr.Value{
+				"b": types.Int64Unknown(),
+				"a": types.Int64Value(1),
+			}),
+			expected: `{"@type":"types.MapType[basetypes.Int64Type]","@value":{"a":1,"b":null},"@unknown":[["b"]]}`,
+		},
+		"object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"list": types.ListType{ElemType: types.StringType},
+					"name": types.StringType,
+				},
+				map[string]attr.Value{
+					"list": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one"), types.StringUnknown()}),
+					"name": types.StringUnknown(),
+				},
+			),
+			expected: `{"@type":"types.ObjectType[\"list\":types.ListType[basetypes.StringType], \"name\":basetypes.StringType]","@value":{"list":["one",null],"name":null},"@unknown":[["list",1],["name"]]}`,
+		},
+		"tuple": {
+			value: types.TupleValueMust(
+				[]attr.Type{types.StringType, types.NumberType},
+				[]attr.Value{types.StringValue("one"), types.NumberValue(big.NewFloat(2))},
+			),
+			expected: `{"@type":"types.TupleType[basetypes.StringType, basetypes.NumberType]","@value":["one",2]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := attr.MarshalJSON(context.Background(), testCase.value)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+			"port": types.Int64Type,
+		},
+	}
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		data          string
+		expected      attr.Value
+		expectedError string
+	}{
+		"nil-type": {
+			typ:           nil,
+			data:          `"test"`,
+			expectedError: "cannot unmarshal JSON without a Type",
+		},
+		"invalid-json": {
+			typ:           types.StringType,
+			data:          `"test`,
+			expectedError: "unable to decode JSON: unexpected EOF",
+		},
+		"trailing-data": {
+			typ:           types.StringType,
+			data:          `"test" "other"`,
+			expectedError: "unable to decode JSON: unexpected data after value",
+		},
+		"plain-string": {
+			typ:      types.StringType,
+			data:     `"test"`,
+			expected: types.StringValue("test"),
+		},
+		"plain-null": {
+			typ:      types.StringType,
+			data:     `null`,
+			expected: types.StringNull(),
+		},
+		"plain-number": {
+			typ:      types.Float64Type,
+			data:     `1.5`,
+			expected: types.Float64Value(1.5),
+		},
+		"plain-number-terraform-precision": {
+			typ:      types.NumberType,
+			data:     `0.1`,
+			expected: types.NumberValue(bigFloat512("0.1")),
+		},
+		"plain-object-missing-attribute": {
+			typ:  objectType,
+			data: `{"name":"test"}`,
+			expected: types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+				"name": types.StringValue("test"),
+				"port": types.Int64Null(),
+			}),
+		},
+		"plain-object-unexpected-attribute": {
+			typ:           objectType,
+			data:          `{"name":"test","other":true}`,
+			expectedError: `[]: unexpected attribute "other"`,
+		},
+		"plain-map-with-envelope-properties": {
+			typ:  types.MapType{ElemType: types.StringType},
+			data: `{"@value":"test","other":"test"}`,
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"@value": types.StringValue("test"),
+				"other":  types.StringValue("test"),
+			}),
+		},
+		"plain-wrong-type": {
+			typ:           types.ListType{ElemType: types.StringType},
+			data:          `["one",2]`,
+			expectedError: `[1]: unexpected JSON json.Number for type tftypes.String`,
+		},
+		"plain-tuple-length": {
+			typ:           types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+			data:          `["one","two"]`,
+			expectedError: `[]: expected 1 tuple elements, got 2`,
+		},
+		"envelope-without-type": {
+			typ:      types.StringType,
+			data:     `{"@value":"test"}`,
+			expected: types.StringValue("test"),
+		},
+		"envelope-unknown": {
+			typ:  types.ListType{ElemType: types.StringType},
+			data: `{"@type":"types.ListType[basetypes.StringType]","@value":["one",null],"@unknown":[[1]]}`,
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringUnknown(),
+			}),
+		},
+		"envelope-type-mismatch": {
+			typ:           types.StringType,
+			data:          `{"@type":"StringTypeWithSemanticEquals(true)","@value":"test"}`,
+			expectedError: "JSON value type StringTypeWithSemanticEquals(true) does not match type basetypes.StringType",
+		},
+		"envelope-invalid-unknown": {
+			typ:           types.StringType,
+			data:          `{"@value":null,"@unknown":[["test",1.5]]}`,
+			expectedError: "invalid @unknown path step 1.5, expected element index",
+		},
+		"envelope-missing-unknown-path": {
+			typ:           types.ListType{ElemType: types.StringType},
+			data:          `{"@value":["one"],"@unknown":[[1]]}`,
+			expectedError: "unknown value path [1] does not exist in the value",
+		},
+		"envelope-custom-type": {
+			typ: testtypes.StringTypeWithSemanticEquals{
+				SemanticEquals: true,
+			},
+			data: `{"@type":"StringTypeWithSemanticEquals(true)","@value":"test"}`,
+			expected: testtypes.StringValueWithSemanticEquals{
+				StringValue:    types.StringValue("test"),
+				SemanticEquals: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := attr.UnmarshalJSON(context.Background(), testCase.typ, []byte(testCase.data))
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMarshalJSON_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	nestedType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"map":    types.MapType{ElemType: types.NumberType},
+			"set":    types.SetType{ElemType: types.ListType{ElemType: types.StringType}},
+			"string": types.StringType,
+		},
+	}
+
+	testCases := map[string]attr.Value{
+		"bool-unknown":               types.BoolUnknown(),
+		"float64":                    types.Float64Value(1.5),
+		"int64-negative":             types.Int64Value(-9223372036854775808),
+		"number-terraform-precision": types.NumberValue(bigFloat512("0.1")),
+		"number-third":               types.NumberValue(new(big.Float).SetPrec(512).Quo(big.NewFloat(1), big.NewFloat(3))),
+		"number-zero":                types.NumberValue(big.NewFloat(0)),
+		"string-escaped":             types.StringValue("line\n\"quoted\" é"),
+		"string-custom": testtypes.StringValueWithSemanticEquals{
+			StringValue:    types.StringUnknown(),
+			SemanticEquals: true,
+		},
+		"nested": types.ObjectValueMust(nestedType.AttrTypes, map[string]attr.Value{
+			"map": types.MapValueMust(types.NumberType, map[string]attr.Value{
+				"a": types.NumberValue(big.NewFloat(1)),
+				"b": types.NumberUnknown(),
+				"c": types.NumberNull(),
+			}),
+			"set": types.SetValueMust(types.ListType{ElemType: types.StringType}, []attr.Value{
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringUnknown()}),
+				types.ListUnknown(types.StringType),
+				types.ListNull(types.StringType),
+			}),
+			"string": types.StringValue("test"),
+		}),
+		"nested-null":    types.ObjectNull(nestedType.AttrTypes),
+		"nested-unknown": types.ObjectUnknown(nestedType.AttrTypes),
+		"tuple": types.TupleValueMust(
+			[]attr.Type{types.StringType, types.BoolType},
+			[]attr.Value{types.StringUnknown(), types.BoolValue(false)},
+		),
+	}
+
+	for name, value := range testCases {
+		name, value := name, value
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			data, err := attr.MarshalJSON(ctx, value)
+
+			if err != nil {
+				t.Fatalf("unexpected MarshalJSON error: %s", err)
+			}
+
+			got, err := attr.UnmarshalJSON(ctx, value.Type(ctx), data)
+
+			if err != nil {
+				t.Fatalf("unexpected UnmarshalJSON error: %s", err)
+			}
+
+			if !got.Equal(value) {
+				t.Errorf("expected %s, got %s from JSON: %s", value, got, data)
+			}
+		})
+	}
+}
+
+func bigFloat512(s string) *big.Float {
+	f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
@@ -40,7 +40,8 @@ type Type interface {
 	// Most types should verify the associated Type is exactly equal to prevent
 	// potential data consistency issues. For example:
 	//
-	//  - basetypes.Number is inequal to basetypes.Int64 or basetypes.Float64
+	//  - basetypes.Number is inequal to basetypes.Int32, basetypes.Int64,
+	//    basetypes.Float32, or basetypes.Float64
 	//  - basetypes.String is inequal to a custom Go type that embeds it
 	//
 	Equal(Type) bool
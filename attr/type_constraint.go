@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TypeConstraint returns the Terraform type constraint syntax for the Type,
+// such as list(object({name = string, port = number})), which is suitable
+// for documentation and error messages. Custom types are represented by their
+// underlying Terraform type.
+func TypeConstraint(ctx context.Context, typ Type) string {
+	if typ == nil {
+		return ""
+	}
+
+	return terraformTypeConstraint(typ.TerraformType(ctx))
+}
+
+// terraformTypeConstraint returns the Terraform type constraint syntax for
+// the Terraform type.
+func terraformTypeConstraint(typ tftypes.Type) string {
+	switch typ := typ.(type) {
+	case tftypes.List:
+		return "list(" + terraformTypeConstraint(typ.ElementType) + ")"
+	case tftypes.Map:
+		return "map(" + terraformTypeConstraint(typ.ElementType) + ")"
+	case tftypes.Set:
+		return "set(" + terraformTypeConstraint(typ.ElementType) + ")"
+	case tftypes.Object:
+		attributes := make([]string, 0, len(typ.AttributeTypes))
+
+		for _, name := range sortedMapKeys(typ.AttributeTypes) {
+			constraint := terraformTypeConstraint(typ.AttributeTypes[name])
+
+			if _, ok := typ.OptionalAttributes[name]; ok {
+				constraint = "optional(" + constraint + ")"
+			}
+
+			attributes = append(attributes, name+" = "+constraint)
+		}
+
+		return "object({" + strings.Join(attributes, ", ") + "})"
+	case tftypes.Tuple:
+		elements := make([]string, 0, len(typ.ElementTypes))
+
+		for _, elementType := range typ.ElementTypes {
+			elements = append(elements, terraformTypeConstraint(elementType))
+		}
+
+		return "tuple([" + strings.Join(elements, ", ") + "])"
+	}
+
+	switch {
+	case typ == nil:
+		return ""
+	case typ.Is(tftypes.Bool):
+		return "bool"
+	case typ.Is(tftypes.DynamicPseudoType):
+		return "any"
+	case typ.Is(tftypes.Number):
+		return "number"
+	case typ.Is(tftypes.String):
+		return "string"
+	}
+
+	return typ.String()
+}
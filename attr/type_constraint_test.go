@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTypeConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		expected string
+	}{
+		"nil": {
+			typ:      nil,
+			expected: "",
+		},
+		"bool": {
+			typ:      types.BoolType,
+			expected: "bool",
+		},
+		"float64": {
+			typ:      types.Float64Type,
+			expected: "number",
+		},
+		"string": {
+			typ:      types.StringType,
+			expected: "string",
+		},
+		"string-custom": {
+			typ:      testtypes.StringTypeWithSemanticEquals{},
+			expected: "string",
+		},
+		"list": {
+			typ:      types.ListType{ElemType: types.StringType},
+			expected: "list(string)",
+		},
+		"list-missing-element-type": {
+			typ:      types.ListType{},
+			expected: "list(any)",
+		},
+		"map": {
+			typ:      types.MapType{ElemType: types.Int64Type},
+			expected: "map(number)",
+		},
+		"set": {
+			typ:      types.SetType{ElemType: types.BoolType},
+			expected: "set(bool)",
+		},
+		"object": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"port": types.Int64Type,
+					"name": types.StringType,
+				},
+			},
+			expected: "object({name = string, port = number})",
+		},
+		"object-empty": {
+			typ:      types.ObjectType{},
+			expected: "object({})",
+		},
+		"tuple": {
+			typ:      types.TupleType{ElemTypes: []attr.Type{types.StringType, types.NumberType}},
+			expected: "tuple([string, number])",
+		},
+		"nested": {
+			typ: types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"ports": types.SetType{ElemType: types.Int64Type},
+						"tags":  types.MapType{ElemType: types.StringType},
+					},
+				},
+			},
+			expected: "list(object({ports = set(number), tags = map(string)}))",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := attr.TypeConstraint(context.Background(), testCase.typ)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
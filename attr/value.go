@@ -36,7 +36,8 @@ type Value interface {
 	// Most types should verify the associated Type is exactly equal to prevent
 	// potential data consistency issues. For example:
 	//
-	//  - basetypes.Number is inequal to basetypes.Int64 or basetypes.Float64
+	//  - basetypes.Number is inequal to basetypes.Int32, basetypes.Int64,
+	//    basetypes.Float32, or basetypes.Float64
 	//  - basetypes.String is inequal to a custom Go type that embeds it
 	//
 	// Additionally, most types should verify that known values are compared
@@ -15,7 +15,8 @@ import "github.com/hashicorp/terraform-plugin-framework/path"
 // implementations.
 //
 // To add path information to an existing diagnostic, see the WithPath()
-// function.
+// function. To add a code or Go error, see the WithCode() and WithError()
+// functions.
 type Diagnostic interface {
 	// Severity returns the desired level of feedback for the diagnostic.
 	Severity() Severity
@@ -51,3 +52,33 @@ type DiagnosticWithPath interface {
 	// supporting implementations such as Terraform CLI commands.
 	Path() path.Path
 }
+
+// DiagnosticWithCode is a diagnostic associated with a code.
+//
+// Codes are intended for provider logic and unit testing, rather than
+// practitioners. Use the CodeOf() function to find the code of a diagnostic
+// which may be wrapped, such as with WithPath().
+type DiagnosticWithCode interface {
+	Diagnostic
+
+	// Code is a machine-readable identifier for the diagnostic, such as
+	// "invalid_name".
+	Code() string
+}
+
+// DiagnosticWithError is a diagnostic associated with a Go error.
+//
+// Use the ErrorOf() function to find the error of a diagnostic which may be
+// wrapped, such as with WithPath().
+type DiagnosticWithError interface {
+	Diagnostic
+
+	// Unwrap returns the Go error associated with the diagnostic.
+	Unwrap() error
+}
+
+// diagnosticWrapper is implemented by the diagnostic wrappers of this
+// package, so information can be found through multiple wrappers.
+type diagnosticWrapper interface {
+	unwrapDiagnostic() Diagnostic
+}
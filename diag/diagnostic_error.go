@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import "fmt"
+
+// DiagnosticError is a Go error for a diagnostic, such as those joined by the
+// Err() method of Diagnostics. Use errors.As() to access the diagnostic.
+type DiagnosticError struct {
+	// Diagnostic is the diagnostic represented by the error.
+	Diagnostic Diagnostic
+}
+
+// Error returns the diagnostic summary and detail, separated by a colon even
+// if the detail is empty, prefixed with the diagnostic path if present.
+func (e DiagnosticError) Error() string {
+	if e.Diagnostic == nil {
+		return ""
+	}
+
+	text := fmt.Sprintf("%s: %s", e.Diagnostic.Summary(), e.Diagnostic.Detail())
+
+	if dp, ok := e.Diagnostic.(DiagnosticWithPath); ok && len(dp.Path().Steps()) > 0 {
+		text = dp.Path().String() + ": " + text
+	}
+
+	return text
+}
+
+// Unwrap returns the Go error of the diagnostic, if any, based on the
+// ErrorOf() function. This enables errors.Is() and errors.As() checks
+// against the original error chain.
+func (e DiagnosticError) Unwrap() error {
+	return ErrorOf(e.Diagnostic)
+}
+
+// DiagnosticsFromErr returns the diagnostics represented by the given error,
+// which is the inverse of the Err() method of Diagnostics. Errors joined with
+// errors.Join() return one diagnostic per error. Each DiagnosticError returns
+// its original diagnostic and any other error is converted by FromError(). A
+// nil error returns nil diagnostics.
+func DiagnosticsFromErr(err error) Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	var diags Diagnostics
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			diags.Append(DiagnosticsFromErr(e)...)
+		}
+
+		return diags
+	}
+
+	diags.Append(FromError(err))
+
+	return diags
+}
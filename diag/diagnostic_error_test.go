@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestDiagnosticErrorError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      diag.DiagnosticError
+		expected string
+	}{
+		"nil": {
+			err:      diag.DiagnosticError{},
+			expected: "",
+		},
+		"summary": {
+			err: diag.DiagnosticError{
+				Diagnostic: diag.NewErrorDiagnostic("test summary", ""),
+			},
+			expected: "test summary: ",
+		},
+		"summary-detail": {
+			err: diag.DiagnosticError{
+				Diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+			expected: "test summary: test detail",
+		},
+		"path": {
+			err: diag.DiagnosticError{
+				Diagnostic: diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "test summary", "test detail"),
+			},
+			expected: "test[0]: test summary: test detail",
+		},
+		"path-empty": {
+			err: diag.DiagnosticError{
+				Diagnostic: diag.NewAttributeErrorDiagnostic(path.Empty(), "test summary", "test detail"),
+			},
+			expected: "test summary: test detail",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.err.Error()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsFromErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			err:      nil,
+			expected: nil,
+		},
+		"error": {
+			err: errTest,
+			expected: diag.Diagnostics{
+				diag.FromError(errTest),
+			},
+		},
+		"diagnostic-error": {
+			err: diag.DiagnosticError{
+				Diagnostic: diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			},
+		},
+		"joined": {
+			err: errors.Join(
+				errTest,
+				diag.DiagnosticError{
+					Diagnostic: diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+				},
+				errTest,
+			),
+			expected: diag.Diagnostics{
+				diag.FromError(errTest),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.DiagnosticsFromErr(testCase.err)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsErr_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	pathErr := &fs.PathError{Op: "open", Path: "test", Err: fs.ErrNotExist}
+
+	diags := diag.Diagnostics{
+		diag.NewErrorDiagnostic("one summary", "one detail"),
+		diag.NewAttributeErrorDiagnostic(path.Root("test"), "two summary", "two detail"),
+		diag.WithCode("test_code", diag.NewErrorDiagnosticFromErr("three summary", pathErr)),
+	}
+
+	err := diags.Err()
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected errors.Is() to match fs.ErrNotExist")
+	}
+
+	var gotPathErr *fs.PathError
+
+	if !errors.As(err, &gotPathErr) || gotPathErr != pathErr {
+		t.Errorf("expected errors.As() to find *fs.PathError")
+	}
+
+	got := diag.DiagnosticsFromErr(err)
+
+	if diff := cmp.Diff(got, diags); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if !got.HasCode("test_code") {
+		t.Errorf("expected code to be preserved")
+	}
+}
@@ -4,6 +4,8 @@
 package diag
 
 import (
+	"errors"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -58,6 +60,26 @@ func (diags Diagnostics) Contains(in Diagnostic) bool {
 	return false
 }
 
+// Deduplicate returns a new collection without any diagnostics equal to an
+// earlier diagnostic in the collection, based on the underlying
+// (Diagnostic).Equal() method of each. The order of diagnostics is preserved.
+//
+// Append already prevents duplicates, so this is only necessary for
+// collections which were created or modified directly.
+func (diags Diagnostics) Deduplicate() Diagnostics {
+	dd := Diagnostics{}
+
+	for _, d := range diags {
+		if d == nil || dd.Contains(d) {
+			continue
+		}
+
+		dd = append(dd, d)
+	}
+
+	return dd
+}
+
 // Equal returns true if all given diagnostics are equivalent in order and
 // content, based on the underlying (Diagnostic).Equal() method of each.
 func (diags Diagnostics) Equal(other Diagnostics) bool {
@@ -74,6 +96,32 @@ func (diags Diagnostics) Equal(other Diagnostics) bool {
 	return true
 }
 
+// FilterCode returns all the Diagnostic in Diagnostics with the given code,
+// based on the CodeOf() function.
+func (diags Diagnostics) FilterCode(code string) Diagnostics {
+	dd := Diagnostics{}
+
+	for _, d := range diags {
+		if CodeOf(d) == code {
+			dd = append(dd, d)
+		}
+	}
+
+	return dd
+}
+
+// HasCode returns true if the collection has a Diagnostic with the given code,
+// based on the CodeOf() function.
+func (diags Diagnostics) HasCode(code string) bool {
+	for _, d := range diags {
+		if CodeOf(d) == code {
+			return true
+		}
+	}
+
+	return false
+}
+
 // HasError returns true if the collection has an error severity Diagnostic.
 func (diags Diagnostics) HasError() bool {
 	for _, diag := range diags {
@@ -95,7 +143,28 @@ func (diags Diagnostics) WarningsCount() int {
 	return len(diags.Warnings())
 }
 
+// Err returns all the Diagnostic in Diagnostics that are SeverityError as a
+// single Go error, joined with errors.Join() so each is a DiagnosticError. A
+// nil error is returned if there are no error diagnostics. Use
+// DiagnosticsFromErr() to convert the error back into Diagnostics.
+//
+// Any Go error of the diagnostics, such as from NewErrorDiagnosticFromErr(),
+// is available to errors.Is() and errors.As() checks of the returned error.
+func (diags Diagnostics) Err() error {
+	var errs []error
+
+	for _, d := range diags.Errors() {
+		errs = append(errs, DiagnosticError{Diagnostic: d})
+	}
+
+	return errors.Join(errs...)
+}
+
 // Errors returns all the Diagnostic in Diagnostics that are SeverityError.
+//
+// Diagnostics created with FromError() or WithError() keep their Go error,
+// which is available via the ErrorOf() function for errors.Is() and
+// errors.As() checks.
 func (diags Diagnostics) Errors() Diagnostics {
 	dd := Diagnostics{}
 
@@ -4,6 +4,7 @@
 package diag_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -475,6 +476,59 @@ func TestDiagnosticsContains(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsDeduplicate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			diags:    nil,
+			expected: diag.Diagnostics{},
+		},
+		"no-duplicates": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+			},
+		},
+		"duplicates": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				nil,
+				diag.WithCode("test_code", diag.NewWarningDiagnostic("two summary", "two detail")),
+				diag.WithCode("test_code", diag.NewWarningDiagnostic("two summary", "two detail")),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.WithCode("test_code", diag.NewWarningDiagnostic("two summary", "two detail")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diags.Deduplicate()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsEqual(t *testing.T) {
 	t.Parallel()
 
@@ -602,6 +656,103 @@ func TestDiagnosticsEqual(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsFilterCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		code     string
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			diags:    nil,
+			code:     "test_code",
+			expected: diag.Diagnostics{},
+		},
+		"no-match": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.WithCode("other_code", diag.NewErrorDiagnostic("two summary", "two detail")),
+			},
+			code:     "test_code",
+			expected: diag.Diagnostics{},
+		},
+		"match": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.WithCode("test_code", diag.NewErrorDiagnostic("two summary", "two detail")),
+				diag.WithCode("test_code", diag.NewAttributeWarningDiagnostic(path.Root("test"), "three summary", "three detail")),
+				diag.WithCode("other_code", diag.NewErrorDiagnostic("four summary", "four detail")),
+			},
+			code: "test_code",
+			expected: diag.Diagnostics{
+				diag.WithCode("test_code", diag.NewErrorDiagnostic("two summary", "two detail")),
+				diag.WithCode("test_code", diag.NewAttributeWarningDiagnostic(path.Root("test"), "three summary", "three detail")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diags.FilterCode(testCase.code)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsHasCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		code     string
+		expected bool
+	}{
+		"nil": {
+			diags:    nil,
+			code:     "test_code",
+			expected: false,
+		},
+		"no-match": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.WithCode("other_code", diag.NewErrorDiagnostic("two summary", "two detail")),
+			},
+			code:     "test_code",
+			expected: false,
+		},
+		"match": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.WithPath(path.Root("test"), diag.WithCode("test_code", diag.NewErrorDiagnostic("two summary", "two detail"))),
+			},
+			code:     "test_code",
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diags.HasCode(testCase.code)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsHasError(t *testing.T) {
 	t.Parallel()
 
@@ -740,6 +891,66 @@ func TestDiagnosticsWarningsCount(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected string
+	}{
+		"nil": {
+			diags:    nil,
+			expected: "",
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: "",
+		},
+		"errors": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "two summary", "two detail"),
+			},
+			expected: "one summary: one detail\ntest: two summary: two detail",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diags.Err()
+
+			if testCase.expected == "" {
+				if got != nil {
+					t.Fatalf("expected no error, got: %s", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if diff := cmp.Diff(got.Error(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			var diagErr diag.DiagnosticError
+
+			if !errors.As(got, &diagErr) {
+				t.Errorf("expected errors.As() to find diag.DiagnosticError")
+			}
+		})
+	}
+}
+
 func TestDiagnosticsErrors(t *testing.T) {
 	t.Parallel()
 
@@ -771,6 +982,15 @@ func TestDiagnosticsErrors(t *testing.T) {
 			},
 			expected: diag.Diagnostics{},
 		},
+		"from-error": {
+			diags: diag.Diagnostics{
+				diag.FromError(errTest),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: diag.Diagnostics{
+				diag.FromError(errTest),
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -44,3 +44,15 @@ func NewErrorDiagnostic(summary string, detail string) ErrorDiagnostic {
 		summary: summary,
 	}
 }
+
+// NewErrorDiagnosticFromErr returns a new error severity diagnostic with the
+// given summary and the error message as the detail. The error is wrapped, so
+// it remains available via ErrorOf() and the Err() method of Diagnostics for
+// errors.Is() and errors.As() checks.
+func NewErrorDiagnosticFromErr(summary string, err error) Diagnostic {
+	if err == nil {
+		return NewErrorDiagnostic(summary, "")
+	}
+
+	return WithError(err, NewErrorDiagnostic(summary, err.Error()))
+}
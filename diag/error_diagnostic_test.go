@@ -4,8 +4,12 @@
 package diag_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
@@ -57,3 +61,47 @@ func TestErrorDiagnosticEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestNewErrorDiagnosticFromErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		summary  string
+		err      error
+		expected diag.Diagnostic
+	}{
+		"nil": {
+			summary:  "test summary",
+			err:      nil,
+			expected: diag.NewErrorDiagnostic("test summary", ""),
+		},
+		"error": {
+			summary:  "test summary",
+			err:      errTest,
+			expected: diag.WithError(errTest, diag.NewErrorDiagnostic("test summary", "test error")),
+		},
+		"wrapped-error": {
+			summary:  "test summary",
+			err:      fmt.Errorf("wrapped: %w", errTest),
+			expected: diag.WithError(fmt.Errorf("wrapped: %w", errTest), diag.NewErrorDiagnostic("test summary", "wrapped: test error")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.NewErrorDiagnosticFromErr(testCase.summary, testCase.err)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if testCase.err != nil && !errors.Is(diag.ErrorOf(got), errTest) {
+				t.Errorf("expected error chain to include errTest, got: %s", diag.ErrorOf(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+var _ DiagnosticWithCode = withCode{}
+
+// withCode wraps a diagnostic with a code.
+type withCode struct {
+	Diagnostic
+
+	code string
+}
+
+// Code returns the diagnostic code.
+func (d withCode) Code() string {
+	return d.code
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withCode) Equal(other Diagnostic) bool {
+	o, ok := other.(withCode)
+
+	if !ok {
+		return false
+	}
+
+	if d.code != o.code {
+		return false
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// unwrapDiagnostic returns the wrapped diagnostic.
+func (d withCode) unwrapDiagnostic() Diagnostic {
+	return d.Diagnostic
+}
+
+// WithCode wraps a diagnostic with a code or overwrites the code. Codes are
+// not shown to practitioners, but enable provider logic and unit testing to
+// identify diagnostics without relying on the summary or detail text.
+//
+// Path information of the given diagnostic is preserved, so the returned
+// diagnostic implements DiagnosticWithPath when the given diagnostic does.
+// Use the CodeOf() function to read the code, since the returned diagnostic
+// may not directly implement DiagnosticWithCode.
+func WithCode(code string, d Diagnostic) Diagnostic {
+	switch d := d.(type) {
+	case withPath:
+		d.Diagnostic = WithCode(code, d.Diagnostic)
+
+		return d
+	case withCode:
+		d.code = code
+
+		return d
+	case DiagnosticWithPath:
+		return withPath{
+			Diagnostic: withCode{Diagnostic: d, code: code},
+			path:       d.Path(),
+		}
+	}
+
+	return withCode{
+		Diagnostic: d,
+		code:       code,
+	}
+}
+
+// CodeOf returns the code of the diagnostic, searching through any diagnostic
+// wrappers of this package, such as WithPath(). An empty string is returned
+// if no code is found.
+func CodeOf(d Diagnostic) string {
+	for d != nil {
+		if dc, ok := d.(DiagnosticWithCode); ok {
+			return dc.Code()
+		}
+
+		dw, ok := d.(diagnosticWrapper)
+
+		if !ok {
+			return ""
+		}
+
+		d = dw.unwrapDiagnostic()
+	}
+
+	return ""
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		code         string
+		diagnostic   diag.Diagnostic
+		expectedCode string
+		expectedPath *path.Path
+	}{
+		"nil": {
+			code:         "test_code",
+			diagnostic:   nil,
+			expectedCode: "test_code",
+		},
+		"diagnostic": {
+			code:         "test_code",
+			diagnostic:   diag.NewErrorDiagnostic("test summary", "test detail"),
+			expectedCode: "test_code",
+		},
+		"diagnostic-with-code": {
+			code:         "new_code",
+			diagnostic:   diag.WithCode("old_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expectedCode: "new_code",
+		},
+		"diagnostic-with-path": {
+			code:         "test_code",
+			diagnostic:   diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expectedCode: "test_code",
+			expectedPath: pointer(path.Root("test")),
+		},
+		"withpath": {
+			code:         "test_code",
+			diagnostic:   diag.WithPath(path.Root("test"), diag.NewErrorDiagnostic("test summary", "test detail")),
+			expectedCode: "test_code",
+			expectedPath: pointer(path.Root("test")),
+		},
+		"withpath-with-code": {
+			code:         "new_code",
+			diagnostic:   diag.WithPath(path.Root("test"), diag.WithCode("old_code", diag.NewErrorDiagnostic("test summary", "test detail"))),
+			expectedCode: "new_code",
+			expectedPath: pointer(path.Root("test")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.WithCode(testCase.code, testCase.diagnostic)
+
+			if diff := cmp.Diff(diag.CodeOf(got), testCase.expectedCode); diff != "" {
+				t.Errorf("unexpected code difference: %s", diff)
+			}
+
+			var gotPath *path.Path
+
+			if dp, ok := got.(diag.DiagnosticWithPath); ok {
+				gotPath = pointer(dp.Path())
+			}
+
+			if diff := cmp.Diff(gotPath, testCase.expectedPath); diff != "" {
+				t.Errorf("unexpected path difference: %s", diff)
+			}
+
+			if testCase.diagnostic == nil {
+				return
+			}
+
+			if got.Summary() != testCase.diagnostic.Summary() || got.Detail() != testCase.diagnostic.Detail() || got.Severity() != testCase.diagnostic.Severity() {
+				t.Errorf("expected wrapped diagnostic content to be preserved, got: %#v", got)
+			}
+		})
+	}
+}
+
+func TestWithCodeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diagnostic diag.Diagnostic
+		other      diag.Diagnostic
+		expected   bool
+	}{
+		"matching": {
+			diagnostic: diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   true,
+		},
+		"nil-wrapped": {
+			diagnostic: diag.WithCode("test_code", nil),
+			other:      diag.WithCode("test_code", nil),
+			expected:   true,
+		},
+		"nil": {
+			diagnostic: diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      nil,
+			expected:   false,
+		},
+		"different-code": {
+			diagnostic: diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithCode("other_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   false,
+		},
+		"different-diagnostic": {
+			diagnostic: diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithCode("test_code", diag.NewWarningDiagnostic("test summary", "test detail")),
+			expected:   false,
+		},
+		"different-type": {
+			diagnostic: diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diagnostic.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diagnostic diag.Diagnostic
+		expected   string
+	}{
+		"nil": {
+			diagnostic: nil,
+			expected:   "",
+		},
+		"no-code": {
+			diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected:   "",
+		},
+		"withpath-no-code": {
+			diagnostic: diag.WithPath(path.Root("test"), diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   "",
+		},
+		"withcode": {
+			diagnostic: diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   "test_code",
+		},
+		"witherror-withcode": {
+			diagnostic: diag.WithError(errTest, diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail"))),
+			expected:   "test_code",
+		},
+		"custom": {
+			diagnostic: testCodeDiagnostic{
+				Diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+				code:       "custom_code",
+			},
+			expected: "custom_code",
+		},
+		"withpath-custom": {
+			diagnostic: diag.WithPath(
+				path.Root("test"),
+				testCodeDiagnostic{
+					Diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+					code:       "custom_code",
+				},
+			),
+			expected: "custom_code",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.CodeOf(testCase.diagnostic)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testCodeDiagnostic is a custom diagnostic implementation of
+// DiagnosticWithCode.
+type testCodeDiagnostic struct {
+	diag.Diagnostic
+
+	code string
+}
+
+func (d testCodeDiagnostic) Code() string {
+	return d.code
+}
+
+func pointer[T any](value T) *T {
+	return &value
+}
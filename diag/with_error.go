@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+var _ DiagnosticWithError = withError{}
+
+// withError wraps a diagnostic with a Go error.
+type withError struct {
+	Diagnostic
+
+	err error
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent. Errors
+// are compared by their message, since not all error types are comparable.
+func (d withError) Equal(other Diagnostic) bool {
+	o, ok := other.(withError)
+
+	if !ok {
+		return false
+	}
+
+	if (d.err == nil) != (o.err == nil) {
+		return false
+	}
+
+	if d.err != nil && d.err.Error() != o.err.Error() {
+		return false
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// Unwrap returns the wrapped error.
+func (d withError) Unwrap() error {
+	return d.err
+}
+
+// unwrapDiagnostic returns the wrapped diagnostic.
+func (d withError) unwrapDiagnostic() Diagnostic {
+	return d.Diagnostic
+}
+
+// WithError wraps a diagnostic with a Go error or overwrites the error. The
+// error is not shown to practitioners, but remains available to provider
+// logic, such as errors.Is() and errors.As() checks against ErrorOf().
+//
+// Path information of the given diagnostic is preserved, so the returned
+// diagnostic implements DiagnosticWithPath when the given diagnostic does.
+// Use the ErrorOf() function to read the error, since the returned diagnostic
+// may not directly implement DiagnosticWithError.
+func WithError(err error, d Diagnostic) Diagnostic {
+	switch d := d.(type) {
+	case withPath:
+		d.Diagnostic = WithError(err, d.Diagnostic)
+
+		return d
+	case withError:
+		d.err = err
+
+		return d
+	case DiagnosticWithPath:
+		return withPath{
+			Diagnostic: withError{Diagnostic: d, err: err},
+			path:       d.Path(),
+		}
+	}
+
+	return withError{
+		Diagnostic: d,
+		err:        err,
+	}
+}
+
+// FromError returns an error severity diagnostic with the error message as
+// the summary, which wraps the error so it remains available via ErrorOf().
+// A DiagnosticError returns its original diagnostic. A nil error returns a nil
+// diagnostic, which is ignored by the Append() method of Diagnostics.
+func FromError(err error) Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	if de, ok := err.(DiagnosticError); ok {
+		return de.Diagnostic
+	}
+
+	return WithError(err, NewErrorDiagnostic(err.Error(), ""))
+}
+
+// ErrorOf returns the Go error of the diagnostic, searching through any
+// diagnostic wrappers of this package, such as WithPath() and WithCode(). A
+// nil error is returned if no error is found.
+//
+// The returned error chain is unchanged, so it is compatible with errors.Is()
+// and errors.As().
+func ErrorOf(d Diagnostic) error {
+	for d != nil {
+		if de, ok := d.(DiagnosticWithError); ok {
+			return de.Unwrap()
+		}
+
+		dw, ok := d.(diagnosticWrapper)
+
+		if !ok {
+			return nil
+		}
+
+		d = dw.unwrapDiagnostic()
+	}
+
+	return nil
+}
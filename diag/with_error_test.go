@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var errTest = errors.New("test error")
+
+func TestFromError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected diag.Diagnostic
+	}{
+		"nil": {
+			err:      nil,
+			expected: nil,
+		},
+		"error": {
+			err:      errTest,
+			expected: diag.WithError(errTest, diag.NewErrorDiagnostic("test error", "")),
+		},
+		"wrapped-error": {
+			err:      fmt.Errorf("wrapped: %w", errTest),
+			expected: diag.WithError(fmt.Errorf("wrapped: %w", errTest), diag.NewErrorDiagnostic("wrapped: test error", "")),
+		},
+		"diagnostic-error": {
+			err:      diag.DiagnosticError{Diagnostic: diag.NewErrorDiagnosticFromErr("test summary", errTest)},
+			expected: diag.NewErrorDiagnosticFromErr("test summary", errTest),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.FromError(testCase.err)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if testCase.err != nil && !errors.Is(diag.ErrorOf(got), errTest) {
+				t.Errorf("expected error chain to include errTest, got: %s", diag.ErrorOf(got))
+			}
+		})
+	}
+}
+
+func TestWithError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err          error
+		diagnostic   diag.Diagnostic
+		expectedCode string
+		expectedPath *path.Path
+	}{
+		"diagnostic": {
+			err:        errTest,
+			diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+		},
+		"diagnostic-with-error": {
+			err:        errTest,
+			diagnostic: diag.WithError(errors.New("other error"), diag.NewErrorDiagnostic("test summary", "test detail")),
+		},
+		"diagnostic-with-path": {
+			err:          errTest,
+			diagnostic:   diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expectedPath: pointer(path.Root("test")),
+		},
+		"withpath-withcode": {
+			err:          errTest,
+			diagnostic:   diag.WithPath(path.Root("test"), diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail"))),
+			expectedCode: "test_code",
+			expectedPath: pointer(path.Root("test")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.WithError(testCase.err, testCase.diagnostic)
+
+			if !errors.Is(diag.ErrorOf(got), testCase.err) {
+				t.Errorf("expected error %q, got: %v", testCase.err, diag.ErrorOf(got))
+			}
+
+			if diff := cmp.Diff(diag.CodeOf(got), testCase.expectedCode); diff != "" {
+				t.Errorf("unexpected code difference: %s", diff)
+			}
+
+			var gotPath *path.Path
+
+			if dp, ok := got.(diag.DiagnosticWithPath); ok {
+				gotPath = pointer(dp.Path())
+			}
+
+			if diff := cmp.Diff(gotPath, testCase.expectedPath); diff != "" {
+				t.Errorf("unexpected path difference: %s", diff)
+			}
+
+			if got.Summary() != testCase.diagnostic.Summary() || got.Detail() != testCase.diagnostic.Detail() || got.Severity() != testCase.diagnostic.Severity() {
+				t.Errorf("expected wrapped diagnostic content to be preserved, got: %#v", got)
+			}
+		})
+	}
+}
+
+func TestWithErrorEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diagnostic diag.Diagnostic
+		other      diag.Diagnostic
+		expected   bool
+	}{
+		"matching": {
+			diagnostic: diag.FromError(errTest),
+			other:      diag.FromError(errTest),
+			expected:   true,
+		},
+		"matching-message": {
+			diagnostic: diag.FromError(errTest),
+			other:      diag.FromError(errors.New("test error")),
+			expected:   true,
+		},
+		"nil-error": {
+			diagnostic: diag.WithError(nil, diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithError(nil, diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   true,
+		},
+		"nil-error-mismatch": {
+			diagnostic: diag.WithError(nil, diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithError(errTest, diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   false,
+		},
+		"nil": {
+			diagnostic: diag.FromError(errTest),
+			other:      nil,
+			expected:   false,
+		},
+		"different-error": {
+			diagnostic: diag.WithError(errTest, diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithError(errors.New("other error"), diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   false,
+		},
+		"different-diagnostic": {
+			diagnostic: diag.WithError(errTest, diag.NewErrorDiagnostic("test summary", "test detail")),
+			other:      diag.WithError(errTest, diag.NewErrorDiagnostic("other summary", "test detail")),
+			expected:   false,
+		},
+		"different-type": {
+			diagnostic: diag.FromError(errTest),
+			other:      diag.NewErrorDiagnostic("test error", ""),
+			expected:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diagnostic.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestErrorOf(t *testing.T) {
+	t.Parallel()
+
+	pathErr := &fs.PathError{Op: "open", Path: "test", Err: fs.ErrNotExist}
+
+	testCases := map[string]struct {
+		diagnostic diag.Diagnostic
+		expected   error
+	}{
+		"nil": {
+			diagnostic: nil,
+			expected:   nil,
+		},
+		"no-error": {
+			diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected:   nil,
+		},
+		"withcode-no-error": {
+			diagnostic: diag.WithCode("test_code", diag.NewErrorDiagnostic("test summary", "test detail")),
+			expected:   nil,
+		},
+		"fromerror": {
+			diagnostic: diag.FromError(pathErr),
+			expected:   pathErr,
+		},
+		"withpath-withcode-fromerror": {
+			diagnostic: diag.WithPath(path.Root("test"), diag.WithCode("test_code", diag.FromError(pathErr))),
+			expected:   pathErr,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.ErrorOf(testCase.diagnostic)
+
+			if got != testCase.expected {
+				t.Fatalf("expected error %v, got: %v", testCase.expected, got)
+			}
+
+			if testCase.expected == nil {
+				return
+			}
+
+			if !errors.Is(got, fs.ErrNotExist) {
+				t.Errorf("expected errors.Is() to match fs.ErrNotExist")
+			}
+
+			var gotPathErr *fs.PathError
+
+			if !errors.As(got, &gotPathErr) || gotPathErr != pathErr {
+				t.Errorf("expected errors.As() to find *fs.PathError")
+			}
+		})
+	}
+}
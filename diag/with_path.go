@@ -40,6 +40,11 @@ func (d withPath) Path() path.Path {
 	return d.path
 }
 
+// unwrapDiagnostic returns the wrapped diagnostic.
+func (d withPath) unwrapDiagnostic() Diagnostic {
+	return d.Diagnostic
+}
+
 // WithPath wraps a diagnostic with path information or overwrites the path.
 func WithPath(path path.Path, d Diagnostic) DiagnosticWithPath {
 	wp, ok := d.(withPath)
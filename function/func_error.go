@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // NewFuncError returns a new function error with the
@@ -40,6 +41,10 @@ type FuncError struct {
 	// function argument position that caused the error. Only errors that pertain
 	// to a function argument will include this information.
 	FunctionArgument *int64
+
+	// diags are the diagnostics the function error was created from, if any,
+	// which are returned by DiagsFromFuncError.
+	diags diag.Diagnostics
 }
 
 // Equal returns true if the other function error is wholly equivalent.
@@ -72,12 +77,25 @@ func (fe *FuncError) Error() string {
 	return fe.Text
 }
 
+// Unwrap returns the error diagnostics the function error was created from as
+// a single Go error, if any. This enables errors.Is() and errors.As() checks
+// against Go errors of those diagnostics, such as from
+// diag.NewErrorDiagnosticFromErr().
+func (fe *FuncError) Unwrap() error {
+	if fe == nil {
+		return nil
+	}
+
+	return fe.diags.Err()
+}
+
 // ConcatFuncErrors returns a new function error with the text from all supplied
 // function errors concatenated together. If any of the function errors have a
 // function argument, the first one encountered will be used.
 func ConcatFuncErrors(funcErrs ...*FuncError) *FuncError {
 	var text string
 	var functionArgument *int64
+	var diags diag.Diagnostics
 
 	for _, f := range funcErrs {
 		if f == nil {
@@ -93,12 +111,15 @@ func ConcatFuncErrors(funcErrs ...*FuncError) *FuncError {
 		if functionArgument == nil {
 			functionArgument = f.FunctionArgument
 		}
+
+		diags.Append(DiagsFromFuncError(f)...)
 	}
 
 	if text != "" || functionArgument != nil {
 		return &FuncError{
 			Text:             text,
 			FunctionArgument: functionArgument,
+			diags:            diags,
 		}
 	}
 
@@ -108,18 +129,100 @@ func ConcatFuncErrors(funcErrs ...*FuncError) *FuncError {
 // FuncErrorFromDiags iterates over the given diagnostics and returns a new function error
 // with the summary and detail text from all error diagnostics concatenated together.
 // Diagnostics with a severity of warning are logged but are not included in the returned
-// function error.
+// function error text.
+//
+// If an error diagnostic has a path starting with a list index, such as
+// path.Empty().AtListIndex(0), the index is used as the function argument of
+// the returned function error. The first function argument encountered will
+// be used.
+//
+// The returned function error retains all given diagnostics, including
+// warnings, which are returned by DiagsFromFuncError. Go errors of the
+// diagnostics remain available to errors.Is() and errors.As() checks of the
+// returned function error.
 func FuncErrorFromDiags(ctx context.Context, diags diag.Diagnostics) *FuncError {
-	var funcErr *FuncError
+	var text string
+	var functionArgument *int64
 
 	for _, d := range diags {
 		switch d.Severity() {
 		case diag.SeverityError:
-			funcErr = ConcatFuncErrors(funcErr, NewFuncError(fmt.Sprintf("%s: %s", d.Summary(), d.Detail())))
+			if text != "" {
+				text += "\n"
+			}
+
+			text += fmt.Sprintf("%s: %s", d.Summary(), d.Detail())
+
+			if functionArgument == nil {
+				functionArgument = functionArgumentFromDiagnostic(d)
+			}
 		case diag.SeverityWarning:
 			tflog.Warn(ctx, "warning: call function", map[string]interface{}{"summary": d.Summary(), "detail": d.Detail()})
 		}
 	}
 
-	return funcErr
+	if text == "" && functionArgument == nil {
+		return nil
+	}
+
+	return &FuncError{
+		Text:             text,
+		FunctionArgument: functionArgument,
+		diags:            append(diag.Diagnostics{}, diags...),
+	}
+}
+
+// DiagsFromFuncError returns the diagnostics of the given function error,
+// which is the inverse of FuncErrorFromDiags. Function errors created by
+// FuncErrorFromDiags or ConcatFuncErrors return their original diagnostics.
+// Other function errors return an error diagnostic with the text as the
+// summary and, if the function argument is set, a path of the function
+// argument as a list index, such as path.Empty().AtListIndex(0). Nil or empty
+// function errors return nil diagnostics.
+func DiagsFromFuncError(funcErr *FuncError) diag.Diagnostics {
+	if funcErr == nil {
+		return nil
+	}
+
+	if funcErr.diags != nil {
+		return append(diag.Diagnostics{}, funcErr.diags...)
+	}
+
+	if funcErr.Text == "" && funcErr.FunctionArgument == nil {
+		return nil
+	}
+
+	var d diag.Diagnostic = diag.NewErrorDiagnostic(funcErr.Text, "")
+
+	if funcErr.FunctionArgument != nil {
+		d = diag.WithPath(path.Empty().AtListIndex(int(*funcErr.FunctionArgument)), d)
+	}
+
+	return diag.Diagnostics{d}
+}
+
+// functionArgumentFromDiagnostic returns the function argument position of a
+// diagnostic with a path starting with a list index, otherwise nil.
+func functionArgumentFromDiagnostic(d diag.Diagnostic) *int64 {
+	dp, ok := d.(diag.DiagnosticWithPath)
+
+	if !ok {
+		return nil
+	}
+
+	steps := dp.Path().Steps()
+
+	if len(steps) == 0 {
+		return nil
+	}
+
+	step, ok := steps[0].(path.PathStepElementKeyInt)
+
+	if !ok {
+		return nil
+	}
+
+	functionArgument := int64(step)
+
+	return &functionArgument
 }
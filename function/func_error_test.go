@@ -6,6 +6,8 @@ package function_test
 import (
 	"bytes"
 	"context"
+	"errors"
+	"io/fs"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 func TestFunctionError_Equal(t *testing.T) {
@@ -246,6 +249,46 @@ func TestFuncErrorFromDiags(t *testing.T) {
 				},
 			},
 		},
+		"error-summary-only": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", ""),
+			},
+			expected: function.NewFuncError("one summary: "),
+		},
+		"error-argument-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(1).AtMapKey("key"), "one summary", "one detail"),
+			},
+			expected: function.NewArgumentFuncError(1, "one summary: one detail"),
+		},
+		"error-non-argument-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+			},
+			expected: function.NewFuncError("one summary: one detail"),
+		},
+		"error-multiple-argument-paths": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(2), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(0), "three summary", "three detail"),
+			},
+			expected: function.NewArgumentFuncError(2, "one summary: one detail\ntwo summary: two detail\nthree summary: three detail"),
+		},
+		"warning-argument-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Empty().AtListIndex(1), "one summary", "one detail"),
+			},
+			expectedLog: []map[string]interface{}{
+				{
+					"@level":   "warn",
+					"@message": "warning: call function",
+					"@module":  "provider",
+					"detail":   "one detail",
+					"summary":  "one summary",
+				},
+			},
+		},
 		"multiple": {
 			diags: diag.Diagnostics{
 				diag.NewErrorDiagnostic("one summary", "one detail"),
@@ -301,3 +344,143 @@ func TestFuncErrorFromDiags(t *testing.T) {
 		})
 	}
 }
+
+func TestDiagsFromFuncError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		funcErr  *function.FuncError
+		expected diag.Diagnostics
+	}{
+		"nil": {},
+		"empty": {
+			funcErr: &function.FuncError{},
+		},
+		"text": {
+			funcErr: function.NewFuncError("function error"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("function error", ""),
+			},
+		},
+		"text-argument": {
+			funcErr: function.NewArgumentFuncError(1, "function error"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(1), "function error", ""),
+			},
+		},
+		"from-diags": {
+			funcErr: function.FuncErrorFromDiags(context.Background(), diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(1), "three summary", "three detail"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(1), "three summary", "three detail"),
+			},
+		},
+		"concat": {
+			funcErr: function.ConcatFuncErrors(
+				function.NewFuncError("function error one"),
+				function.FuncErrorFromDiags(context.Background(), diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(0), "two summary", "two detail"),
+				}),
+				&function.FuncError{},
+				function.NewArgumentFuncError(1, "function error three"),
+			),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("function error one", ""),
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(0), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(1), "function error three", ""),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.DiagsFromFuncError(tc.funcErr)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFuncErrorFromDiags_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	pathErr := &fs.PathError{Op: "open", Path: "test", Err: fs.ErrNotExist}
+
+	testCases := map[string]diag.Diagnostics{
+		"error": {
+			diag.NewErrorDiagnostic("one summary", "one detail"),
+		},
+		"error-warning": {
+			diag.NewErrorDiagnostic("one summary", "one detail"),
+			diag.NewWarningDiagnostic("two summary", "two detail"),
+		},
+		"argument-path": {
+			diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(1), "one summary", "one detail"),
+		},
+		"code-error": {
+			diag.WithCode("test_code", diag.NewErrorDiagnosticFromErr("one summary", pathErr)),
+		},
+	}
+
+	for name, diags := range testCases {
+		name, diags := name, diags
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			funcErr := function.FuncErrorFromDiags(context.Background(), diags)
+
+			// Concatenation with a nil function error, as the framework does,
+			// must also preserve the diagnostics.
+			funcErr = function.ConcatFuncErrors(nil, funcErr)
+
+			got := function.DiagsFromFuncError(funcErr)
+
+			if diff := cmp.Diff(got, diags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(function.FuncErrorFromDiags(context.Background(), got), funcErr); diff != "" {
+				t.Errorf("unexpected function error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFuncErrorUnwrap(t *testing.T) {
+	t.Parallel()
+
+	pathErr := &fs.PathError{Op: "open", Path: "test", Err: fs.ErrNotExist}
+
+	funcErr := function.ConcatFuncErrors(
+		function.NewFuncError("function error"),
+		function.FuncErrorFromDiags(context.Background(), diag.Diagnostics{
+			diag.NewErrorDiagnosticFromErr("one summary", pathErr),
+		}),
+	)
+
+	if !errors.Is(funcErr, fs.ErrNotExist) {
+		t.Errorf("expected errors.Is() to match fs.ErrNotExist")
+	}
+
+	var gotPathErr *fs.PathError
+
+	if !errors.As(funcErr, &gotPathErr) || gotPathErr != pathErr {
+		t.Errorf("expected errors.As() to find *fs.PathError")
+	}
+
+	if errors.Unwrap(function.NewFuncError("function error")) != nil {
+		t.Errorf("expected no wrapped error for text only function error")
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestInt32ParameterGetAllowNullValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parameter function.Int32Parameter
+		expected  bool
+	}{
+		"unset": {
+			parameter: function.Int32Parameter{},
+			expected:  false,
+		},
+		"AllowNullValue-false": {
+			parameter: function.Int32Parameter{
+				AllowNullValue: false,
+			},
+			expected: false,
+		},
+		"AllowNullValue-true": {
+			parameter: function.Int32Parameter{
+				AllowNullValue: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.parameter.GetAllowNullValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt32ParameterGetAllowUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parameter function.Int32Parameter
+		expected  bool
+	}{
+		"unset": {
+			parameter: function.Int32Parameter{},
+			expected:  false,
+		},
+		"AllowUnknownValues-false": {
+			parameter: function.Int32Parameter{
+				AllowUnknownValues: false,
+			},
+			expected: false,
+		},
+		"AllowUnknownValues-true": {
+			parameter: function.Int32Parameter{
+				AllowUnknownValues: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.parameter.GetAllowUnknownValues()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt32ParameterGetDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parameter function.Int32Parameter
+		expected  string
+	}{
+		"unset": {
+			parameter: function.Int32Parameter{},
+			expected:  "",
+		},
+		"Description-empty": {
+			parameter: function.Int32Parameter{
+				Description: "",
+			},
+			expected: "",
+		},
+		"Description-nonempty": {
+			parameter: function.Int32Parameter{
+				Description: "test description",
+			},
+			expected: "test description",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.parameter.GetDescription()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt32ParameterGetMarkdownDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parameter function.Int32Parameter
+		expected  string
+	}{
+		"unset": {
+			parameter: function.Int32Parameter{},
+			expected:  "",
+		},
+		"MarkdownDescription-empty": {
+			parameter: function.Int32Parameter{
+				MarkdownDescription: "",
+			},
+			expected: "",
+		},
+		"MarkdownDescription-nonempty": {
+			parameter: function.Int32Parameter{
+				MarkdownDescription: "test description",
+			},
+			expected: "test description",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.parameter.GetMarkdownDescription()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt32ParameterGetName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parameter function.Int32Parameter
+		expected  string
+	}{
+		"unset": {
+			parameter: function.Int32Parameter{},
+			expected:  "",
+		},
+		"Name-nonempty": {
+			parameter: function.Int32Parameter{
+				Name: "test",
+			},
+			expected: "test",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.parameter.GetName()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt32ParameterGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		parameter function.Int32Parameter
+		expected  attr.Type
+	}{
+		"unset": {
+			parameter: function.Int32Parameter{},
+			expected:  basetypes.Int32Type{},
+		},
+		"CustomType": {
+			parameter: function.Int32Parameter{
+				CustomType: testtypes.Int32TypeWithSemanticEquals{},
+			},
+			expected: testtypes.Int32TypeWithSemanticEquals{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.parameter.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
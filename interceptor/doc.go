@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package interceptor contains the interface, request types, and response
+// types for intercepting the operations of a Terraform Provider server, such
+// as creating a resource, reading a data source, or calling a function.
+//
+// Interceptors implement cross-cutting behavior once for every resource, data
+// source, and function, such as adding values to the context, auditing
+// requests, capturing metrics, or adding diagnostics to every response. The
+// [Interceptor] implementations are given to the providerserver package
+// ServeOpts type Interceptors field or to functions such as
+// providerserver.NewProtocol6WithInterceptors.
+package interceptor
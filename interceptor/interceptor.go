@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+import (
+	"context"
+)
+
+// Interceptor is called around framework server operations, such as creating
+// a resource, reading a data source, or calling a function. Schema and
+// metadata RPCs, such as GetProviderSchema, are not intercepted.
+//
+// When a server has multiple interceptors, the Before methods are called in
+// order, then the operation, then the After methods in reverse order, so the
+// first interceptor is the outermost. If a Before method returns an error
+// diagnostic, the operation and the remaining Before methods are skipped,
+// while the After methods of interceptors whose Before method was already
+// called, including the interceptor which returned the error, are still
+// called in reverse order.
+type Interceptor interface {
+	// Before is called before the operation. The BeforeResponse Context
+	// field can be set to modify the context given to the remaining
+	// interceptors, the operation, and the After method of this
+	// interceptor.
+	Before(context.Context, Request, *BeforeResponse)
+
+	// After is called after the operation, or after an error diagnostic
+	// was returned by a Before method. The AfterResponse Diagnostics field
+	// contains all diagnostics so far and replaces the diagnostics of the
+	// operation response.
+	After(context.Context, Request, *AfterResponse)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+// Operation is a framework server operation which can be intercepted. Each
+// operation is handled by calling provider-defined logic, such as a resource
+// Create method. The ApplyResourceChange RPC is intercepted as the
+// OperationCreateResource, OperationUpdateResource, or OperationDeleteResource
+// operation.
+type Operation string
+
+const (
+	// OperationCallFunction is the CallFunction RPC.
+	OperationCallFunction Operation = "CallFunction"
+
+	// OperationConfigureProvider is the ConfigureProvider RPC.
+	OperationConfigureProvider Operation = "ConfigureProvider"
+
+	// OperationCreateResource is the ApplyResourceChange RPC when a resource
+	// is created.
+	OperationCreateResource Operation = "CreateResource"
+
+	// OperationDeleteResource is the ApplyResourceChange RPC when a resource
+	// is destroyed.
+	OperationDeleteResource Operation = "DeleteResource"
+
+	// OperationImportResourceState is the ImportResourceState RPC.
+	OperationImportResourceState Operation = "ImportResourceState"
+
+	// OperationMoveResourceState is the MoveResourceState RPC.
+	OperationMoveResourceState Operation = "MoveResourceState"
+
+	// OperationPlanResourceChange is the PlanResourceChange RPC.
+	OperationPlanResourceChange Operation = "PlanResourceChange"
+
+	// OperationReadDataSource is the ReadDataSource RPC.
+	OperationReadDataSource Operation = "ReadDataSource"
+
+	// OperationReadResource is the ReadResource RPC.
+	OperationReadResource Operation = "ReadResource"
+
+	// OperationUpdateResource is the ApplyResourceChange RPC when a resource
+	// is updated.
+	OperationUpdateResource Operation = "UpdateResource"
+
+	// OperationUpgradeResourceState is the UpgradeResourceState RPC.
+	OperationUpgradeResourceState Operation = "UpgradeResourceState"
+
+	// OperationValidateDataSourceConfig is the ValidateDataSourceConfig RPC.
+	OperationValidateDataSourceConfig Operation = "ValidateDataSourceConfig"
+
+	// OperationValidateProviderConfig is the ValidateProviderConfig RPC.
+	OperationValidateProviderConfig Operation = "ValidateProviderConfig"
+
+	// OperationValidateResourceConfig is the ValidateResourceConfig RPC.
+	OperationValidateResourceConfig Operation = "ValidateResourceConfig"
+)
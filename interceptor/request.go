@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Request represents an operation request. Fields which are not applicable to
+// the operation are the zero value. Data is copied from the operation request,
+// so modifications do not affect the operation.
+type Request struct {
+	// Operation is the intercepted operation.
+	Operation Operation
+
+	// TypeName is the resource type name for resource operations, the data
+	// source type name for data source operations, or the function name for
+	// OperationCallFunction. It is empty for provider operations. For
+	// OperationMoveResourceState, it is the target resource type name.
+	TypeName string
+
+	// Arguments is the function arguments for OperationCallFunction.
+	Arguments function.ArgumentsData
+
+	// Config is the configuration for the OperationConfigureProvider,
+	// OperationCreateResource, OperationPlanResourceChange,
+	// OperationReadDataSource, OperationUpdateResource, and validation
+	// operations.
+	Config *tfsdk.Config
+
+	// ID is the import identifier for OperationImportResourceState.
+	ID string
+
+	// Plan is the planned state for OperationCreateResource and
+	// OperationUpdateResource, or the proposed new state for
+	// OperationPlanResourceChange.
+	Plan *tfsdk.Plan
+
+	// ProviderMeta is the provider_meta configuration, if the provider
+	// defines a meta schema.
+	ProviderMeta *tfsdk.Config
+
+	// State is the prior state for OperationDeleteResource,
+	// OperationPlanResourceChange, OperationReadResource, and
+	// OperationUpdateResource.
+	State *tfsdk.State
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptor
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// BeforeResponse represents a response to an Interceptor Before method. An
+// instance of this response struct is supplied as an argument to the Before
+// method.
+type BeforeResponse struct {
+	// Context is the context for the remaining interceptors, the operation,
+	// and the After method of the interceptor. Defaults to the context
+	// given to the Before method.
+	Context context.Context
+
+	// Diagnostics report errors or warnings related to the operation. An
+	// error diagnostic prevents the operation from being called. Warning
+	// diagnostics are included in the operation response.
+	Diagnostics diag.Diagnostics
+}
+
+// AfterResponse represents a response to an Interceptor After method. An
+// instance of this response struct is supplied as an argument to the After
+// method.
+type AfterResponse struct {
+	// Diagnostics contains the diagnostics of the operation and any
+	// interceptors so far. Diagnostics can be appended, or replaced, and
+	// become the diagnostics of the operation response. For
+	// OperationCallFunction, the diagnostics are converted to and from the
+	// function error with function.DiagsFromFuncError and
+	// function.FuncErrorFromDiags.
+	Diagnostics diag.Diagnostics
+
+	// State is the new state for OperationCreateResource,
+	// OperationDeleteResource, OperationMoveResourceState,
+	// OperationReadDataSource, OperationReadResource,
+	// OperationUpdateResource, and OperationUpgradeResourceState, or the
+	// planned state for OperationPlanResourceChange. It is nil if the
+	// operation was not called or did not return a state. Data is copied
+	// from the operation response, so modifications do not affect the
+	// response.
+	State *tfsdk.State
+}
@@ -41,6 +41,7 @@ func ApplyResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.ApplyReso
 	fw := &fwserver.ApplyResourceChangeRequest{
 		ResourceSchema: resourceSchema,
 		Resource:       resource,
+		TypeName:       proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, resourceSchema)
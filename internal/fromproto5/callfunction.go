@@ -22,6 +22,7 @@ func CallFunctionRequest(ctx context.Context, proto *tfprotov5.CallFunctionReque
 	fw := &fwserver.CallFunctionRequest{
 		Function:           function,
 		FunctionDefinition: functionDefinition,
+		Name:               proto.Name,
 	}
 
 	arguments, diags := ArgumentsData(ctx, proto.Arguments, functionDefinition)
@@ -41,6 +41,7 @@ func PlanResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.PlanResour
 	fw := &fwserver.PlanResourceChangeRequest{
 		ResourceSchema: resourceSchema,
 		Resource:       resource,
+		TypeName:       proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, resourceSchema)
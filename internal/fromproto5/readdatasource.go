@@ -39,6 +39,7 @@ func ReadDataSourceRequest(ctx context.Context, proto5 *tfprotov5.ReadDataSource
 	fw := &fwserver.ReadDataSourceRequest{
 		DataSource:       dataSource,
 		DataSourceSchema: dataSourceSchema,
+		TypeName:         proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, dataSourceSchema)
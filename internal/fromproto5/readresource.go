@@ -26,6 +26,7 @@ func ReadResourceRequest(ctx context.Context, proto5 *tfprotov5.ReadResourceRequ
 
 	fw := &fwserver.ReadResourceRequest{
 		Resource: resource,
+		TypeName: proto5.TypeName,
 	}
 
 	currentState, currentStateDiags := State(ctx, proto5.CurrentState, resourceSchema)
@@ -41,6 +41,7 @@ func UpgradeResourceStateRequest(ctx context.Context, proto5 *tfprotov5.UpgradeR
 		RawState:       (*tfprotov6.RawState)(proto5.RawState),
 		ResourceSchema: resourceSchema,
 		Resource:       resource,
+		TypeName:       proto5.TypeName,
 		Version:        proto5.Version,
 	}
 
@@ -41,6 +41,7 @@ func PlanResourceChangeRequest(ctx context.Context, proto6 *tfprotov6.PlanResour
 	fw := &fwserver.PlanResourceChangeRequest{
 		ResourceSchema: resourceSchema,
 		Resource:       resource,
+		TypeName:       proto6.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto6.Config, resourceSchema)
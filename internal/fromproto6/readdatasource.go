@@ -39,6 +39,7 @@ func ReadDataSourceRequest(ctx context.Context, proto6 *tfprotov6.ReadDataSource
 	fw := &fwserver.ReadDataSourceRequest{
 		DataSourceSchema: dataSourceSchema,
 		DataSource:       dataSource,
+		TypeName:         proto6.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto6.Config, dataSourceSchema)
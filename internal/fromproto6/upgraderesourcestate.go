@@ -40,6 +40,7 @@ func UpgradeResourceStateRequest(ctx context.Context, proto6 *tfprotov6.UpgradeR
 		RawState:       proto6.RawState,
 		ResourceSchema: resourceSchema,
 		Resource:       resource,
+		TypeName:       proto6.TypeName,
 		Version:        proto6.Version,
 	}
 
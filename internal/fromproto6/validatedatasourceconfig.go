@@ -26,6 +26,7 @@ func ValidateDataSourceConfigRequest(ctx context.Context, proto6 *tfprotov6.Vali
 
 	fw.Config = config
 	fw.DataSource = dataSource
+	fw.TypeName = proto6.TypeName
 
 	return fw, diags
 }
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// DependenciesRequest is the request for resolving the dependency paths of a
+// plan modifier.
+type DependenciesRequest struct {
+	// Path is the path of the attribute being modified. It is excluded from
+	// the dependency paths.
+	Path path.Path
+
+	// PathExpression is the expression of the attribute being modified.
+	// Relative dependency expressions are merged with this expression.
+	PathExpression path.Expression
+
+	// Expressions are the dependency path expressions.
+	Expressions path.Expressions
+
+	// Plan is the planned new state for the resource.
+	Plan tfsdk.Plan
+
+	// State is the current state of the resource.
+	State tfsdk.State
+}
+
+// DependencyPaths returns all paths in the plan which match the dependency
+// expressions, in expression order and excluding the attribute path itself.
+func DependencyPaths(ctx context.Context, req DependenciesRequest) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var result path.Paths
+
+	for _, expression := range req.PathExpression.MergeExpressions(req.Expressions...) {
+		matchedPaths, matchDiags := req.Plan.PathMatches(ctx, expression)
+
+		diags.Append(matchDiags...)
+
+		// Collect all errors
+		if matchDiags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			if matchedPath.Equal(req.Path) {
+				continue
+			}
+
+			result.Append(matchedPath)
+		}
+	}
+
+	return result, diags
+}
+
+// DependencyPlanValues returns the dependency paths and their planned values.
+func DependencyPlanValues(ctx context.Context, req DependenciesRequest) (path.Paths, []attr.Value, diag.Diagnostics) {
+	paths, diags := DependencyPaths(ctx, req)
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	values := make([]attr.Value, 0, len(paths))
+
+	for _, p := range paths {
+		var value attr.Value
+
+		diags.Append(req.Plan.GetAttribute(ctx, p, &value)...)
+
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		values = append(values, value)
+	}
+
+	return paths, values, diags
+}
+
+// DependenciesChanged returns true if the planned value of any dependency
+// path is unknown or differs from its prior state value. All dependencies are
+// considered changed if there is no prior state.
+func DependenciesChanged(ctx context.Context, req DependenciesRequest) (bool, diag.Diagnostics) {
+	if req.State.Raw.IsNull() {
+		return true, nil
+	}
+
+	paths, planValues, diags := DependencyPlanValues(ctx, req)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for i, p := range paths {
+		if planValues[i] == nil || planValues[i].IsUnknown() {
+			return true, diags
+		}
+
+		var stateValue attr.Value
+
+		diags.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if !planValues[i].Equal(stateValue) {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+// ValuesKnown returns true if all given values are known.
+func ValuesKnown(values []attr.Value) bool {
+	for _, value := range values {
+		if value == nil || value.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
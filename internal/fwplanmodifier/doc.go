@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwplanmodifier contains shared logic for the plan modifier
+// implementations in the typed resource/schema plan modifier packages.
+package fwplanmodifier
@@ -15,6 +15,14 @@ type AttributeWithBoolDefaultValue interface {
 	BoolDefaultValue() defaults.Bool
 }
 
+// AttributeWithFloat32DefaultValue is an optional interface on Attribute which
+// enables Float32 default value support.
+type AttributeWithFloat32DefaultValue interface {
+	Attribute
+
+	Float32DefaultValue() defaults.Float32
+}
+
 // AttributeWithFloat64DefaultValue is an optional interface on Attribute which
 // enables Float64 default value support.
 type AttributeWithFloat64DefaultValue interface {
@@ -23,6 +31,14 @@ type AttributeWithFloat64DefaultValue interface {
 	Float64DefaultValue() defaults.Float64
 }
 
+// AttributeWithInt32DefaultValue is an optional interface on Attribute which
+// enables Int32 default value support.
+type AttributeWithInt32DefaultValue interface {
+	Attribute
+
+	Int32DefaultValue() defaults.Int32
+}
+
 // AttributeWithInt64DefaultValue is an optional interface on Attribute which
 // enables Int64 default value support.
 type AttributeWithInt64DefaultValue interface {
@@ -78,3 +94,11 @@ type AttributeWithStringDefaultValue interface {
 
 	StringDefaultValue() defaults.String
 }
+
+// AttributeWithTupleDefaultValue is an optional interface on Attribute which
+// enables Tuple default value support.
+type AttributeWithTupleDefaultValue interface {
+	Attribute
+
+	TupleDefaultValue() defaults.Tuple
+}
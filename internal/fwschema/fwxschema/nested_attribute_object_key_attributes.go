@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwxschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// NestedAttributeObjectWithKeyAttributes is an optional interface on
+// NestedAttributeObject which enables correlating list and set elements with
+// prior state elements by underlying attribute values, rather than by
+// position or by whole element value.
+type NestedAttributeObjectWithKeyAttributes interface {
+	fwschema.NestedAttributeObject
+
+	// GetKeyAttributes should return the names of the underlying attributes
+	// which uniquely identify an element of the collection.
+	GetKeyAttributes() []string
+}
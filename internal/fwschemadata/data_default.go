@@ -95,6 +95,29 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 
 			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
 
+			return resp.PlanValue.ToTerraformValue(ctx)
+		case fwschema.AttributeWithFloat32DefaultValue:
+			defaultValue := a.Float32DefaultValue()
+
+			if defaultValue == nil {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Float32Request{
+				Path: fwPath,
+			}
+			resp := defaults.Float32Response{}
+
+			defaultValue.DefaultFloat32(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+
 			return resp.PlanValue.ToTerraformValue(ctx)
 		case fwschema.AttributeWithFloat64DefaultValue:
 			defaultValue := a.Float64DefaultValue()
@@ -119,6 +142,30 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
 
 			return resp.PlanValue.ToTerraformValue(ctx)
+		case fwschema.AttributeWithInt32DefaultValue:
+			defaultValue := a.Int32DefaultValue()
+
+			if defaultValue == nil {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Int32Request{
+				Path: fwPath,
+			}
+			resp := defaults.Int32Response{}
+
+			defaultValue.DefaultInt32(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+
+			return resp.PlanValue.ToTerraformValue(ctx)
+
 		case fwschema.AttributeWithInt64DefaultValue:
 			defaultValue := a.Int64DefaultValue()
 
@@ -297,6 +344,29 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 
 			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
 
+			return resp.PlanValue.ToTerraformValue(ctx)
+		case fwschema.AttributeWithTupleDefaultValue:
+			defaultValue := a.TupleDefaultValue()
+
+			if defaultValue == nil {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.TupleRequest{
+				Path: fwPath,
+			}
+			resp := defaults.TupleResponse{}
+
+			defaultValue.DefaultTuple(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+
 			return resp.PlanValue.ToTerraformValue(ctx)
 		}
 
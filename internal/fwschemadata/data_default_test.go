@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/tupledefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -4087,6 +4088,97 @@ func TestDataDefault(t *testing.T) {
 				),
 			},
 		},
+		"tuple-attribute-null-modified-default": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tuple_attribute": testschema.AttributeWithTupleDefaultValue{
+							Optional:     true,
+							ElementTypes: []attr.Type{types.StringType, types.Int64Type},
+							Default: tupledefault.StaticValue(
+								types.TupleValueMust(
+									[]attr.Type{types.StringType, types.Int64Type},
+									[]attr.Value{
+										types.StringValue("two"),
+										types.Int64Value(2),
+									},
+								),
+							),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"tuple_attribute": tftypes.Tuple{
+								ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"tuple_attribute": tftypes.NewValue(tftypes.Tuple{
+							ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+						}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.Number, 1),
+						}),
+					},
+				),
+			},
+			rawConfig: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tuple_attribute": tftypes.Tuple{
+							ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"tuple_attribute": tftypes.NewValue(tftypes.Tuple{
+						ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+					}, nil,
+					),
+				},
+			),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tuple_attribute": testschema.AttributeWithTupleDefaultValue{
+							Optional:     true,
+							ElementTypes: []attr.Type{types.StringType, types.Int64Type},
+							Default: tupledefault.StaticValue(
+								types.TupleValueMust(
+									[]attr.Type{types.StringType, types.Int64Type},
+									[]attr.Value{
+										types.StringValue("two"),
+										types.Int64Value(2),
+									},
+								),
+							),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"tuple_attribute": tftypes.Tuple{
+								ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"tuple_attribute": tftypes.NewValue(tftypes.Tuple{
+							ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+						}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "two"),
+							tftypes.NewValue(tftypes.Number, 2),
+						}),
+					},
+				),
+			},
+		},
 		"list-nested-attribute-not-null-unmodified-default": {
 			data: &fwschemadata.Data{
 				Description: fwschemadata.DataDescriptionState,
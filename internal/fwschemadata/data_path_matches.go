@@ -6,6 +6,7 @@ package fwschemadata
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,7 +17,11 @@ import (
 //
 // If a parent path is null or unknown, which would prevent a full expression
 // from matching, the parent path is returned rather than no match to prevent
-// false positives.
+// false positives. This does not apply to expressions with recursive descent
+// steps, where any path could be a parent path.
+//
+// Expression steps which filter elements by value, such as
+// path.ExpressionStepElementPredicate, are matched against the data values.
 func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var paths path.Paths
@@ -33,6 +38,26 @@ func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 		return paths, diags
 	}
 
+	// Element values are only fetched for expressions with element
+	// predicate steps, which must match list and map element values.
+	valueAt := func(elementPath path.Path) attr.Value {
+		value, valueDiags := d.ValueAtPath(ctx, elementPath)
+
+		if valueDiags.HasError() {
+			return nil
+		}
+
+		return value
+	}
+
+	var recursiveDescent bool
+
+	for _, step := range pathExpr.Steps() {
+		if _, ok := step.(path.ExpressionStepRecursiveDescent); ok {
+			recursiveDescent = true
+		}
+	}
+
 	_ = tftypes.Walk(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
 		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
 
@@ -44,20 +69,23 @@ func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 			return false, nil
 		}
 
-		if pathExpr.Matches(fwPath) {
-			paths.Append(fwPath)
+		matched := pathExpr.MatchesWithValues(fwPath, valueAt)
 
-			// If we matched, there is no need to traverse further since a
-			// deeper path will never match.
-			return false, nil
+		if matched {
+			paths.Append(fwPath)
 		}
 
 		// If current path cannot be parent path, there is no need to traverse
-		// further since a deeper path will never match.
-		if !pathExpr.MatchesParent(fwPath) {
+		// further since a deeper path will never match. Expressions with
+		// recursive descent steps can match both a path and deeper paths.
+		if !pathExpr.MatchesParentWithValues(fwPath, valueAt) {
 			return false, nil
 		}
 
+		if matched {
+			return true, nil
+		}
+
 		// If value at current path (now known to be a parent path of the
 		// expression) is null or unknown, return it as a valid path match
 		// since Walk will stop traversing deeper anyways and we want
@@ -67,8 +95,14 @@ func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 		// at this parent path will return a potentially unexpected type,
 		// however this is an implementation tradeoff to prevent false
 		// positives of missing null or unknown values.
+		//
+		// Expressions with recursive descent steps are skipped, since every
+		// path is potentially a parent path and every null or unknown value
+		// would be returned.
 		if tfTypeValue.IsNull() || !tfTypeValue.IsKnown() {
-			paths.Append(fwPath)
+			if !recursiveDescent {
+				paths.Append(fwPath)
+			}
 
 			return false, nil
 		}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -1373,6 +1374,714 @@ func TestDataPathMatches(t *testing.T) {
 				),
 			},
 		},
+		"AttributeNameExact-ElementPredicate-list-match": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-0"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-1"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-y"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-2"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtElementWhere("test_type", types.StringValue("test-type-x")),
+			expected: path.Paths{
+				path.Root("test_parent").AtListIndex(0),
+				path.Root("test_parent").AtListIndex(2),
+			},
+		},
+		"AttributeNameExact-ElementPredicate-list-mismatch": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-0"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-1"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-y"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-2"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtElementWhere("test_type", types.StringValue("test-type-z")),
+			expected:   nil,
+		},
+		"AttributeNameExact-ElementPredicate-list-parent-null": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						nil,
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtElementWhere("test_type", types.StringValue("test-type-x")),
+			expected: path.Paths{
+				path.Root("test_parent"),
+			},
+		},
+		"AttributeNameExact-ElementPredicate-AttributeNameExact-list-match": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-0"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-1"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-y"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-2"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtElementWhere("test_type", types.StringValue("test-type-x")).AtName("test_name"),
+			expected: path.Paths{
+				path.Root("test_parent").AtListIndex(0).AtName("test_name"),
+				path.Root("test_parent").AtListIndex(2).AtName("test_name"),
+			},
+		},
+		"AttributeNameExact-ElementPredicate-map-match": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeMap,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.Map{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.Map{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"key-0": tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-0"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+							"key-1": tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-1"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-y"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtElementWhere("test_type", types.StringValue("test-type-x")),
+			expected: path.Paths{
+				path.Root("test_parent").AtMapKey("key-0"),
+			},
+		},
+		"AttributeNameExact-ElementPredicate-set-match": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeSet,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.Set{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.Set{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-0"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-1"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-y"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtElementWhere("test_type", types.StringValue("test-type-x")),
+			expected: path.Paths{
+				path.Root("test_parent").AtSetValue(types.ObjectValueMust(
+					map[string]attr.Type{
+						"test_name": types.StringType,
+						"test_type": types.StringType,
+					},
+					map[string]attr.Value{
+						"test_name": types.StringValue("test-name-0"),
+						"test_type": types.StringValue("test-type-x"),
+					},
+				)),
+			},
+		},
+		"RecursiveDescent-match": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-0"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-1"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-y"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRecursiveDescent("test_name"),
+			expected: path.Paths{
+				path.Root("test_parent").AtListIndex(0).AtName("test_name"),
+				path.Root("test_parent").AtListIndex(1).AtName("test_name"),
+			},
+		},
+		"RecursiveDescent-parent-null": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						nil,
+					),
+				},
+			),
+			expression: path.MatchRecursiveDescent("test_name"),
+			expected:   nil,
+		},
+		"RecursiveDescent-mismatch": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_name": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_type": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_name": tftypes.String,
+									"test_type": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_name": tftypes.String,
+										"test_type": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_name": tftypes.NewValue(tftypes.String, "test-name-0"),
+									"test_type": tftypes.NewValue(tftypes.String, "test-type-x"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRecursiveDescent("not_test"),
+			expected:   nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: ..not_test",
+				),
+			},
+		},
+		"RecursiveDescent-nested-match": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeSingle,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.String,
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.String, "test-value"),
+						},
+					),
+				},
+			),
+			expression: path.MatchRecursiveDescent("test"),
+			expected: path.Paths{
+				path.Root("test"),
+				path.Root("test").AtName("test"),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
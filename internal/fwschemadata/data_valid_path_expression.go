@@ -68,6 +68,28 @@ func validatePathExpressionSteps(ctx context.Context, currentType attr.Type, cur
 		}
 
 		currentTfStep = tftypes.ElementKeyValue(tfValue)
+	case path.ExpressionStepRecursiveDescent:
+		return validateRecursiveDescentExpressionSteps(ctx, currentType, string(step), nextSteps)
+	case path.ExpressionStepElementPredicate:
+		elementType, ok := currentType.(attr.TypeWithElementType)
+
+		if !ok {
+			return false
+		}
+
+		objectType, ok := elementType.ElementType().(attr.TypeWithAttributeTypes)
+
+		if !ok {
+			return false
+		}
+
+		attributeType, ok := objectType.AttributeTypes()[step.AttributeName]
+
+		if !ok || attributeType == nil {
+			return false
+		}
+
+		return validatePathExpressionSteps(ctx, elementType.ElementType(), nextSteps)
 	default:
 		// If new, resolved path.ExpressionStep are introduced, they must be
 		// added as cases to this switch statement.
@@ -100,3 +122,33 @@ func validatePathExpressionSteps(ctx context.Context, currentType attr.Type, cur
 
 	return validatePathExpressionSteps(ctx, nextType, nextSteps)
 }
+
+// validateRecursiveDescentExpressionSteps is a recursive function which
+// returns true if an attribute with the given name, at any depth within the
+// type, can have the remaining path expression steps applied to it.
+func validateRecursiveDescentExpressionSteps(ctx context.Context, currentType attr.Type, name string, nextSteps path.ExpressionSteps) bool {
+	switch t := currentType.(type) {
+	case attr.TypeWithAttributeTypes:
+		attributeTypes := t.AttributeTypes()
+
+		if attributeType, ok := attributeTypes[name]; ok && validatePathExpressionSteps(ctx, attributeType, nextSteps) {
+			return true
+		}
+
+		for _, attributeType := range attributeTypes {
+			if validateRecursiveDescentExpressionSteps(ctx, attributeType, name, nextSteps) {
+				return true
+			}
+		}
+	case attr.TypeWithElementType:
+		return validateRecursiveDescentExpressionSteps(ctx, t.ElementType(), name, nextSteps)
+	case attr.TypeWithElementTypes:
+		for _, elementType := range t.ElementTypes() {
+			if validateRecursiveDescentExpressionSteps(ctx, elementType, name, nextSteps) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -252,6 +252,251 @@ func TestDataValidPathExpression(t *testing.T) {
 			expression: path.MatchRoot("test").AtSetValue(types.StringValue("test-value")),
 			expected:   false,
 		},
+		"AttributeNameExact-ElementPredicate-list-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtElementWhere("nested_test", types.StringValue("test-value")),
+			expected:   true,
+		},
+		"AttributeNameExact-ElementPredicate-map-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.MapType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtElementWhere("nested_test", types.StringValue("test-value")).AtName("nested_test"),
+			expected:   true,
+		},
+		"AttributeNameExact-ElementPredicate-set-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.SetType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtElementWhere("nested_test", types.StringValue("test-value")),
+			expected:   true,
+		},
+		"AttributeNameExact-ElementPredicate-mismatch-attribute": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtElementWhere("not_test", types.StringValue("test-value")),
+			expected:   false,
+		},
+		"AttributeNameExact-ElementPredicate-mismatch-next-step": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtElementWhere("nested_test", types.StringValue("test-value")).AtName("not_test"),
+			expected:   false,
+		},
+		"AttributeNameExact-ElementPredicate-mismatch-type": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.ListType{ElemType: types.StringType},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtElementWhere("nested_test", types.StringValue("test-value")),
+			expected:   false,
+		},
+		"RecursiveDescent-match-root": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+			expression: path.MatchRecursiveDescent("test"),
+			expected:   true,
+		},
+		"RecursiveDescent-match-nested": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRecursiveDescent("nested_test"),
+			expected:   true,
+		},
+		"RecursiveDescent-match-tuple": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.TupleType{
+								ElemTypes: []attr.Type{
+									types.StringType,
+									types.ObjectType{
+										AttrTypes: map[string]attr.Type{
+											"nested_test": types.StringType,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRecursiveDescent("nested_test"),
+			expected:   true,
+		},
+		"RecursiveDescent-mismatch": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRecursiveDescent("not_test"),
+			expected:   false,
+		},
+		"RecursiveDescent-mismatch-next-step": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRecursiveDescent("nested_test").AtAnyListIndex(),
+			expected:   false,
+		},
+		"AttributeNameExact-RecursiveDescent-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"nested_test": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtRecursiveDescent("nested_test"),
+			expected:   true,
+		},
+		"AttributeNameExact-RecursiveDescent-mismatch": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtRecursiveDescent("test"),
+			expected:   false,
+		},
 	}
 
 	for name, testCase := range testCases {
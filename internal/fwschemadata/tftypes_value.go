@@ -62,11 +62,11 @@ func CreateParentTerraformValue(_ context.Context, parentPath path.Path, parentT
 // value will be added.
 //
 // Lists can only have the next element added according to the current length.
+// Tuples can only have existing elements overwritten, since their length is
+// fixed by the type.
 func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentValue tftypes.Value, childStep path.PathStep, childValue tftypes.Value) (tftypes.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	// TODO: Add Tuple support
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/54
 	switch childStep := childStep.(type) {
 	case path.PathStepAttributeName:
 		// Set in Object
@@ -96,6 +96,37 @@ func UpsertChildTerraformValue(_ context.Context, parentPath path.Path, parentVa
 		parentAttrs[string(childStep)] = childValue
 		parentValue = tftypes.NewValue(parentValue.Type(), parentAttrs)
 	case path.PathStepElementKeyInt:
+		// Set existing Tuple element
+		if parentValue.Type().Is(tftypes.Tuple{}) {
+			var parentElems []tftypes.Value
+			err := parentValue.Copy().As(&parentElems)
+
+			if err != nil {
+				diags.AddAttributeError(
+					parentPath,
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						fmt.Sprintf("Unable to extract tuple elements from parent value: %s", err),
+				)
+				return parentValue, diags
+			}
+
+			if int(childStep) < 0 || int(childStep) >= len(parentElems) {
+				diags.AddAttributeError(
+					parentPath,
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						fmt.Sprintf("Cannot set tuple element %d as tuple has %d elements.", int(childStep), len(parentElems)),
+				)
+				return parentValue, diags
+			}
+
+			parentElems[int(childStep)] = childValue
+			parentValue = tftypes.NewValue(parentValue.Type(), parentElems)
+
+			break
+		}
+
 		// Upsert List element, except past length + 1
 		if !parentValue.Type().Is(tftypes.List{}) {
 			diags.AddAttributeError(
@@ -490,6 +490,69 @@ func TestUpsertChildTerraformValue(t *testing.T) {
 				tftypes.NewValue(tftypes.String, "two"),
 			}),
 		},
+		"Tuple-overwrite": {
+			parentType: tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			},
+			parentValue: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.Number, 1),
+			}),
+			childStep:  path.PathStepElementKeyInt(1),
+			childValue: tftypes.NewValue(tftypes.Number, 2),
+			expected: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.Number, 2),
+			}),
+		},
+		"Tuple-write": {
+			parentType: tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			},
+			parentValue: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.Number, nil),
+			}),
+			childStep:  path.PathStepElementKeyInt(0),
+			childValue: tftypes.NewValue(tftypes.String, "one"),
+			expected: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.Number, nil),
+			}),
+		},
+		"Tuple-write-length-error": {
+			parentType: tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String},
+			},
+			parentValue: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+			childStep:  path.PathStepElementKeyInt(1),
+			childValue: tftypes.NewValue(tftypes.String, "two"),
+			expected: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot set tuple element 1 as tuple has 1 elements.",
+				),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
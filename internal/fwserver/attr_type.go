@@ -26,6 +26,21 @@ func coerceBoolTypable(ctx context.Context, schemaPath path.Path, valuable baset
 	return typable, nil
 }
 
+func coerceFloat32Typable(ctx context.Context, schemaPath path.Path, valuable basetypes.Float32Valuable) (basetypes.Float32Typable, diag.Diagnostics) {
+	typable, ok := valuable.Type(ctx).(basetypes.Float32Typable)
+
+	// Type() of a Valuable should always be a Typable to recreate the Valuable,
+	// but if for some reason it is not, raise an implementation error instead
+	// of a panic.
+	if !ok {
+		return nil, diag.Diagnostics{
+			attributePlanModificationTypableError(schemaPath, valuable),
+		}
+	}
+
+	return typable, nil
+}
+
 func coerceFloat64Typable(ctx context.Context, schemaPath path.Path, valuable basetypes.Float64Valuable) (basetypes.Float64Typable, diag.Diagnostics) {
 	typable, ok := valuable.Type(ctx).(basetypes.Float64Typable)
 
@@ -41,6 +56,21 @@ func coerceFloat64Typable(ctx context.Context, schemaPath path.Path, valuable ba
 	return typable, nil
 }
 
+func coerceInt32Typable(ctx context.Context, schemaPath path.Path, valuable basetypes.Int32Valuable) (basetypes.Int32Typable, diag.Diagnostics) {
+	typable, ok := valuable.Type(ctx).(basetypes.Int32Typable)
+
+	// Type() of a Valuable should always be a Typable to recreate the Valuable,
+	// but if for some reason it is not, raise an implementation error instead
+	// of a panic.
+	if !ok {
+		return nil, diag.Diagnostics{
+			attributePlanModificationTypableError(schemaPath, valuable),
+		}
+	}
+
+	return typable, nil
+}
+
 func coerceInt64Typable(ctx context.Context, schemaPath path.Path, valuable basetypes.Int64Valuable) (basetypes.Int64Typable, diag.Diagnostics) {
 	typable, ok := valuable.Type(ctx).(basetypes.Int64Typable)
 
@@ -145,3 +175,18 @@ func coerceStringTypable(ctx context.Context, schemaPath path.Path, valuable bas
 
 	return typable, nil
 }
+
+func coerceTupleTypable(ctx context.Context, schemaPath path.Path, valuable basetypes.TupleValuable) (basetypes.TupleTypable, diag.Diagnostics) {
+	typable, ok := valuable.Type(ctx).(basetypes.TupleTypable)
+
+	// Type() of a Valuable should always be a Typable to recreate the Valuable,
+	// but if for some reason it is not, raise an implementation error instead
+	// of a panic.
+	if !ok {
+		return nil, diag.Diagnostics{
+			attributePlanModificationTypableError(schemaPath, valuable),
+		}
+	}
+
+	return typable, nil
+}
@@ -242,3 +242,73 @@ func setElemObjectFromTerraformValue(ctx context.Context, schemaPath path.Path,
 
 	return coerceObjectValue(ctx, schemaPath, elemValue)
 }
+
+// listElemObjectByKeyAttributes returns the list element object which has the
+// same key attribute values as the given object. A null object is returned if
+// no element matches.
+func listElemObjectByKeyAttributes(ctx context.Context, schemaPath path.Path, list types.List, keyObject types.Object, keyAttributes []string, description fwschemadata.DataDescription) (types.Object, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return listElemObject(ctx, schemaPath, list, 0, description)
+	}
+
+	for _, elem := range list.Elements() {
+		elemObject, diags := coerceObjectValue(ctx, schemaPath, elem)
+
+		if diags.HasError() {
+			return elemObject, diags
+		}
+
+		if objectKeyAttributesEqual(keyObject, elemObject, keyAttributes) {
+			return elemObject, diags
+		}
+	}
+
+	return listElemObjectFromTerraformValue(ctx, schemaPath, list, description, nil)
+}
+
+// objectKeyAttributesEqual returns true if both objects are known and have
+// equal, known, and non-null values for all key attributes.
+func objectKeyAttributesEqual(a, b types.Object, keyAttributes []string) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+
+	for _, keyAttribute := range keyAttributes {
+		aValue, ok := a.Attributes()[keyAttribute]
+
+		if !ok || aValue == nil || aValue.IsNull() || aValue.IsUnknown() {
+			return false
+		}
+
+		bValue, ok := b.Attributes()[keyAttribute]
+
+		if !ok || bValue == nil || !aValue.Equal(bValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setElemObjectByKeyAttributes returns the set element object which has the
+// same key attribute values as the given object. A null object is returned if
+// no element matches.
+func setElemObjectByKeyAttributes(ctx context.Context, schemaPath path.Path, set types.Set, keyObject types.Object, keyAttributes []string, description fwschemadata.DataDescription) (types.Object, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return setElemObject(ctx, schemaPath, set, 0, description)
+	}
+
+	for _, elem := range set.Elements() {
+		elemObject, diags := coerceObjectValue(ctx, schemaPath, elem)
+
+		if diags.HasError() {
+			return elemObject, diags
+		}
+
+		if objectKeyAttributesEqual(keyObject, elemObject, keyAttributes) {
+			return elemObject, diags
+		}
+	}
+
+	return setElemObjectFromTerraformValue(ctx, schemaPath, set, description, nil)
+}
@@ -92,8 +92,12 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 	switch attributeWithPlanModifiers := a.(type) {
 	case fwxschema.AttributeWithBoolPlanModifiers:
 		AttributePlanModifyBool(ctx, attributeWithPlanModifiers, req, resp)
+	case fwxschema.AttributeWithFloat32PlanModifiers:
+		AttributePlanModifyFloat32(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithFloat64PlanModifiers:
 		AttributePlanModifyFloat64(ctx, attributeWithPlanModifiers, req, resp)
+	case fwxschema.AttributeWithInt32PlanModifiers:
+		AttributePlanModifyInt32(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithInt64PlanModifiers:
 		AttributePlanModifyInt64(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithListPlanModifiers:
@@ -108,6 +112,8 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 		AttributePlanModifySet(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithStringPlanModifiers:
 		AttributePlanModifyString(ctx, attributeWithPlanModifiers, req, resp)
+	case fwxschema.AttributeWithTuplePlanModifiers:
+		AttributePlanModifyTuple(ctx, attributeWithPlanModifiers, req, resp)
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -127,6 +133,14 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 
 	nestedAttributeObject := nestedAttribute.GetNestedObject()
 
+	// Key attributes, if declared, are used to correlate planned list and set
+	// elements with prior state elements.
+	var keyAttributes []string
+
+	if objectWithKeyAttributes, ok := nestedAttributeObject.(fwxschema.NestedAttributeObjectWithKeyAttributes); ok {
+		keyAttributes = objectWithKeyAttributes.GetKeyAttributes()
+	}
+
 	nm := nestedAttribute.GetNestingMode()
 	switch nm {
 	case fwschema.NestingModeList:
@@ -209,7 +223,13 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 				return
 			}
 
-			stateObject, diags := listElemObject(ctx, attrPath, stateList, idx, fwschemadata.DataDescriptionState)
+			var stateObject types.Object
+
+			if len(keyAttributes) > 0 {
+				stateObject, diags = listElemObjectByKeyAttributes(ctx, attrPath, stateList, planObject, keyAttributes, fwschemadata.DataDescriptionState)
+			} else {
+				stateObject, diags = listElemObject(ctx, attrPath, stateList, idx, fwschemadata.DataDescriptionState)
+			}
 
 			resp.Diagnostics.Append(diags...)
 
@@ -360,7 +380,13 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 				return
 			}
 
-			stateObject, diags := setElemObject(ctx, attrPath, stateSet, idx, fwschemadata.DataDescriptionState)
+			var stateObject types.Object
+
+			if len(keyAttributes) > 0 {
+				stateObject, diags = setElemObjectByKeyAttributes(ctx, attrPath, stateSet, planObject, keyAttributes, fwschemadata.DataDescriptionState)
+			} else {
+				stateObject, diags = setElemObject(ctx, attrPath, stateSet, idx, fwschemadata.DataDescriptionState)
+			}
 
 			resp.Diagnostics.Append(diags...)
 
@@ -839,6 +865,166 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 	}
 }
 
+// AttributePlanModifyFloat32 performs all types.Float32 plan modification.
+func AttributePlanModifyFloat32(ctx context.Context, attribute fwxschema.AttributeWithFloat32PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	// Use basetypes.Float32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planValuable, ok := req.AttributePlan.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
+		)
+
+		return
+	}
+
+	planValue, diags := planValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	stateValuable, ok := req.AttributeState.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
+		)
+
+		return
+	}
+
+	stateValue, diags := stateValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	typable, diags := coerceFloat32Typable(ctx, req.AttributePath, planValuable)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planModifyReq := planmodifier.Float32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		Private:        req.Private,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, planModifier := range attribute.Float32PlanModifiers() {
+		// Instantiate a new response for each request to prevent plan modifiers
+		// from modifying or removing diagnostics.
+		planModifyResp := &planmodifier.Float32Response{
+			PlanValue: planModifyReq.PlanValue,
+			Private:   resp.Private,
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined planmodifier.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		planModifier.PlanModifyFloat32(ctx, planModifyReq, planModifyResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined planmodifier.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		// Prepare next request with base type.
+		planModifyReq.PlanValue = planModifyResp.PlanValue
+
+		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+		resp.Private = planModifyResp.Private
+
+		if planModifyResp.RequiresReplace {
+			resp.RequiresReplace.Append(req.AttributePath)
+		}
+
+		// Only on new errors.
+		if planModifyResp.Diagnostics.HasError() {
+			return
+		}
+
+		// A custom value type must be returned in the final response to prevent
+		// later correctness errors.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/754
+		valuable, valueFromDiags := typable.ValueFromFloat32(ctx, planModifyResp.PlanValue)
+
+		resp.Diagnostics.Append(valueFromDiags...)
+
+		// Only on new errors.
+		if valueFromDiags.HasError() {
+			return
+		}
+
+		resp.AttributePlan = valuable
+	}
+}
+
 // AttributePlanModifyFloat64 performs all types.Float64 plan modification.
 func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.AttributeWithFloat64PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
 	// Use basetypes.Float64Valuable until custom types cannot re-implement
@@ -999,6 +1185,166 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 	}
 }
 
+// AttributePlanModifyInt32 performs all types.Int32 plan modification.
+func AttributePlanModifyInt32(ctx context.Context, attribute fwxschema.AttributeWithInt32PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	// Use basetypes.Int32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planValuable, ok := req.AttributePlan.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
+		)
+
+		return
+	}
+
+	planValue, diags := planValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	stateValuable, ok := req.AttributeState.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
+		)
+
+		return
+	}
+
+	stateValue, diags := stateValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	typable, diags := coerceInt32Typable(ctx, req.AttributePath, planValuable)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planModifyReq := planmodifier.Int32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		Private:        req.Private,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, planModifier := range attribute.Int32PlanModifiers() {
+		// Instantiate a new response for each request to prevent plan modifiers
+		// from modifying or removing diagnostics.
+		planModifyResp := &planmodifier.Int32Response{
+			PlanValue: planModifyReq.PlanValue,
+			Private:   resp.Private,
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined planmodifier.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		planModifier.PlanModifyInt32(ctx, planModifyReq, planModifyResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined planmodifier.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		// Prepare next request with base type.
+		planModifyReq.PlanValue = planModifyResp.PlanValue
+
+		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+		resp.Private = planModifyResp.Private
+
+		if planModifyResp.RequiresReplace {
+			resp.RequiresReplace.Append(req.AttributePath)
+		}
+
+		// Only on new errors.
+		if planModifyResp.Diagnostics.HasError() {
+			return
+		}
+
+		// A custom value type must be returned in the final response to prevent
+		// later correctness errors.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/754
+		valuable, valueFromDiags := typable.ValueFromInt32(ctx, planModifyResp.PlanValue)
+
+		resp.Diagnostics.Append(valueFromDiags...)
+
+		// Only on new errors.
+		if valueFromDiags.HasError() {
+			return
+		}
+
+		resp.AttributePlan = valuable
+	}
+}
+
 // AttributePlanModifyInt64 performs all types.Int64 plan modification.
 func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.AttributeWithInt64PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
 	// Use basetypes.Int64Valuable until custom types cannot re-implement
@@ -2119,6 +2465,166 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 	}
 }
 
+// AttributePlanModifyTuple performs all types.Tuple plan modification.
+func AttributePlanModifyTuple(ctx context.Context, attribute fwxschema.AttributeWithTuplePlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	// Use basetypes.TupleValuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.TupleValuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Tuple Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Tuple attribute plan modification. "+
+				"The value type must implement the basetypes.TupleValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToTupleValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planValuable, ok := req.AttributePlan.(basetypes.TupleValuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Tuple Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Tuple attribute plan modification. "+
+				"The value type must implement the basetypes.TupleValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
+		)
+
+		return
+	}
+
+	planValue, diags := planValuable.ToTupleValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	stateValuable, ok := req.AttributeState.(basetypes.TupleValuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Tuple Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Tuple attribute plan modification. "+
+				"The value type must implement the basetypes.TupleValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
+		)
+
+		return
+	}
+
+	stateValue, diags := stateValuable.ToTupleValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	typable, diags := coerceTupleTypable(ctx, req.AttributePath, planValuable)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planModifyReq := planmodifier.TupleRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		Private:        req.Private,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, planModifier := range attribute.TuplePlanModifiers() {
+		// Instantiate a new response for each request to prevent plan modifiers
+		// from modifying or removing diagnostics.
+		planModifyResp := &planmodifier.TupleResponse{
+			PlanValue: planModifyReq.PlanValue,
+			Private:   resp.Private,
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined planmodifier.Tuple",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		planModifier.PlanModifyTuple(ctx, planModifyReq, planModifyResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined planmodifier.Tuple",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		// Prepare next request with base type.
+		planModifyReq.PlanValue = planModifyResp.PlanValue
+
+		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+		resp.Private = planModifyResp.Private
+
+		if planModifyResp.RequiresReplace {
+			resp.RequiresReplace.Append(req.AttributePath)
+		}
+
+		// Only on new errors.
+		if planModifyResp.Diagnostics.HasError() {
+			return
+		}
+
+		// A custom value type must be returned in the final response to prevent
+		// later correctness errors.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/754
+		valuable, valueFromDiags := typable.ValueFromTuple(ctx, planModifyResp.PlanValue)
+
+		resp.Diagnostics.Append(valueFromDiags...)
+
+		// Only on new errors.
+		if valueFromDiags.HasError() {
+			return
+		}
+
+		resp.AttributePlan = valuable
+	}
+}
+
 func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttributeObject, req planmodifier.ObjectRequest, resp *ModifyAttributePlanResponse) {
 	if objectWithPlanModifiers, ok := o.(fwxschema.NestedAttributeObjectWithPlanModifiers); ok {
 		for _, objectPlanModifier := range objectWithPlanModifiers.ObjectPlanModifiers() {
@@ -1955,9 +1955,9 @@ func TestAttributeModifyPlan(t *testing.T) {
 				),
 			},
 		},
-		"attribute-set-nested-nested-usestateforunknown-elements-removed": {
+		"attribute-set-nested-nested-usestateforunknown-elements-rearranged-key-attributes": {
 			attribute: testschema.NestedAttribute{
-				NestedObject: testschema.NestedAttributeObject{
+				NestedObject: testschema.NestedAttributeObjectWithKeyAttributes{
 					Attributes: map[string]fwschema.Attribute{
 						"nested_computed": testschema.AttributeWithStringPlanModifiers{
 							Computed: true,
@@ -1970,6 +1970,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 							Required: true,
 						},
 					},
+					KeyAttributes: []string{"nested_required"},
 				},
 				NestingMode: fwschema.NestingModeSet,
 				Required:    true,
@@ -1993,6 +1994,16 @@ func TestAttributeModifyPlan(t *testing.T) {
 								"nested_required": types.StringValue("testvalue2"), // prior state on index 0 is testvalue1
 							},
 						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringNull(),
+								"nested_required": types.StringValue("testvalue1"), // prior state on index 1 is testvalue2
+							},
+						),
 					},
 				),
 				AttributePath: path.Root("test"),
@@ -2014,6 +2025,16 @@ func TestAttributeModifyPlan(t *testing.T) {
 								"nested_required": types.StringValue("testvalue2"),
 							},
 						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringUnknown(),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
 					},
 				),
 				AttributeState: types.SetValueMust(
@@ -2062,150 +2083,421 @@ func TestAttributeModifyPlan(t *testing.T) {
 								"nested_required": types.StringType,
 							},
 							map[string]attr.Value{
-								// TODO: Rework list/set element alignment during plan
-								// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/709
-								"nested_computed": types.StringValue("statevalue1"),
+								"nested_computed": types.StringValue("statevalue2"),
 								"nested_required": types.StringValue("testvalue2"),
 							},
 						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue1"),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
 					},
 				),
 			},
 		},
-		"attribute-map-nested-custom-nested-object": {
+		"attribute-list-nested-nested-usestateforunknown-elements-rearranged-key-attributes": {
 			attribute: testschema.NestedAttribute{
-				NestedObject: testschema.NestedAttributeObject{
-					CustomType: testschema.NestedObjectCustomType{
-						ObjectType: basetypes.ObjectType{
-							AttrTypes: map[string]attr.Type{
-								"nested_attr": types.StringType,
+				NestedObject: testschema.NestedAttributeObjectWithKeyAttributes{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_computed": testschema.AttributeWithStringPlanModifiers{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
-					},
-					Attributes: map[string]fwschema.Attribute{
-						"nested_attr": testschema.Attribute{
+						"nested_required": testschema.Attribute{
+							Type:     types.StringType,
 							Required: true,
 						},
 					},
+					KeyAttributes: []string{"nested_required"},
 				},
+				NestingMode: fwschema.NestingModeList,
 				Required:    true,
-				NestingMode: fwschema.NestingModeMap,
 			},
 			req: ModifyAttributePlanRequest{
-				AttributeConfig: types.MapValueMust(
-					testschema.NestedObjectCustomType{
-						ObjectType: types.ObjectType{
-							AttrTypes: map[string]attr.Type{
-								"nested_attr": types.StringType,
-							},
+				AttributeConfig: types.ListValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
 						},
 					},
-					map[string]attr.Value{
-						"testkey": testschema.NestedObjectCustomValue{
-							ObjectValue: types.ObjectValueMust(
-								map[string]attr.Type{
-									"nested_attr": types.StringType,
-								},
-								map[string]attr.Value{
-									"nested_attr": types.StringValue("testvalue"),
-								},
-							),
-						},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringNull(),
+								"nested_required": types.StringValue("testvalue2"), // prior state on index 0 is testvalue1
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringNull(),
+								"nested_required": types.StringValue("testvalue1"), // prior state on index 1 is testvalue2
+							},
+						),
 					},
 				),
 				AttributePath: path.Root("test"),
-				AttributePlan: types.MapValueMust(
-					testschema.NestedObjectCustomType{
-						ObjectType: types.ObjectType{
-							AttrTypes: map[string]attr.Type{
-								"nested_attr": types.StringType,
-							},
+				AttributePlan: types.ListValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
 						},
 					},
-					map[string]attr.Value{
-						"testkey": testschema.NestedObjectCustomValue{
-							ObjectValue: types.ObjectValueMust(
-								map[string]attr.Type{
-									"nested_attr": types.StringType,
-								},
-								map[string]attr.Value{
-									"nested_attr": types.StringValue("testvalue"),
-								},
-							),
-						},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringUnknown(),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringUnknown(),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
 					},
 				),
-				AttributeState: types.MapValueMust(
-					testschema.NestedObjectCustomType{
-						ObjectType: types.ObjectType{
-							AttrTypes: map[string]attr.Type{
-								"nested_attr": types.StringType,
-							},
+				AttributeState: types.ListValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
 						},
 					},
-					map[string]attr.Value{
-						"testkey": testschema.NestedObjectCustomValue{
-							ObjectValue: types.ObjectValueMust(
-								map[string]attr.Type{
-									"nested_attr": types.StringType,
-								},
-								map[string]attr.Value{
-									"nested_attr": types.StringValue("testvalue"),
-								},
-							),
-						},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue1"),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue2"),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
 					},
 				),
 			},
 			expectedResp: ModifyAttributePlanResponse{
-				AttributePlan: types.MapValueMust(
-					testschema.NestedObjectCustomType{
-						ObjectType: types.ObjectType{
-							AttrTypes: map[string]attr.Type{
-								"nested_attr": types.StringType,
-							},
+				AttributePlan: types.ListValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
 						},
 					},
-					map[string]attr.Value{
-						"testkey": testschema.NestedObjectCustomValue{
-							ObjectValue: types.ObjectValueMust(
-								map[string]attr.Type{
-									"nested_attr": types.StringType,
-								},
-								map[string]attr.Value{
-									"nested_attr": types.StringValue("testvalue"),
-								},
-							),
-						},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue2"),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue1"),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
 					},
 				),
 			},
 		},
-		"attribute-map-nested-nested-custom-nested-object": {
+		"attribute-set-nested-nested-usestateforunknown-elements-removed": {
 			attribute: testschema.NestedAttribute{
 				NestedObject: testschema.NestedAttributeObject{
-					CustomType: testschema.MapNestedObjectCustomType{
-						ObjectType: basetypes.ObjectType{
-							AttrTypes: map[string]attr.Type{
-								"nested_map_nested": types.MapType{
-									ElemType: testschema.NestedObjectCustomType{
-										ObjectType: types.ObjectType{
-											AttrTypes: map[string]attr.Type{
-												"nested_attr": types.StringType,
-											},
-										},
-									},
-								},
+					Attributes: map[string]fwschema.Attribute{
+						"nested_computed": testschema.AttributeWithStringPlanModifiers{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
+						"nested_required": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
 					},
-					Attributes: map[string]fwschema.Attribute{
-						"nested_map_nested": testschema.NestedAttribute{
-							NestedObject: testschema.NestedAttributeObject{
-								CustomType: testschema.NestedObjectCustomType{
-									ObjectType: basetypes.ObjectType{
-										AttrTypes: map[string]attr.Type{
-											"nested_attr": types.StringType,
+				},
+				NestingMode: fwschema.NestingModeSet,
+				Required:    true,
+			},
+			req: ModifyAttributePlanRequest{
+				AttributeConfig: types.SetValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringNull(),
+								"nested_required": types.StringValue("testvalue2"), // prior state on index 0 is testvalue1
+							},
+						),
+					},
+				),
+				AttributePath: path.Root("test"),
+				AttributePlan: types.SetValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringUnknown(),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+					},
+				),
+				AttributeState: types.SetValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue1"),
+								"nested_required": types.StringValue("testvalue1"),
+							},
+						),
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								"nested_computed": types.StringValue("statevalue2"),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+					},
+				),
+			},
+			expectedResp: ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(
+					types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_computed": types.StringType,
+							"nested_required": types.StringType,
+						},
+					},
+					[]attr.Value{
+						types.ObjectValueMust(
+							map[string]attr.Type{
+								"nested_computed": types.StringType,
+								"nested_required": types.StringType,
+							},
+							map[string]attr.Value{
+								// TODO: Rework list/set element alignment during plan
+								// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/709
+								"nested_computed": types.StringValue("statevalue1"),
+								"nested_required": types.StringValue("testvalue2"),
+							},
+						),
+					},
+				),
+			},
+		},
+		"attribute-map-nested-custom-nested-object": {
+			attribute: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					CustomType: testschema.NestedObjectCustomType{
+						ObjectType: basetypes.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+						},
+					},
+					Attributes: map[string]fwschema.Attribute{
+						"nested_attr": testschema.Attribute{
+							Required: true,
+						},
+					},
+				},
+				Required:    true,
+				NestingMode: fwschema.NestingModeMap,
+			},
+			req: ModifyAttributePlanRequest{
+				AttributeConfig: types.MapValueMust(
+					testschema.NestedObjectCustomType{
+						ObjectType: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+						},
+					},
+					map[string]attr.Value{
+						"testkey": testschema.NestedObjectCustomValue{
+							ObjectValue: types.ObjectValueMust(
+								map[string]attr.Type{
+									"nested_attr": types.StringType,
+								},
+								map[string]attr.Value{
+									"nested_attr": types.StringValue("testvalue"),
+								},
+							),
+						},
+					},
+				),
+				AttributePath: path.Root("test"),
+				AttributePlan: types.MapValueMust(
+					testschema.NestedObjectCustomType{
+						ObjectType: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+						},
+					},
+					map[string]attr.Value{
+						"testkey": testschema.NestedObjectCustomValue{
+							ObjectValue: types.ObjectValueMust(
+								map[string]attr.Type{
+									"nested_attr": types.StringType,
+								},
+								map[string]attr.Value{
+									"nested_attr": types.StringValue("testvalue"),
+								},
+							),
+						},
+					},
+				),
+				AttributeState: types.MapValueMust(
+					testschema.NestedObjectCustomType{
+						ObjectType: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+						},
+					},
+					map[string]attr.Value{
+						"testkey": testschema.NestedObjectCustomValue{
+							ObjectValue: types.ObjectValueMust(
+								map[string]attr.Type{
+									"nested_attr": types.StringType,
+								},
+								map[string]attr.Value{
+									"nested_attr": types.StringValue("testvalue"),
+								},
+							),
+						},
+					},
+				),
+			},
+			expectedResp: ModifyAttributePlanResponse{
+				AttributePlan: types.MapValueMust(
+					testschema.NestedObjectCustomType{
+						ObjectType: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested_attr": types.StringType,
+							},
+						},
+					},
+					map[string]attr.Value{
+						"testkey": testschema.NestedObjectCustomValue{
+							ObjectValue: types.ObjectValueMust(
+								map[string]attr.Type{
+									"nested_attr": types.StringType,
+								},
+								map[string]attr.Value{
+									"nested_attr": types.StringValue("testvalue"),
+								},
+							),
+						},
+					},
+				),
+			},
+		},
+		"attribute-map-nested-nested-custom-nested-object": {
+			attribute: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					CustomType: testschema.MapNestedObjectCustomType{
+						ObjectType: basetypes.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested_map_nested": types.MapType{
+									ElemType: testschema.NestedObjectCustomType{
+										ObjectType: types.ObjectType{
+											AttrTypes: map[string]attr.Type{
+												"nested_attr": types.StringType,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Attributes: map[string]fwschema.Attribute{
+						"nested_map_nested": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								CustomType: testschema.NestedObjectCustomType{
+									ObjectType: basetypes.ObjectType{
+										AttrTypes: map[string]attr.Type{
+											"nested_attr": types.StringType,
 										},
 									},
 								},
@@ -8615,54 +8907,708 @@ func TestAttributePlanModifyObject(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath: path.Root("test"),
-				AttributeConfig: types.ObjectValueMust(
-					map[string]attr.Type{
-						"testattr": types.StringType,
-					},
-					map[string]attr.Value{
-						"testattr": types.StringValue("testvalue"),
-					},
-				),
-				AttributePlan: types.ObjectValueMust(
-					map[string]attr.Type{
-						"testattr": types.StringType,
-					},
-					map[string]attr.Value{
-						"testattr": types.StringValue("testvalue"),
-					},
-				),
-				AttributeState: types.ObjectValueMust(
-					map[string]attr.Type{
-						"testattr": types.StringType,
-					},
-					map[string]attr.Value{
-						"testattr": types.StringValue("oldtestvalue"),
-					},
-				),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("oldtestvalue"),
+					},
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("testvalue"),
+					},
+				),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("testvalue"),
+					},
+				),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains deduplicated
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributePlanModifyObject(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributePlanModifySet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithSetPlanModifiers
+		request   ModifyAttributePlanRequest
+		response  *ModifyAttributePlanResponse
+		expected  *ModifyAttributePlanResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:          types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Set{ElementType: tftypes.String},
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(
+											tftypes.Set{ElementType: tftypes.String},
+											[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
+										),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Set{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Set{ElementType: tftypes.String},
+								[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
+							),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got := req.ConfigValue
+							expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetNull(types.StringType),
+				AttributeState:  types.SetNull(types.StringType),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetNull(types.StringType),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetNull(types.StringType),
+			},
+		},
+		"request-plan": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got := req.Plan
+							expected := tfsdk.Plan{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Set{ElementType: tftypes.String},
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(
+											tftypes.Set{ElementType: tftypes.String},
+											[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
+										),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.Plan",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Set{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Set{ElementType: tftypes.String},
+								[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
+							),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+		},
+		"request-planvalue": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got := req.PlanValue
+							expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.PlanValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetNull(types.StringType),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetNull(types.StringType),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+		},
+		"request-private": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got, diags := req.Private.GetKey(ctx, "testkey")
+							expected := []byte(`{"testproperty":true}`)
+
+							resp.Diagnostics.Append(diags...)
+
+							if diff := cmp.Diff(got, expected); diff != "" {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.Private",
+									diff,
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetNull(types.StringType),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetNull(types.StringType),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+		},
+		"request-state": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got := req.State
+							expected := tfsdk.State{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Set{ElementType: tftypes.String},
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(
+											tftypes.Set{ElementType: tftypes.String},
+											[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
+										),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.State",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Set{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Set{ElementType: tftypes.String},
+								[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
+							),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+		},
+		"request-statevalue": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							got := req.StateValue
+							expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected SetRequest.StateValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetNull(types.StringType),
+				AttributePlan:   types.SetNull(types.StringType),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetNull(types.StringType),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetNull(types.StringType),
+			},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"response-planvalue": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							resp.PlanValue = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetNull(types.StringType),
+				AttributePlan:   types.SetUnknown(types.StringType),
+				AttributeState:  types.SetNull(types.StringType),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetUnknown(types.StringType),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+		},
+		"response-planvalue-custom-type": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							resp.PlanValue = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: testtypes.SetValueWithSemanticEquals{
+					SetValue: types.SetNull(types.StringType),
+				},
+				AttributePlan: testtypes.SetValueWithSemanticEquals{
+					SetValue: types.SetUnknown(types.StringType),
+				},
+				AttributeState: testtypes.SetValueWithSemanticEquals{
+					SetValue: types.SetNull(types.StringType),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.SetValueWithSemanticEquals{
+					SetValue: types.SetUnknown(types.StringType),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.SetValueWithSemanticEquals{
+					SetValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				},
+			},
+		},
+		"response-private": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							resp.Diagnostics.Append(
+								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
+							)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetNull(types.StringType),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetNull(types.StringType),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"newtestproperty":true}`),
+					}),
+				),
+			},
+		},
+		"response-requiresreplace-add": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("oldtestvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
+				},
+			},
+		},
+		"response-requiresreplace-false": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							resp.RequiresReplace = false // same as not being set
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("oldtestvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains as it should not be removed
+				},
+			},
+		},
+		"response-requiresreplace-update": {
+			attribute: testschema.AttributeWithSetPlanModifiers{
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("oldtestvalue")}),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.ObjectValueMust(
-					map[string]attr.Type{
-						"testattr": types.StringType,
-					},
-					map[string]attr.Value{
-						"testattr": types.StringValue("testvalue"),
-					},
-				),
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.ObjectValueMust(
-					map[string]attr.Type{
-						"testattr": types.StringType,
-					},
-					map[string]attr.Value{
-						"testattr": types.StringValue("testvalue"),
-					},
-				),
+				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains deduplicated
 				},
@@ -8676,7 +9622,7 @@ func TestAttributePlanModifyObject(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			AttributePlanModifyObject(context.Background(), testCase.attribute, testCase.request, testCase.response)
+			AttributePlanModifySet(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
 			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -8685,26 +9631,26 @@ func TestAttributePlanModifyObject(t *testing.T) {
 	}
 }
 
-func TestAttributePlanModifySet(t *testing.T) {
+func TestAttributePlanModifyString(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		attribute fwxschema.AttributeWithSetPlanModifiers
+		attribute fwxschema.AttributeWithStringPlanModifiers
 		request   ModifyAttributePlanRequest
 		response  *ModifyAttributePlanResponse
 		expected  *ModifyAttributePlanResponse
 	}{
 		"request-path": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got := req.Path
 							expected := path.Root("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.Path",
+									"Unexpected StringRequest.Path",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -8714,28 +9660,28 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
 		"request-pathexpression": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got := req.PathExpression
 							expected := path.MatchRoot("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.PathExpression",
+									"Unexpected StringRequest.PathExpression",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -8746,42 +9692,39 @@ func TestAttributePlanModifySet(t *testing.T) {
 			request: ModifyAttributePlanRequest{
 				AttributePath:           path.Root("test"),
 				AttributePathExpression: path.MatchRoot("test"),
-				AttributeConfig:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:          types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeConfig:         types.StringValue("testvalue"),
+				AttributePlan:           types.StringValue("testvalue"),
+				AttributeState:          types.StringValue("testvalue"),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
 		"request-config": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got := req.Config
 							expected := tfsdk.Config{
 								Raw: tftypes.NewValue(
 									tftypes.Object{
 										AttributeTypes: map[string]tftypes.Type{
-											"test": tftypes.Set{ElementType: tftypes.String},
+											"test": tftypes.String,
 										},
 									},
 									map[string]tftypes.Value{
-										"test": tftypes.NewValue(
-											tftypes.Set{ElementType: tftypes.String},
-											[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
-										),
+										"test": tftypes.NewValue(tftypes.String, "testvalue"),
 									},
 								),
 							}
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.Config",
+									"Unexpected StringRequest.Config",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -8791,43 +9734,40 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
-								"test": tftypes.Set{ElementType: tftypes.String},
+								"test": tftypes.String,
 							},
 						},
 						map[string]tftypes.Value{
-							"test": tftypes.NewValue(
-								tftypes.Set{ElementType: tftypes.String},
-								[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
-							),
+							"test": tftypes.NewValue(tftypes.String, "testvalue"),
 						},
 					),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
 		"request-configvalue": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got := req.ConfigValue
-							expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+							expected := types.StringValue("testvalue")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.ConfigValue",
+									"Unexpected StringRequest.ConfigValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -8837,42 +9777,39 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetNull(types.StringType),
-				AttributeState:  types.SetNull(types.StringType),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringNull(),
+				AttributeState:  types.StringNull(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetNull(types.StringType),
+				AttributePlan: types.StringNull(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetNull(types.StringType),
+				AttributePlan: types.StringNull(),
 			},
 		},
 		"request-plan": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got := req.Plan
 							expected := tfsdk.Plan{
 								Raw: tftypes.NewValue(
 									tftypes.Object{
 										AttributeTypes: map[string]tftypes.Type{
-											"test": tftypes.Set{ElementType: tftypes.String},
+											"test": tftypes.String,
 										},
 									},
 									map[string]tftypes.Value{
-										"test": tftypes.NewValue(
-											tftypes.Set{ElementType: tftypes.String},
-											[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
-										),
+										"test": tftypes.NewValue(tftypes.String, "testvalue"),
 									},
 								),
 							}
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.Plan",
+									"Unexpected StringRequest.Plan",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -8882,43 +9819,40 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
 				Plan: tfsdk.Plan{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
-								"test": tftypes.Set{ElementType: tftypes.String},
+								"test": tftypes.String,
 							},
 						},
 						map[string]tftypes.Value{
-							"test": tftypes.NewValue(
-								tftypes.Set{ElementType: tftypes.String},
-								[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
-							),
+							"test": tftypes.NewValue(tftypes.String, "testvalue"),
 						},
 					),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
 		"request-planvalue": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got := req.PlanValue
-							expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+							expected := types.StringValue("testvalue")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.PlanValue",
+									"Unexpected StringRequest.PlanValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -8928,22 +9862,22 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetNull(types.StringType),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetNull(types.StringType),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringNull(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
 		"request-private": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got, diags := req.Private.GetKey(ctx, "testkey")
 							expected := []byte(`{"testproperty":true}`)
 
@@ -8951,7 +9885,7 @@ func TestAttributePlanModifySet(t *testing.T) {
 
 							if diff := cmp.Diff(got, expected); diff != "" {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.Private",
+									"Unexpected StringRequest.Private",
 									diff,
 								)
 							}
@@ -8961,9 +9895,9 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetNull(types.StringType),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetNull(types.StringType),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringNull(),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -8972,7 +9906,7 @@ func TestAttributePlanModifySet(t *testing.T) {
 				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -8981,7 +9915,7 @@ func TestAttributePlanModifySet(t *testing.T) {
 				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -8991,30 +9925,27 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 		},
 		"request-state": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got := req.State
 							expected := tfsdk.State{
 								Raw: tftypes.NewValue(
 									tftypes.Object{
 										AttributeTypes: map[string]tftypes.Type{
-											"test": tftypes.Set{ElementType: tftypes.String},
+											"test": tftypes.String,
 										},
 									},
 									map[string]tftypes.Value{
-										"test": tftypes.NewValue(
-											tftypes.Set{ElementType: tftypes.String},
-											[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
-										),
+										"test": tftypes.NewValue(tftypes.String, "testvalue"),
 									},
 								),
 							}
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.State",
+									"Unexpected StringRequest.State",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -9024,43 +9955,40 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
-								"test": tftypes.Set{ElementType: tftypes.String},
+								"test": tftypes.String,
 							},
 						},
-						map[string]tftypes.Value{
-							"test": tftypes.NewValue(
-								tftypes.Set{ElementType: tftypes.String},
-								[]tftypes.Value{tftypes.NewValue(tftypes.String, "testvalue")},
-							),
-						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.String, "testvalue"),
+						},
 					),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
 		"request-statevalue": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							got := req.StateValue
-							expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+							expected := types.StringValue("testvalue")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected SetRequest.StateValue",
+									"Unexpected StringRequest.StateValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -9070,22 +9998,22 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetNull(types.StringType),
-				AttributePlan:   types.SetNull(types.StringType),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringNull(),
+				AttributeState:  types.StringValue("testvalue"),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetNull(types.StringType),
+				AttributePlan: types.StringNull(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetNull(types.StringType),
+				AttributePlan: types.StringNull(),
 			},
 		},
 		"response-diagnostics": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
 							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
 						},
@@ -9094,12 +10022,12 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
 						path.Root("other"),
@@ -9114,7 +10042,7 @@ func TestAttributePlanModifySet(t *testing.T) {
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
 						path.Root("other"),
@@ -9140,66 +10068,66 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 		},
 		"response-planvalue": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
-							resp.PlanValue = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = types.StringValue("testvalue")
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetNull(types.StringType),
-				AttributePlan:   types.SetUnknown(types.StringType),
-				AttributeState:  types.SetNull(types.StringType),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringUnknown(),
+				AttributeState:  types.StringNull(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetUnknown(types.StringType),
+				AttributePlan: types.StringUnknown(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
 		"response-planvalue-custom-type": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
-							resp.PlanValue = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")})
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = types.StringValue("testvalue")
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath: path.Root("test"),
-				AttributeConfig: testtypes.SetValueWithSemanticEquals{
-					SetValue: types.SetNull(types.StringType),
+				AttributeConfig: testtypes.StringValueWithSemanticEquals{
+					StringValue: types.StringNull(),
 				},
-				AttributePlan: testtypes.SetValueWithSemanticEquals{
-					SetValue: types.SetUnknown(types.StringType),
+				AttributePlan: testtypes.StringValueWithSemanticEquals{
+					StringValue: types.StringUnknown(),
 				},
-				AttributeState: testtypes.SetValueWithSemanticEquals{
-					SetValue: types.SetNull(types.StringType),
+				AttributeState: testtypes.StringValueWithSemanticEquals{
+					StringValue: types.StringNull(),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: testtypes.SetValueWithSemanticEquals{
-					SetValue: types.SetUnknown(types.StringType),
+				AttributePlan: testtypes.StringValueWithSemanticEquals{
+					StringValue: types.StringUnknown(),
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: testtypes.SetValueWithSemanticEquals{
-					SetValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: testtypes.StringValueWithSemanticEquals{
+					StringValue: types.StringValue("testvalue"),
 				},
 			},
 		},
 		"response-private": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							resp.Diagnostics.Append(
 								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
 							)
@@ -9209,9 +10137,9 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetNull(types.StringType),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetNull(types.StringType),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringNull(),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9220,7 +10148,7 @@ func TestAttributePlanModifySet(t *testing.T) {
 				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9229,7 +10157,7 @@ func TestAttributePlanModifySet(t *testing.T) {
 				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9239,10 +10167,10 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 		},
 		"response-requiresreplace-add": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							resp.RequiresReplace = true
 						},
 					},
@@ -9250,25 +10178,25 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("oldtestvalue")}),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("oldtestvalue"),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				RequiresReplace: path.Paths{
 					path.Root("test"),
 				},
 			},
 		},
 		"response-requiresreplace-false": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							resp.RequiresReplace = false // same as not being set
 						},
 					},
@@ -9276,28 +10204,28 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("oldtestvalue")}),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("oldtestvalue"),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains as it should not be removed
 				},
 			},
 		},
 		"response-requiresreplace-update": {
-			attribute: testschema.AttributeWithSetPlanModifiers{
-				PlanModifiers: []planmodifier.Set{
-					testplanmodifier.Set{
-						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 							resp.RequiresReplace = true
 						},
 					},
@@ -9305,18 +10233,18 @@ func TestAttributePlanModifySet(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributePlan:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
-				AttributeState:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("oldtestvalue")}),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("oldtestvalue"),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan: types.StringValue("testvalue"),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains deduplicated
 				},
@@ -9330,7 +10258,7 @@ func TestAttributePlanModifySet(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			AttributePlanModifySet(context.Background(), testCase.attribute, testCase.request, testCase.response)
+			AttributePlanModifyString(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
 			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -9339,26 +10267,26 @@ func TestAttributePlanModifySet(t *testing.T) {
 	}
 }
 
-func TestAttributePlanModifyString(t *testing.T) {
+func TestAttributePlanModifyTuple(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		attribute fwxschema.AttributeWithStringPlanModifiers
+		attribute fwxschema.AttributeWithTuplePlanModifiers
 		request   ModifyAttributePlanRequest
 		response  *ModifyAttributePlanResponse
 		expected  *ModifyAttributePlanResponse
 	}{
 		"request-path": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got := req.Path
 							expected := path.Root("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.Path",
+									"Unexpected TupleRequest.Path",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -9367,29 +10295,64 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringValue("testvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 		},
 		"request-pathexpression": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got := req.PathExpression
 							expected := path.MatchRoot("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.PathExpression",
+									"Unexpected TupleRequest.PathExpression",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -9400,39 +10363,87 @@ func TestAttributePlanModifyString(t *testing.T) {
 			request: ModifyAttributePlanRequest{
 				AttributePath:           path.Root("test"),
 				AttributePathExpression: path.MatchRoot("test"),
-				AttributeConfig:         types.StringValue("testvalue"),
-				AttributePlan:           types.StringValue("testvalue"),
-				AttributeState:          types.StringValue("testvalue"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 		},
 		"request-config": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got := req.Config
 							expected := tfsdk.Config{
 								Raw: tftypes.NewValue(
 									tftypes.Object{
 										AttributeTypes: map[string]tftypes.Type{
-											"test": tftypes.String,
+											"test": tftypes.Tuple{
+												ElementTypes: []tftypes.Type{
+													tftypes.String,
+												},
+											},
 										},
 									},
 									map[string]tftypes.Value{
-										"test": tftypes.NewValue(tftypes.String, "testvalue"),
+										"test": tftypes.NewValue(
+											tftypes.Tuple{
+												ElementTypes: []tftypes.Type{
+													tftypes.String,
+												},
+											},
+											[]tftypes.Value{
+												tftypes.NewValue(tftypes.String, "testvalue"),
+											},
+										),
 									},
 								),
 							}
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.Config",
+									"Unexpected TupleRequest.Config",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -9441,41 +10452,96 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringValue("testvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
-								"test": tftypes.String,
+								"test": tftypes.Tuple{
+									ElementTypes: []tftypes.Type{
+										tftypes.String,
+									},
+								},
 							},
 						},
 						map[string]tftypes.Value{
-							"test": tftypes.NewValue(tftypes.String, "testvalue"),
+							"test": tftypes.NewValue(
+								tftypes.Tuple{
+									ElementTypes: []tftypes.Type{
+										tftypes.String,
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(tftypes.String, "testvalue"),
+								},
+							),
 						},
 					),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 		},
 		"request-configvalue": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got := req.ConfigValue
-							expected := types.StringValue("testvalue")
+							expected := types.TupleValueMust(
+								[]attr.Type{
+									types.StringType,
+								},
+								[]attr.Value{
+									types.StringValue("testvalue"),
+								},
+							)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.ConfigValue",
+									"Unexpected TupleRequest.ConfigValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -9484,40 +10550,68 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringNull(),
-				AttributeState:  types.StringNull(),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
+				AttributeState: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringNull(),
+				AttributePlan: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringNull(),
+				AttributePlan: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 			},
 		},
 		"request-plan": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got := req.Plan
 							expected := tfsdk.Plan{
 								Raw: tftypes.NewValue(
 									tftypes.Object{
 										AttributeTypes: map[string]tftypes.Type{
-											"test": tftypes.String,
+											"test": tftypes.Tuple{
+												ElementTypes: []tftypes.Type{
+													tftypes.String,
+												},
+											},
 										},
 									},
 									map[string]tftypes.Value{
-										"test": tftypes.NewValue(tftypes.String, "testvalue"),
+										"test": tftypes.NewValue(
+											tftypes.Tuple{
+												ElementTypes: []tftypes.Type{
+													tftypes.String,
+												},
+											},
+											[]tftypes.Value{
+												tftypes.NewValue(tftypes.String, "testvalue"),
+											},
+										),
 									},
 								),
 							}
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.Plan",
+									"Unexpected TupleRequest.Plan",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -9526,41 +10620,96 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringValue("testvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				Plan: tfsdk.Plan{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
-								"test": tftypes.String,
+								"test": tftypes.Tuple{
+									ElementTypes: []tftypes.Type{
+										tftypes.String,
+									},
+								},
 							},
 						},
 						map[string]tftypes.Value{
-							"test": tftypes.NewValue(tftypes.String, "testvalue"),
+							"test": tftypes.NewValue(
+								tftypes.Tuple{
+									ElementTypes: []tftypes.Type{
+										tftypes.String,
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(tftypes.String, "testvalue"),
+								},
+							),
 						},
 					),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 		},
 		"request-planvalue": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got := req.PlanValue
-							expected := types.StringValue("testvalue")
+							expected := types.TupleValueMust(
+								[]attr.Type{
+									types.StringType,
+								},
+								[]attr.Value{
+									types.StringValue("testvalue"),
+								},
+							)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.PlanValue",
+									"Unexpected TupleRequest.PlanValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -9569,23 +10718,48 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringNull(),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringNull(),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 		},
 		"request-private": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got, diags := req.Private.GetKey(ctx, "testkey")
 							expected := []byte(`{"testproperty":true}`)
 
@@ -9593,7 +10767,7 @@ func TestAttributePlanModifyString(t *testing.T) {
 
 							if diff := cmp.Diff(got, expected); diff != "" {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.Private",
+									"Unexpected TupleRequest.Private",
 									diff,
 								)
 							}
@@ -9602,10 +10776,21 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringNull(),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringNull(),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9614,7 +10799,14 @@ func TestAttributePlanModifyString(t *testing.T) {
 				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9623,7 +10815,14 @@ func TestAttributePlanModifyString(t *testing.T) {
 				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9633,27 +10832,40 @@ func TestAttributePlanModifyString(t *testing.T) {
 			},
 		},
 		"request-state": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got := req.State
 							expected := tfsdk.State{
 								Raw: tftypes.NewValue(
 									tftypes.Object{
 										AttributeTypes: map[string]tftypes.Type{
-											"test": tftypes.String,
+											"test": tftypes.Tuple{
+												ElementTypes: []tftypes.Type{
+													tftypes.String,
+												},
+											},
 										},
 									},
 									map[string]tftypes.Value{
-										"test": tftypes.NewValue(tftypes.String, "testvalue"),
+										"test": tftypes.NewValue(
+											tftypes.Tuple{
+												ElementTypes: []tftypes.Type{
+													tftypes.String,
+												},
+											},
+											[]tftypes.Value{
+												tftypes.NewValue(tftypes.String, "testvalue"),
+											},
+										),
 									},
 								),
 							}
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.State",
+									"Unexpected TupleRequest.State",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -9662,41 +10874,96 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringValue("testvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
-								"test": tftypes.String,
+								"test": tftypes.Tuple{
+									ElementTypes: []tftypes.Type{
+										tftypes.String,
+									},
+								},
 							},
 						},
 						map[string]tftypes.Value{
-							"test": tftypes.NewValue(tftypes.String, "testvalue"),
+							"test": tftypes.NewValue(
+								tftypes.Tuple{
+									ElementTypes: []tftypes.Type{
+										tftypes.String,
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(tftypes.String, "testvalue"),
+								},
+							),
 						},
 					),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 		},
 		"request-statevalue": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							got := req.StateValue
-							expected := types.StringValue("testvalue")
+							expected := types.TupleValueMust(
+								[]attr.Type{
+									types.StringType,
+								},
+								[]attr.Value{
+									types.StringValue("testvalue"),
+								},
+							)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected StringRequest.StateValue",
+									"Unexpected TupleRequest.StateValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -9705,23 +10972,38 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringNull(),
-				AttributePlan:   types.StringNull(),
-				AttributeState:  types.StringValue("testvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
+				AttributePlan: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringNull(),
+				AttributePlan: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringNull(),
+				AttributePlan: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 			},
 		},
 		"response-diagnostics": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
 							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
 						},
@@ -9729,13 +11011,41 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringValue("testvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
 						path.Root("other"),
@@ -9750,7 +11060,14 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
 						path.Root("other"),
@@ -9776,66 +11093,55 @@ func TestAttributePlanModifyString(t *testing.T) {
 			},
 		},
 		"response-planvalue": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
-							resp.PlanValue = types.StringValue("testvalue")
-						},
-					},
-				},
-			},
-			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringNull(),
-				AttributePlan:   types.StringUnknown(),
-				AttributeState:  types.StringNull(),
-			},
-			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringUnknown(),
-			},
-			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
-			},
-		},
-		"response-planvalue-custom-type": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
-							resp.PlanValue = types.StringValue("testvalue")
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
+							resp.PlanValue = types.TupleValueMust(
+								[]attr.Type{
+									types.StringType,
+								},
+								[]attr.Value{
+									types.StringValue("testvalue"),
+								},
+							)
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath: path.Root("test"),
-				AttributeConfig: testtypes.StringValueWithSemanticEquals{
-					StringValue: types.StringNull(),
-				},
-				AttributePlan: testtypes.StringValueWithSemanticEquals{
-					StringValue: types.StringUnknown(),
-				},
-				AttributeState: testtypes.StringValueWithSemanticEquals{
-					StringValue: types.StringNull(),
-				},
+				AttributeConfig: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
+				AttributePlan: types.TupleUnknown([]attr.Type{
+					types.StringType,
+				}),
+				AttributeState: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: testtypes.StringValueWithSemanticEquals{
-					StringValue: types.StringUnknown(),
-				},
+				AttributePlan: types.TupleUnknown([]attr.Type{
+					types.StringType,
+				}),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: testtypes.StringValueWithSemanticEquals{
-					StringValue: types.StringValue("testvalue"),
-				},
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 		},
 		"response-private": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							resp.Diagnostics.Append(
 								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
 							)
@@ -9844,10 +11150,21 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringNull(),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringNull(),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleNull([]attr.Type{
+					types.StringType,
+				}),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9856,7 +11173,14 @@ func TestAttributePlanModifyString(t *testing.T) {
 				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9865,7 +11189,14 @@ func TestAttributePlanModifyString(t *testing.T) {
 				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -9875,84 +11206,189 @@ func TestAttributePlanModifyString(t *testing.T) {
 			},
 		},
 		"response-requiresreplace-add": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							resp.RequiresReplace = true
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringValue("oldtestvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("oldtestvalue"),
+					},
+				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				RequiresReplace: path.Paths{
 					path.Root("test"),
 				},
 			},
 		},
 		"response-requiresreplace-false": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							resp.RequiresReplace = false // same as not being set
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringValue("oldtestvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("oldtestvalue"),
+					},
+				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains as it should not be removed
 				},
 			},
 		},
 		"response-requiresreplace-update": {
-			attribute: testschema.AttributeWithStringPlanModifiers{
-				PlanModifiers: []planmodifier.String{
-					testplanmodifier.String{
-						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+			attribute: testschema.AttributeWithTuplePlanModifiers{
+				PlanModifiers: []planmodifier.Tuple{
+					testplanmodifier.Tuple{
+						PlanModifyTupleMethod: func(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
 							resp.RequiresReplace = true
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
-				AttributePath:   path.Root("test"),
-				AttributeConfig: types.StringValue("testvalue"),
-				AttributePlan:   types.StringValue("testvalue"),
-				AttributeState:  types.StringValue("oldtestvalue"),
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("oldtestvalue"),
+					},
+				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.StringValue("testvalue"),
+				AttributePlan: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+					},
+					[]attr.Value{
+						types.StringValue("testvalue"),
+					},
+				),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains deduplicated
 				},
@@ -9966,7 +11402,7 @@ func TestAttributePlanModifyString(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			AttributePlanModifyString(context.Background(), testCase.attribute, testCase.request, testCase.response)
+			AttributePlanModifyTuple(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
 			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -111,8 +111,12 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	switch attributeWithValidators := a.(type) {
 	case fwxschema.AttributeWithBoolValidators:
 		AttributeValidateBool(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithFloat32Validators:
+		AttributeValidateFloat32(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithFloat64Validators:
 		AttributeValidateFloat64(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithInt32Validators:
+		AttributeValidateInt32(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithInt64Validators:
 		AttributeValidateInt64(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithListValidators:
@@ -127,6 +131,8 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 		AttributeValidateSet(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithStringValidators:
 		AttributeValidateString(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithTupleValidators:
+		AttributeValidateTuple(ctx, attributeWithValidators, req, resp)
 	}
 
 	AttributeValidateNestedAttributes(ctx, a, req, resp)
@@ -206,6 +212,71 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 	}
 }
 
+// AttributeValidateFloat32 performs all types.Float32 validation.
+func AttributeValidateFloat32(ctx context.Context, attribute fwxschema.AttributeWithFloat32Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Use basetypes.Float32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute validation. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.Float32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.Float32Validators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.Float32Response{}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined validator.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		attributeValidator.ValidateFloat32(ctx, validateReq, validateResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined validator.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
 // AttributeValidateFloat64 performs all types.Float64 validation.
 func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.AttributeWithFloat64Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.Float64Valuable until custom types cannot re-implement
@@ -271,6 +342,71 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 	}
 }
 
+// AttributeValidateInt32 performs all types.Int32 validation.
+func AttributeValidateInt32(ctx context.Context, attribute fwxschema.AttributeWithInt32Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Use basetypes.Int32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute validation. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.Int32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.Int32Validators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.Int32Response{}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined validator.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		attributeValidator.ValidateInt32(ctx, validateReq, validateResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined validator.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
 // AttributeValidateInt64 performs all types.Int64 validation.
 func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWithInt64Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.Int64Valuable until custom types cannot re-implement
@@ -726,6 +862,71 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 	}
 }
 
+// AttributeValidateTuple performs all types.Tuple validation.
+func AttributeValidateTuple(ctx context.Context, attribute fwxschema.AttributeWithTupleValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Use basetypes.TupleValuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.TupleValuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Tuple Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Tuple attribute validation. "+
+				"The value type must implement the basetypes.TupleValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToTupleValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.TupleRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.TupleValidators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.TupleResponse{}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined validator.Tuple",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		attributeValidator.ValidateTuple(ctx, validateReq, validateResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined validator.Tuple",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
 // AttributeValidateNestedAttributes performs all nested Attributes validation.
 //
 // TODO: Clean up this abstraction back into an internal Attribute type method.
@@ -3588,6 +3588,253 @@ func TestAttributeValidateString(t *testing.T) {
 	}
 }
 
+func TestAttributeValidateTuple(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithTupleValidators
+		request   ValidateAttributeRequest
+		response  *ValidateAttributeResponse
+		expected  *ValidateAttributeResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{
+					types.StringType,
+				},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected TupleRequest.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{types.StringType},
+					[]attr.Value{types.StringValue("testvalue")},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{
+					types.StringType,
+				},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected TupleRequest.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{types.StringType},
+					[]attr.Value{types.StringValue("testvalue")},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{
+					types.StringType,
+				},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(
+											tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+											[]tftypes.Value{
+												tftypes.NewValue(tftypes.String, "testvalue"),
+											},
+										),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected TupleRequest.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{types.StringType},
+					[]attr.Value{types.StringValue("testvalue")},
+				),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+								[]tftypes.Value{
+									tftypes.NewValue(tftypes.String, "testvalue"),
+								},
+							),
+						},
+					),
+				},
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{
+					types.StringType,
+				},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							got := req.ConfigValue
+							expected := types.TupleValueMust(
+								[]attr.Type{types.StringType},
+								[]attr.Value{types.StringValue("testvalue")},
+							)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected TupleRequest.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{types.StringType},
+					[]attr.Value{types.StringValue("testvalue")},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{
+					types.StringType,
+				},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.TupleValueMust(
+					[]attr.Type{types.StringType},
+					[]attr.Value{types.StringValue("testvalue")},
+				),
+			},
+			response: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributeValidateTuple(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNestedAttributeObjectValidateObject(t *testing.T) {
 	t.Parallel()
 
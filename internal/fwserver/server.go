@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -33,6 +34,11 @@ type Server struct {
 	// to [resource.ConfigureRequest.ProviderData].
 	ResourceConfigureData any
 
+	// Interceptors are called around server operations, such as
+	// CreateResource. Before methods are called in order and After methods
+	// are called in reverse order.
+	Interceptors []interceptor.Interceptor
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
@@ -24,6 +24,9 @@ type ApplyResourceChangeRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which is given to interceptors.
+	TypeName string
 }
 
 // ApplyResourceChangeResponse is the framework server response for the
@@ -51,6 +54,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 			ProviderMeta:   req.ProviderMeta,
 			ResourceSchema: req.ResourceSchema,
 			Resource:       req.Resource,
+			TypeName:       req.TypeName,
 		}
 		createResp := &CreateResourceResponse{}
 
@@ -73,6 +77,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 			ProviderMeta:   req.ProviderMeta,
 			ResourceSchema: req.ResourceSchema,
 			Resource:       req.Resource,
+			TypeName:       req.TypeName,
 		}
 		deleteResp := &DeleteResourceResponse{}
 
@@ -96,6 +101,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		ProviderMeta:   req.ProviderMeta,
 		ResourceSchema: req.ResourceSchema,
 		Resource:       req.Resource,
+		TypeName:       req.TypeName,
 	}
 	updateResp := &UpdateResourceResponse{}
 
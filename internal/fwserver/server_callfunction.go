@@ -6,8 +6,11 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 // CallFunctionRequest is the framework server request for the
@@ -16,6 +19,9 @@ type CallFunctionRequest struct {
 	Arguments          function.ArgumentsData
 	Function           function.Function
 	FunctionDefinition function.Definition
+
+	// Name is the function name, which is given to interceptors.
+	Name string
 }
 
 // CallFunctionResponse is the framework server response for the
@@ -31,6 +37,35 @@ func (s *Server) CallFunction(ctx context.Context, req *CallFunctionRequest, res
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation: interceptor.OperationCallFunction,
+		TypeName:  req.Name,
+		Arguments: req.Arguments,
+	}
+
+	var funcDiags diag.Diagnostics
+
+	diags := s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.callFunction(ctx, req, resp)
+
+		funcDiags = function.DiagsFromFuncError(resp.Error)
+
+		return funcDiags, nil
+	})
+
+	// Preserve the function error unless interceptors modified it, as
+	// converting diagnostics back into a function error is not lossless.
+	if len(s.Interceptors) > 0 && !diags.Equal(funcDiags) {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	}
+}
+
+// callFunction implements CallFunction without interceptors.
+func (s *Server) callFunction(ctx context.Context, req *CallFunctionRequest, resp *CallFunctionResponse) {
+	if req == nil {
+		return
+	}
+
 	resultData, err := req.FunctionDefinition.Return.NewResultData(ctx)
 
 	resp.Error = function.ConcatFuncErrors(resp.Error, err)
@@ -6,12 +6,35 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 // ConfigureProvider implements the framework server ConfigureProvider RPC.
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	if req == nil {
+		return
+	}
+
+	config := req.Config
+
+	interceptorReq := interceptor.Request{
+		Operation: interceptor.OperationConfigureProvider,
+		Config:    &config,
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.configureProvider(ctx, req, resp)
+
+		return resp.Diagnostics, nil
+	})
+}
+
+// configureProvider implements ConfigureProvider without interceptors.
+func (s *Server) configureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	logging.FrameworkTrace(ctx, "Calling provider defined Provider Configure")
 
 	if req != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -26,6 +27,9 @@ type CreateResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which is given to interceptors.
+	TypeName string
 }
 
 // CreateResourceResponse is the framework server response for a create request
@@ -43,6 +47,27 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation:    interceptor.OperationCreateResource,
+		TypeName:     req.TypeName,
+		Config:       interceptorConfig(req.Config),
+		Plan:         interceptorPlan(req.PlannedState),
+		ProviderMeta: interceptorConfig(req.ProviderMeta),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.createResource(ctx, req, resp)
+
+		return resp.Diagnostics, resp.NewState
+	})
+}
+
+// createResource implements CreateResource without interceptors.
+func (s *Server) createResource(ctx context.Context, req *CreateResourceRequest, resp *CreateResourceResponse) {
+	if req == nil {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -24,6 +25,9 @@ type DeleteResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which is given to interceptors.
+	TypeName string
 }
 
 // DeleteResourceResponse is the framework server response for a delete request
@@ -41,6 +45,26 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation:    interceptor.OperationDeleteResource,
+		TypeName:     req.TypeName,
+		ProviderMeta: interceptorConfig(req.ProviderMeta),
+		State:        interceptorState(req.PriorState),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.deleteResource(ctx, req, resp)
+
+		return resp.Diagnostics, resp.NewState
+	})
+}
+
+// deleteResource implements DeleteResource without interceptors.
+func (s *Server) deleteResource(ctx context.Context, req *DeleteResourceRequest, resp *DeleteResourceResponse) {
+	if req == nil {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -50,6 +51,25 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation: interceptor.OperationImportResourceState,
+		TypeName:  req.TypeName,
+		ID:        req.ID,
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.importResourceState(ctx, req, resp)
+
+		return resp.Diagnostics, nil
+	})
+}
+
+// importResourceState implements ImportResourceState without interceptors.
+func (s *Server) importResourceState(ctx context.Context, req *ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+	if req == nil {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// intercept calls the Before method of each interceptor in order, then the
+// operation, then the After method of each interceptor in reverse order. If a
+// Before method returns an error diagnostic, the remaining Before methods and
+// the operation are skipped, and only the After methods of interceptors whose
+// Before method was called are called.
+//
+// The operation returns its response diagnostics and new state, if any. The
+// returned diagnostics should replace the operation response diagnostics.
+func (s *Server) intercept(ctx context.Context, req interceptor.Request, operation func(context.Context) (diag.Diagnostics, *tfsdk.State)) diag.Diagnostics {
+	if len(s.Interceptors) == 0 {
+		diags, _ := operation(ctx)
+
+		return diags
+	}
+
+	var diags diag.Diagnostics
+	var state *tfsdk.State
+
+	// Each After method is given the context from its Before method.
+	afterContexts := make([]context.Context, 0, len(s.Interceptors))
+
+	for _, i := range s.Interceptors {
+		beforeResp := &interceptor.BeforeResponse{
+			Context: ctx,
+		}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined Interceptor Before")
+		i.Before(ctx, req, beforeResp)
+		logging.FrameworkTrace(ctx, "Called provider defined Interceptor Before")
+
+		if beforeResp.Context != nil {
+			ctx = beforeResp.Context
+		}
+
+		afterContexts = append(afterContexts, ctx)
+		diags.Append(beforeResp.Diagnostics...)
+
+		if diags.HasError() {
+			break
+		}
+	}
+
+	if !diags.HasError() {
+		operationDiags, operationState := operation(ctx)
+
+		diags.Append(operationDiags...)
+
+		state = interceptorState(operationState)
+	}
+
+	for idx := len(afterContexts) - 1; idx >= 0; idx-- {
+		afterResp := &interceptor.AfterResponse{
+			Diagnostics: diags,
+			State:       state,
+		}
+
+		logging.FrameworkTrace(afterContexts[idx], "Calling provider defined Interceptor After")
+		s.Interceptors[idx].After(afterContexts[idx], req, afterResp)
+		logging.FrameworkTrace(afterContexts[idx], "Called provider defined Interceptor After")
+
+		diags = afterResp.Diagnostics
+	}
+
+	return diags
+}
+
+// interceptorConfig returns a copy of the configuration for an interceptor
+// request, so interceptors cannot modify the operation request.
+func interceptorConfig(config *tfsdk.Config) *tfsdk.Config {
+	if config == nil {
+		return nil
+	}
+
+	result := *config
+
+	return &result
+}
+
+// interceptorPlan returns a copy of the plan for an interceptor request, so
+// interceptors cannot modify the operation request.
+func interceptorPlan(plan *tfsdk.Plan) *tfsdk.Plan {
+	if plan == nil {
+		return nil
+	}
+
+	result := *plan
+
+	return &result
+}
+
+// interceptorState returns a copy of the state for an interceptor request, so
+// interceptors cannot modify the operation request.
+func interceptorState(state *tfsdk.State) *tfsdk.State {
+	if state == nil {
+		return nil
+	}
+
+	result := *state
+
+	return &result
+}
@@ -0,0 +1,932 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// testInterceptorContextKey is the context key set by the interceptors of
+// the interceptor tests.
+type testInterceptorContextKey struct{}
+
+// testInterceptorContextValue returns the context value set by the
+// interceptors of the interceptor tests, or an empty string.
+func testInterceptorContextValue(ctx context.Context) string {
+	value, _ := ctx.Value(testInterceptorContextKey{}).(string)
+
+	return value
+}
+
+// testInterceptorOperationDiagnostics returns the diagnostics added by the
+// provider-defined logic of the interceptor tests, which include the context
+// value to verify the context of the interceptors was given to the operation.
+func testInterceptorOperationDiagnostics(ctx context.Context) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewWarningDiagnostic("Operation", testInterceptorContextValue(ctx)),
+	}
+}
+
+func TestServerInterceptorsOperations(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testUpgradeSchema := schema.Schema{
+		Attributes: testSchema.Attributes,
+		Version:    1,
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchema,
+	}
+
+	testPlan := &tfsdk.Plan{
+		Raw:    testValue,
+		Schema: testSchema,
+	}
+
+	testState := &tfsdk.State{
+		Raw:    testValue,
+		Schema: testSchema,
+	}
+
+	testEmptyState := &tfsdk.State{
+		Raw:    tftypes.NewValue(testType, nil),
+		Schema: testSchema,
+	}
+
+	testUpgradedState := &tfsdk.State{
+		Raw:    testValue,
+		Schema: testUpgradeSchema,
+	}
+
+	testArguments := function.NewArgumentsData([]attr.Value{
+		basetypes.NewStringValue("test-argument"),
+	})
+
+	testCases := map[string]struct {
+		operation           func(context.Context, *fwserver.Server) diag.Diagnostics
+		provider            provider.Provider
+		expectedRequest     interceptor.Request
+		expectedState       *tfsdk.State
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"ApplyResourceChange": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.ApplyResourceChangeResponse{}
+
+				s.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+					Config:         testConfig,
+					PlannedState:   testPlan,
+					PriorState:     testEmptyState,
+					ResourceSchema: testSchema,
+					Resource: &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+							resp.State.Raw = req.Plan.Raw
+						},
+					},
+					TypeName: "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationCreateResource,
+				TypeName:  "test_resource",
+				Config:    testConfig,
+				Plan:      testPlan,
+			},
+			expectedState: testState,
+		},
+		"CallFunction": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.CallFunctionResponse{}
+
+				s.CallFunction(ctx, &fwserver.CallFunctionRequest{
+					Arguments: testArguments,
+					Function: &testprovider.Function{
+						RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+							resp.Error = function.FuncErrorFromDiags(ctx, append(
+								testInterceptorOperationDiagnostics(ctx),
+								diag.NewErrorDiagnostic("Function Error", "test detail"),
+							))
+						},
+					},
+					FunctionDefinition: function.Definition{
+						Parameters: []function.Parameter{
+							function.StringParameter{},
+						},
+						Return: function.StringReturn{},
+					},
+					Name: "test_function",
+				}, resp)
+
+				return function.DiagsFromFuncError(resp.Error)
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationCallFunction,
+				TypeName:  "test_function",
+				Arguments: testArguments,
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Function Error", "test detail"),
+			},
+		},
+		"ConfigureProvider": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &provider.ConfigureResponse{}
+
+				s.ConfigureProvider(ctx, &provider.ConfigureRequest{
+					Config: *testConfig,
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			provider: &testprovider.Provider{
+				ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+					resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+				},
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationConfigureProvider,
+				Config:    testConfig,
+			},
+		},
+		"CreateResource": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.CreateResourceResponse{}
+
+				s.CreateResource(ctx, &fwserver.CreateResourceRequest{
+					Config:         testConfig,
+					PlannedState:   testPlan,
+					ResourceSchema: testSchema,
+					Resource: &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+							resp.State.Raw = req.Plan.Raw
+						},
+					},
+					TypeName: "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationCreateResource,
+				TypeName:  "test_resource",
+				Config:    testConfig,
+				Plan:      testPlan,
+			},
+			expectedState: testState,
+		},
+		"DeleteResource": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.DeleteResourceResponse{}
+
+				s.DeleteResource(ctx, &fwserver.DeleteResourceRequest{
+					PriorState:     testState,
+					ResourceSchema: testSchema,
+					Resource: &testprovider.Resource{
+						DeleteMethod: func(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+						},
+					},
+					TypeName: "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationDeleteResource,
+				TypeName:  "test_resource",
+				State:     testState,
+			},
+			expectedState: testEmptyState,
+		},
+		"ImportResourceState": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.ImportResourceStateResponse{}
+
+				s.ImportResourceState(ctx, &fwserver.ImportResourceStateRequest{
+					EmptyState: *testEmptyState,
+					ID:         "test-value",
+					Resource: &testprovider.ResourceWithImportState{
+						Resource: &testprovider.Resource{},
+						ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+							resource.ImportStatePassthroughID(ctx, path.Root("test_required"), req, resp)
+						},
+					},
+					TypeName: "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationImportResourceState,
+				TypeName:  "test_resource",
+				ID:        "test-value",
+			},
+		},
+		"MoveResourceState": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.MoveResourceStateResponse{}
+
+				s.MoveResourceState(ctx, &fwserver.MoveResourceStateRequest{
+					SourceRawState: testNewRawState(t, map[string]interface{}{
+						"test_required": "test-value",
+					}),
+					SourceTypeName: "test_source",
+					TargetResource: &testprovider.ResourceWithMoveState{
+						Resource: &testprovider.Resource{},
+						MoveStateMethod: func(_ context.Context) []resource.StateMover {
+							return []resource.StateMover{
+								{
+									StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+										resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+										resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("test_required"), "test-value")...)
+									},
+								},
+							}
+						},
+					},
+					TargetResourceSchema: testSchema,
+					TargetTypeName:       "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationMoveResourceState,
+				TypeName:  "test_resource",
+			},
+			expectedState: testState,
+		},
+		"PlanResourceChange": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.PlanResourceChangeResponse{}
+
+				s.PlanResourceChange(ctx, &fwserver.PlanResourceChangeRequest{
+					Config:           testConfig,
+					PriorState:       testState,
+					ProposedNewState: testPlan,
+					ResourceSchema:   testSchema,
+					Resource: &testprovider.ResourceWithModifyPlan{
+						Resource: &testprovider.Resource{},
+						ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+						},
+					},
+					TypeName: "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationPlanResourceChange,
+				TypeName:  "test_resource",
+				Config:    testConfig,
+				Plan:      testPlan,
+				State:     testState,
+			},
+			expectedState: testState,
+		},
+		"ReadDataSource": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.ReadDataSourceResponse{}
+
+				s.ReadDataSource(ctx, &fwserver.ReadDataSourceRequest{
+					Config: testConfig,
+					DataSource: &testprovider.DataSource{
+						ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+							resp.State.Raw = req.Config.Raw
+						},
+					},
+					DataSourceSchema: testSchema,
+					TypeName:         "test_data_source",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationReadDataSource,
+				TypeName:  "test_data_source",
+				Config:    testConfig,
+			},
+			expectedState: testState,
+		},
+		"ReadResource": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.ReadResourceResponse{}
+
+				s.ReadResource(ctx, &fwserver.ReadResourceRequest{
+					CurrentState: testState,
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+						},
+					},
+					TypeName: "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationReadResource,
+				TypeName:  "test_resource",
+				State:     testState,
+			},
+			expectedState: testState,
+		},
+		"UpdateResource": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.UpdateResourceResponse{}
+
+				s.UpdateResource(ctx, &fwserver.UpdateResourceRequest{
+					Config:         testConfig,
+					PlannedState:   testPlan,
+					PriorState:     testState,
+					ResourceSchema: testSchema,
+					Resource: &testprovider.Resource{
+						UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+							resp.State.Raw = req.Plan.Raw
+						},
+					},
+					TypeName: "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationUpdateResource,
+				TypeName:  "test_resource",
+				Config:    testConfig,
+				Plan:      testPlan,
+				State:     testState,
+			},
+			expectedState: testState,
+		},
+		"UpgradeResourceState": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.UpgradeResourceStateResponse{}
+
+				s.UpgradeResourceState(ctx, &fwserver.UpgradeResourceStateRequest{
+					RawState: testNewRawState(t, map[string]interface{}{
+						"test_required": "test-value",
+					}),
+					ResourceSchema: testUpgradeSchema,
+					Resource: &testprovider.ResourceWithUpgradeState{
+						Resource: &testprovider.Resource{},
+						UpgradeStateMethod: func(_ context.Context) map[int64]resource.StateUpgrader {
+							return map[int64]resource.StateUpgrader{
+								0: {
+									StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+										resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+										resp.State.Raw = testValue
+									},
+								},
+							}
+						},
+					},
+					TypeName: "test_resource",
+					Version:  0,
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationUpgradeResourceState,
+				TypeName:  "test_resource",
+			},
+			expectedState: testUpgradedState,
+		},
+		"ValidateDataSourceConfig": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.ValidateDataSourceConfigResponse{}
+
+				s.ValidateDataSourceConfig(ctx, &fwserver.ValidateDataSourceConfigRequest{
+					Config: testConfig,
+					DataSource: &testprovider.DataSourceWithValidateConfig{
+						DataSource: &testprovider.DataSource{},
+						ValidateConfigMethod: func(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+						},
+					},
+					TypeName: "test_data_source",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationValidateDataSourceConfig,
+				TypeName:  "test_data_source",
+				Config:    testConfig,
+			},
+		},
+		"ValidateProviderConfig": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.ValidateProviderConfigResponse{}
+
+				s.ValidateProviderConfig(ctx, &fwserver.ValidateProviderConfigRequest{
+					Config: testConfig,
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			provider: &testprovider.ProviderWithValidateConfig{
+				Provider: &testprovider.Provider{},
+				ValidateConfigMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+					resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+				},
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationValidateProviderConfig,
+				Config:    testConfig,
+			},
+		},
+		"ValidateResourceConfig": {
+			operation: func(ctx context.Context, s *fwserver.Server) diag.Diagnostics {
+				resp := &fwserver.ValidateResourceConfigResponse{}
+
+				s.ValidateResourceConfig(ctx, &fwserver.ValidateResourceConfigRequest{
+					Config: testConfig,
+					Resource: &testprovider.ResourceWithValidateConfig{
+						Resource: &testprovider.Resource{},
+						ValidateConfigMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+							resp.Diagnostics.Append(testInterceptorOperationDiagnostics(ctx)...)
+						},
+					},
+					TypeName: "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expectedRequest: interceptor.Request{
+				Operation: interceptor.OperationValidateResourceConfig,
+				TypeName:  "test_resource",
+				Config:    testConfig,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotRequests []interceptor.Request
+			var gotState *tfsdk.State
+
+			server := &fwserver.Server{
+				Interceptors: []interceptor.Interceptor{
+					&testprovider.Interceptor{
+						BeforeMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.BeforeResponse) {
+							gotRequests = append(gotRequests, req)
+							resp.Context = context.WithValue(ctx, testInterceptorContextKey{}, "test-context-value")
+							resp.Diagnostics.AddWarning("Before", "")
+						},
+						AfterMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.AfterResponse) {
+							gotRequests = append(gotRequests, req)
+							gotState = resp.State
+							resp.Diagnostics.AddWarning("After", testInterceptorContextValue(ctx))
+						},
+					},
+				},
+				Provider: testCase.provider,
+			}
+
+			if server.Provider == nil {
+				server.Provider = &testprovider.Provider{}
+			}
+
+			got := testCase.operation(context.Background(), server)
+
+			expectedDiagnostics := diag.Diagnostics{
+				diag.NewWarningDiagnostic("Before", ""),
+				diag.NewWarningDiagnostic("Operation", "test-context-value"),
+			}
+			expectedDiagnostics.Append(testCase.expectedDiagnostics...)
+			expectedDiagnostics.AddWarning("After", "test-context-value")
+
+			if diff := cmp.Diff(got, expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			expectedRequests := []interceptor.Request{testCase.expectedRequest, testCase.expectedRequest}
+
+			if diff := cmp.Diff(gotRequests, expectedRequests, cmp.AllowUnexported(function.ArgumentsData{})); diff != "" {
+				t.Errorf("unexpected requests difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotState, testCase.expectedState); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testRecordingInterceptor returns an interceptor which records its calls
+// with the context value, then sets the context value to its name. The
+// Before method returns the given diagnostics.
+func testRecordingInterceptor(name string, calls *[]string, beforeDiags diag.Diagnostics) interceptor.Interceptor {
+	return &testprovider.Interceptor{
+		BeforeMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.BeforeResponse) {
+			*calls = append(*calls, "before-"+name+": "+testInterceptorContextValue(ctx))
+			resp.Context = context.WithValue(ctx, testInterceptorContextKey{}, name)
+			resp.Diagnostics = beforeDiags
+		},
+		AfterMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.AfterResponse) {
+			*calls = append(*calls, "after-"+name+": "+testInterceptorContextValue(ctx))
+		},
+	}
+}
+
+func TestServerInterceptors(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testCurrentState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testNewState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, "test-newstate-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testCases := map[string]struct {
+		interceptors        func(calls *[]string) []interceptor.Interceptor
+		operationDiags      diag.Diagnostics
+		expectedCalls       []string
+		expectedDiagnostics diag.Diagnostics
+		expectedNewState    *tfsdk.State
+	}{
+		"none": {
+			interceptors: func(_ *[]string) []interceptor.Interceptor {
+				return nil
+			},
+			expectedCalls: []string{
+				"operation: ",
+			},
+			expectedNewState: testNewState,
+		},
+		"order": {
+			interceptors: func(calls *[]string) []interceptor.Interceptor {
+				return []interceptor.Interceptor{
+					testRecordingInterceptor("one", calls, nil),
+					testRecordingInterceptor("two", calls, nil),
+				}
+			},
+			expectedCalls: []string{
+				"before-one: ",
+				"before-two: one",
+				"operation: two",
+				"after-two: two",
+				"after-one: one",
+			},
+			expectedNewState: testNewState,
+		},
+		"before-context-nil": {
+			interceptors: func(calls *[]string) []interceptor.Interceptor {
+				return []interceptor.Interceptor{
+					testRecordingInterceptor("one", calls, nil),
+					&testprovider.Interceptor{
+						BeforeMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.BeforeResponse) {
+							resp.Context = nil
+						},
+					},
+				}
+			},
+			expectedCalls: []string{
+				"before-one: ",
+				"operation: one",
+				"after-one: one",
+			},
+			expectedNewState: testNewState,
+		},
+		"before-warning": {
+			interceptors: func(calls *[]string) []interceptor.Interceptor {
+				return []interceptor.Interceptor{
+					testRecordingInterceptor("one", calls, diag.Diagnostics{
+						diag.NewWarningDiagnostic("Before Warning", "test detail"),
+					}),
+				}
+			},
+			operationDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Operation Warning", "test detail"),
+			},
+			expectedCalls: []string{
+				"before-one: ",
+				"operation: one",
+				"after-one: one",
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Before Warning", "test detail"),
+				diag.NewWarningDiagnostic("Operation Warning", "test detail"),
+			},
+			expectedNewState: testNewState,
+		},
+		"before-error": {
+			interceptors: func(calls *[]string) []interceptor.Interceptor {
+				return []interceptor.Interceptor{
+					testRecordingInterceptor("one", calls, nil),
+					testRecordingInterceptor("two", calls, diag.Diagnostics{
+						diag.NewErrorDiagnostic("Before Error", "test detail"),
+					}),
+					testRecordingInterceptor("three", calls, nil),
+				}
+			},
+			expectedCalls: []string{
+				"before-one: ",
+				"before-two: one",
+				"after-two: two",
+				"after-one: one",
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Before Error", "test detail"),
+			},
+		},
+		"after-diagnostics-append": {
+			interceptors: func(calls *[]string) []interceptor.Interceptor {
+				return []interceptor.Interceptor{
+					&testprovider.Interceptor{
+						AfterMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.AfterResponse) {
+							resp.Diagnostics.AddWarning("After Warning", "test detail")
+						},
+					},
+				}
+			},
+			operationDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Operation Error", "test detail"),
+			},
+			expectedCalls: []string{
+				"operation: ",
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Operation Error", "test detail"),
+				diag.NewWarningDiagnostic("After Warning", "test detail"),
+			},
+			expectedNewState: testNewState,
+		},
+		"after-diagnostics-replace": {
+			interceptors: func(calls *[]string) []interceptor.Interceptor {
+				return []interceptor.Interceptor{
+					&testprovider.Interceptor{
+						AfterMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.AfterResponse) {
+							resp.Diagnostics = nil
+						},
+					},
+				}
+			},
+			operationDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Operation Error", "test detail"),
+			},
+			expectedCalls: []string{
+				"operation: ",
+			},
+			expectedNewState: testNewState,
+		},
+		"after-state": {
+			interceptors: func(calls *[]string) []interceptor.Interceptor {
+				return []interceptor.Interceptor{
+					&testprovider.Interceptor{
+						AfterMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.AfterResponse) {
+							if diff := cmp.Diff(resp.State, testNewState); diff != "" {
+								resp.Diagnostics.AddError("Unexpected State", diff)
+							}
+
+							// Modifications should not affect the response.
+							resp.State.Raw = tftypes.NewValue(testType, nil)
+						},
+					},
+				}
+			},
+			expectedCalls: []string{
+				"operation: ",
+			},
+			expectedNewState: testNewState,
+		},
+		"request-state": {
+			interceptors: func(calls *[]string) []interceptor.Interceptor {
+				return []interceptor.Interceptor{
+					&testprovider.Interceptor{
+						BeforeMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.BeforeResponse) {
+							if diff := cmp.Diff(req.State, testCurrentState); diff != "" {
+								resp.Diagnostics.AddError("Unexpected State", diff)
+							}
+
+							// Modifications should not affect the request.
+							req.State.Raw = tftypes.NewValue(testType, nil)
+						},
+					},
+				}
+			},
+			expectedCalls: []string{
+				"operation: ",
+			},
+			expectedNewState: testNewState,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+
+			server := &fwserver.Server{
+				Interceptors: testCase.interceptors(&calls),
+				Provider:     &testprovider.Provider{},
+			}
+
+			currentState := *testCurrentState
+
+			request := &fwserver.ReadResourceRequest{
+				CurrentState: &currentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						calls = append(calls, "operation: "+testInterceptorContextValue(ctx))
+
+						if diff := cmp.Diff(req.State, *testCurrentState); diff != "" {
+							resp.Diagnostics.AddError("Unexpected Request State", diff)
+						}
+
+						resp.Diagnostics.Append(testCase.operationDiags...)
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-newstate-value")...)
+					},
+				},
+				TypeName: "test_resource",
+			}
+			response := &fwserver.ReadResourceResponse{}
+
+			server.ReadResource(context.Background(), request, response)
+
+			if diff := cmp.Diff(calls, testCase.expectedCalls); diff != "" {
+				t.Errorf("unexpected calls difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(response.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(response.NewState, testCase.expectedNewState); diff != "" {
+				t.Errorf("unexpected new state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerInterceptorsCallFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interceptors  []interceptor.Interceptor
+		runError      *function.FuncError
+		expectedError *function.FuncError
+	}{
+		"none": {
+			runError:      function.NewArgumentFuncError(0, "test error"),
+			expectedError: function.NewArgumentFuncError(0, "test error"),
+		},
+		"preserved": {
+			interceptors: []interceptor.Interceptor{
+				&testprovider.Interceptor{},
+			},
+			runError:      function.NewArgumentFuncError(0, "test error"),
+			expectedError: function.NewArgumentFuncError(0, "test error"),
+		},
+		"after-error": {
+			interceptors: []interceptor.Interceptor{
+				&testprovider.Interceptor{
+					AfterMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.AfterResponse) {
+						resp.Diagnostics.AddError("After Error", "test detail")
+					},
+				},
+			},
+			expectedError: function.NewFuncError("After Error: test detail"),
+		},
+		"after-replace": {
+			interceptors: []interceptor.Interceptor{
+				&testprovider.Interceptor{
+					AfterMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.AfterResponse) {
+						resp.Diagnostics = nil
+					},
+				},
+			},
+			runError: function.NewFuncError("test error"),
+		},
+		"before-error": {
+			interceptors: []interceptor.Interceptor{
+				&testprovider.Interceptor{
+					BeforeMethod: func(ctx context.Context, req interceptor.Request, resp *interceptor.BeforeResponse) {
+						resp.Diagnostics.AddError("Before Error", "test detail")
+					},
+				},
+			},
+			runError:      function.NewFuncError("unexpected run"),
+			expectedError: function.NewFuncError("Before Error: test detail"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Interceptors: testCase.interceptors,
+				Provider:     &testprovider.Provider{},
+			}
+
+			request := &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					basetypes.NewStringValue("test-argument"),
+				}),
+				Function: &testprovider.Function{
+					RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+						resp.Error = testCase.runError
+					},
+				},
+				FunctionDefinition: function.Definition{
+					Parameters: []function.Parameter{
+						function.StringParameter{},
+					},
+					Return: function.StringReturn{},
+				},
+				Name: "test_function",
+			}
+			response := &fwserver.CallFunctionResponse{}
+
+			server.CallFunction(context.Background(), request, response)
+
+			// Compare the exported fields, as function errors converted from
+			// diagnostics retain the diagnostics.
+			if diff := cmp.Diff(response.Error, testCase.expectedError, cmpopts.IgnoreUnexported(function.FuncError{})); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
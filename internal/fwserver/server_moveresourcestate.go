@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -81,6 +82,24 @@ func (s *Server) MoveResourceState(ctx context.Context, req *MoveResourceStateRe
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation: interceptor.OperationMoveResourceState,
+		TypeName:  req.TargetTypeName,
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.moveResourceState(ctx, req, resp)
+
+		return resp.Diagnostics, resp.TargetState
+	})
+}
+
+// moveResourceState implements MoveResourceState without interceptors.
+func (s *Server) moveResourceState(ctx context.Context, req *MoveResourceStateRequest, resp *MoveResourceStateResponse) {
+	if req == nil {
+		return
+	}
+
 	if req.SourceRawState == nil {
 		resp.Diagnostics.AddError(
 			"Missing Source Resource State",
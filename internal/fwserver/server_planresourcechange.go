@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -32,6 +33,9 @@ type PlanResourceChangeRequest struct {
 	ProviderMeta     *tfsdk.Config
 	ResourceSchema   fwschema.Schema
 	Resource         resource.Resource
+
+	// TypeName is the resource type name, which is given to interceptors.
+	TypeName string
 }
 
 // PlanResourceChangeResponse is the framework server response for the
@@ -49,6 +53,28 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation:    interceptor.OperationPlanResourceChange,
+		TypeName:     req.TypeName,
+		Config:       interceptorConfig(req.Config),
+		Plan:         interceptorPlan(req.ProposedNewState),
+		ProviderMeta: interceptorConfig(req.ProviderMeta),
+		State:        interceptorState(req.PriorState),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.planResourceChange(ctx, req, resp)
+
+		return resp.Diagnostics, resp.PlannedState
+	})
+}
+
+// planResourceChange implements PlanResourceChange without interceptors.
+func (s *Server) planResourceChange(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) {
+	if req == nil {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -362,10 +388,18 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			if a.BoolDefaultValue() != nil {
 				return val, nil
 			}
+		case fwschema.AttributeWithFloat32DefaultValue:
+			if a.Float32DefaultValue() != nil {
+				return val, nil
+			}
 		case fwschema.AttributeWithFloat64DefaultValue:
 			if a.Float64DefaultValue() != nil {
 				return val, nil
 			}
+		case fwschema.AttributeWithInt32DefaultValue:
+			if a.Int32DefaultValue() != nil {
+				return val, nil
+			}
 		case fwschema.AttributeWithInt64DefaultValue:
 			if a.Int64DefaultValue() != nil {
 				return val, nil
@@ -394,6 +428,10 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			if a.StringDefaultValue() != nil {
 				return val, nil
 			}
+		case fwschema.AttributeWithTupleDefaultValue:
+			if a.TupleDefaultValue() != nil {
+				return val, nil
+			}
 		}
 
 		logging.FrameworkDebug(ctx, "marking computed attribute that is null in the config as unknown")
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -21,6 +22,9 @@ type ReadDataSourceRequest struct {
 	DataSourceSchema fwschema.Schema
 	DataSource       datasource.DataSource
 	ProviderMeta     *tfsdk.Config
+
+	// TypeName is the data source type name, which is given to interceptors.
+	TypeName string
 }
 
 // ReadDataSourceResponse is the framework server response for the
@@ -36,6 +40,26 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation:    interceptor.OperationReadDataSource,
+		TypeName:     req.TypeName,
+		Config:       interceptorConfig(req.Config),
+		ProviderMeta: interceptorConfig(req.ProviderMeta),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.readDataSource(ctx, req, resp)
+
+		return resp.Diagnostics, resp.State
+	})
+}
+
+// readDataSource implements ReadDataSource without interceptors.
+func (s *Server) readDataSource(ctx context.Context, req *ReadDataSourceRequest, resp *ReadDataSourceResponse) {
+	if req == nil {
+		return
+	}
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
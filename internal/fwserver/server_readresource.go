@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -21,6 +22,9 @@ type ReadResourceRequest struct {
 	Resource     resource.Resource
 	Private      *privatestate.Data
 	ProviderMeta *tfsdk.Config
+
+	// TypeName is the resource type name, which is given to interceptors.
+	TypeName string
 }
 
 // ReadResourceResponse is the framework server response for the
@@ -37,6 +41,26 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation:    interceptor.OperationReadResource,
+		TypeName:     req.TypeName,
+		ProviderMeta: interceptorConfig(req.ProviderMeta),
+		State:        interceptorState(req.CurrentState),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.readResource(ctx, req, resp)
+
+		return resp.Diagnostics, resp.NewState
+	})
+}
+
+// readResource implements ReadResource without interceptors.
+func (s *Server) readResource(ctx context.Context, req *ReadResourceRequest, resp *ReadResourceResponse) {
+	if req == nil {
+		return
+	}
+
 	if req.CurrentState == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Read Request",
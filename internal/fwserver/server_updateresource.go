@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -27,6 +28,9 @@ type UpdateResourceRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
+
+	// TypeName is the resource type name, which is given to interceptors.
+	TypeName string
 }
 
 // UpdateResourceResponse is the framework server response for an update request
@@ -44,6 +48,28 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation:    interceptor.OperationUpdateResource,
+		TypeName:     req.TypeName,
+		Config:       interceptorConfig(req.Config),
+		Plan:         interceptorPlan(req.PlannedState),
+		ProviderMeta: interceptorConfig(req.ProviderMeta),
+		State:        interceptorState(req.PriorState),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.updateResource(ctx, req, resp)
+
+		return resp.Diagnostics, resp.NewState
+	})
+}
+
+// updateResource implements UpdateResource without interceptors.
+func (s *Server) updateResource(ctx context.Context, req *UpdateResourceRequest, resp *UpdateResourceResponse) {
+	if req == nil {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
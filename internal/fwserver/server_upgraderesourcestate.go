@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,6 +35,9 @@ type UpgradeResourceStateRequest struct {
 	ResourceSchema fwschema.Schema
 	Resource       resource.Resource
 	Version        int64
+
+	// TypeName is the resource type name, which is given to interceptors.
+	TypeName string
 }
 
 // UpgradeResourceStateResponse is the framework server response for the
@@ -49,6 +53,24 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation: interceptor.OperationUpgradeResourceState,
+		TypeName:  req.TypeName,
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.upgradeResourceState(ctx, req, resp)
+
+		return resp.Diagnostics, resp.UpgradedState
+	})
+}
+
+// upgradeResourceState implements UpgradeResourceState without interceptors.
+func (s *Server) upgradeResourceState(ctx context.Context, req *UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {
+	if req == nil {
+		return
+	}
+
 	// No UpgradedState to return. This could return an error diagnostic about
 	// the odd scenario, but seems best to allow Terraform CLI to handle the
 	// situation itself in case it might be expected behavior.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -17,6 +18,9 @@ import (
 type ValidateDataSourceConfigRequest struct {
 	Config     *tfsdk.Config
 	DataSource datasource.DataSource
+
+	// TypeName is the data source type name, which is given to interceptors.
+	TypeName string
 }
 
 // ValidateDataSourceConfigResponse is the framework server response for the
@@ -31,6 +35,25 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation: interceptor.OperationValidateDataSourceConfig,
+		TypeName:  req.TypeName,
+		Config:    interceptorConfig(req.Config),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.validateDataSourceConfig(ctx, req, resp)
+
+		return resp.Diagnostics, nil
+	})
+}
+
+// validateDataSourceConfig implements ValidateDataSourceConfig without interceptors.
+func (s *Server) validateDataSourceConfig(ctx context.Context, req *ValidateDataSourceConfigRequest, resp *ValidateDataSourceConfigResponse) {
+	if req == nil || req.Config == nil {
+		return
+	}
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
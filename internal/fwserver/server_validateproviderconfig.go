@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -31,6 +32,24 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation: interceptor.OperationValidateProviderConfig,
+		Config:    interceptorConfig(req.Config),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.validateProviderConfig(ctx, req, resp)
+
+		return resp.Diagnostics, nil
+	})
+}
+
+// validateProviderConfig implements ValidateProviderConfig without interceptors.
+func (s *Server) validateProviderConfig(ctx context.Context, req *ValidateProviderConfigRequest, resp *ValidateProviderConfigResponse) {
+	if req == nil || req.Config == nil {
+		return
+	}
+
 	vpcReq := provider.ValidateConfigRequest{
 		Config: *req.Config,
 	}
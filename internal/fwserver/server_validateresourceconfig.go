@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -17,6 +18,9 @@ import (
 type ValidateResourceConfigRequest struct {
 	Config   *tfsdk.Config
 	Resource resource.Resource
+
+	// TypeName is the resource type name, which is given to interceptors.
+	TypeName string
 }
 
 // ValidateResourceConfigResponse is the framework server response for the
@@ -31,6 +35,25 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		return
 	}
 
+	interceptorReq := interceptor.Request{
+		Operation: interceptor.OperationValidateResourceConfig,
+		TypeName:  req.TypeName,
+		Config:    interceptorConfig(req.Config),
+	}
+
+	resp.Diagnostics = s.intercept(ctx, interceptorReq, func(ctx context.Context) (diag.Diagnostics, *tfsdk.State) {
+		s.validateResourceConfig(ctx, req, resp)
+
+		return resp.Diagnostics, nil
+	})
+}
+
+// validateResourceConfig implements ValidateResourceConfig without interceptors.
+func (s *Server) validateResourceConfig(ctx context.Context, req *ValidateResourceConfigRequest, resp *ValidateResourceConfigResponse) {
+	if req == nil || req.Config == nil {
+		return
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	attrValueType      = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	nullableType       = reflect.TypeOf((*Nullable)(nil)).Elem()
+	unknownableType    = reflect.TypeOf((*Unknownable)(nil)).Elem()
+	valueConverterType = reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()
+)
+
+// maxObjectCodecs is the maximum number of object codecs cached per Go type.
+// Struct types are almost always used with a single object type, so this only
+// bounds the cache if a struct type is used with many different object types.
+const maxObjectCodecs = 8
+
+// codec contains the reflection information about a Go type that is needed
+// to convert values both into and out of that type. Codecs are built once per
+// Go type and cached, so reflection on the same types, such as every element
+// of a large list or every call to Get and Set with the same data model, only
+// pays for inspecting method sets and struct tags once.
+//
+// Struct types additionally have an objectCodec per object attr.Type they are
+// converted with, so codecs are effectively keyed by both the Go type and the
+// attr.Type. attr.Type implementations are not guaranteed to be comparable,
+// such as ObjectType containing a map, so object codecs are found with the
+// attr.Type Equal method rather than by a map key.
+type codec struct {
+	// implementsAttrValue is true if the Go type implements attr.Value.
+	implementsAttrValue bool
+
+	// implementsValueConverter is true if the Go type implements
+	// tftypes.ValueConverter.
+	implementsValueConverter bool
+
+	// implementsUnknownable is true if the Go type implements Unknownable.
+	implementsUnknownable bool
+
+	// implementsNullable is true if the Go type implements Nullable.
+	implementsNullable bool
+
+	// canMarshalText is true if the Go type can be converted into the
+	// string data of string attributes.
+	canMarshalText bool
+
+	// canUnmarshalText is true if the Go type can be parsed from the string
+	// data of string attributes.
+	canUnmarshalText bool
+
+	// structFields is the mapping of Terraform field names to fields of
+	// the Go type, if it is a struct and its fields have been successfully
+	// determined. Errors are not cached, as they include path information
+	// from the value being converted.
+	structFields atomic.Pointer[map[string]structField]
+
+	// objectCodecs contains the object codecs compiled for the Go type,
+	// which is replaced rather than modified, so it can be read without
+	// holding objectCodecsMu.
+	objectCodecs atomic.Pointer[[]*objectCodec]
+
+	// objectCodecsMu serializes updates to objectCodecs.
+	objectCodecsMu sync.Mutex
+}
+
+// objectCodec contains the compiled mapping between the fields of a struct
+// type and the attributes of an object attr.Type, which is used by both
+// Struct and FromStruct.
+type objectCodec struct {
+	// typ is the object type the codec was compiled for.
+	typ attr.TypeWithAttributeTypes
+
+	// fields contains the struct fields sorted by attribute name.
+	fields []objectCodecField
+
+	// objectMissing contains the sorted names of struct fields which are
+	// not object attributes, and of object attributes without a type.
+	objectMissing []string
+
+	// structMissing contains the sorted names of object attributes which
+	// are not struct fields.
+	structMissing []string
+}
+
+// objectCodecField is a struct field with the object attribute it maps to.
+type objectCodecField struct {
+	structField
+
+	// attrName is the name of the object attribute.
+	attrName string
+
+	// attrType is the type of the object attribute, or nil if the object
+	// type has no such attribute.
+	attrType attr.Type
+
+	// tfType is the Terraform type of attrType, which is used to create
+	// the null and unknown values of the "omitempty" and "unknown-as-zero"
+	// tag options.
+	tfType tftypes.Type
+}
+
+// codecs contains the cached codecs, keyed by reflect.Type.
+var codecs sync.Map
+
+// getCodec returns the codec for the Go type `typ`, building and caching it
+// if necessary. It is safe for concurrent use.
+func getCodec(typ reflect.Type) *codec {
+	if c, ok := codecs.Load(typ); ok {
+		return c.(*codec) //nolint:forcetypeassert // only codecs are stored
+	}
+
+	c := &codec{
+		implementsAttrValue:      typ.Implements(attrValueType),
+		implementsValueConverter: typ.Implements(valueConverterType),
+		implementsUnknownable:    typ.Implements(unknownableType),
+		implementsNullable:       typ.Implements(nullableType),
+		canMarshalText:           canMarshalText(typ),
+		canUnmarshalText:         canUnmarshalText(typ),
+	}
+
+	actual, _ := codecs.LoadOrStore(typ, c)
+
+	return actual.(*codec) //nolint:forcetypeassert // only codecs are stored
+}
+
+// fields returns the mapping of Terraform field names to fields of the struct
+// type `typ`, which must be the Go type of the codec. Any error is prefixed
+// with `path`. The result must not be modified.
+func (c *codec) fields(typ reflect.Type, path path.Path) (map[string]structField, error) {
+	if fields := c.structFields.Load(); fields != nil {
+		return *fields, nil
+	}
+
+	fields := map[string]structField{}
+
+	err := addStructFields(fields, typ, nil, "", path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.structFields.Store(&fields)
+
+	return fields, nil
+}
+
+// object returns the object codec for the struct type `typ`, which must be the
+// Go type of the codec, and the object type `attrsType`, compiling and caching
+// it if necessary. Any error is prefixed with `path`. It is safe for
+// concurrent use.
+func (c *codec) object(ctx context.Context, typ reflect.Type, attrsType attr.TypeWithAttributeTypes, path path.Path) (*objectCodec, error) {
+	attrTypes := attrsType.AttributeTypes()
+
+	// object types with missing attribute types can't be compared with
+	// Equal, so their codecs are not cached
+	cacheable := true
+
+	for _, attrType := range attrTypes {
+		if attrType == nil {
+			cacheable = false
+			break
+		}
+	}
+
+	if cached := c.objectCodecs.Load(); cacheable && cached != nil {
+		for _, oc := range *cached {
+			if oc.typ.Equal(attrsType) {
+				return oc, nil
+			}
+		}
+	}
+
+	fields, err := c.fields(typ, path)
+	if err != nil {
+		return nil, err
+	}
+
+	oc := newObjectCodec(ctx, fields, attrsType, attrTypes)
+
+	if !cacheable {
+		return oc, nil
+	}
+
+	c.objectCodecsMu.Lock()
+	defer c.objectCodecsMu.Unlock()
+
+	var cached []*objectCodec
+
+	if current := c.objectCodecs.Load(); current != nil {
+		cached = *current
+	}
+
+	if len(cached) < maxObjectCodecs {
+		updated := make([]*objectCodec, 0, len(cached)+1)
+		updated = append(updated, cached...)
+		updated = append(updated, oc)
+
+		c.objectCodecs.Store(&updated)
+	}
+
+	return oc, nil
+}
+
+// newObjectCodec compiles the object codec for the given struct fields and
+// object type.
+func newObjectCodec(ctx context.Context, fields map[string]structField, attrsType attr.TypeWithAttributeTypes, attrTypes map[string]attr.Type) *objectCodec {
+	oc := &objectCodec{
+		typ:    attrsType,
+		fields: make([]objectCodecField, 0, len(fields)),
+	}
+
+	for name, field := range fields {
+		f := objectCodecField{
+			structField: field,
+			attrName:    name,
+		}
+
+		if attrType, ok := attrTypes[name]; ok && attrType != nil {
+			f.attrType = attrType
+			f.tfType = attrType.TerraformType(ctx)
+		} else if !ok {
+			oc.objectMissing = append(oc.objectMissing, name)
+		}
+
+		oc.fields = append(oc.fields, f)
+	}
+
+	for name, attrType := range attrTypes {
+		if attrType == nil {
+			oc.objectMissing = append(oc.objectMissing, name)
+		}
+
+		if _, ok := fields[name]; !ok {
+			oc.structMissing = append(oc.structMissing, name)
+		}
+	}
+
+	sort.Slice(oc.fields, func(i, j int) bool {
+		return oc.fields[i].attrName < oc.fields[j].attrName
+	})
+	sort.Strings(oc.objectMissing)
+	sort.Strings(oc.structMissing)
+
+	return oc
+}
+
+// field returns the field mapped to the attribute `name`, if any.
+func (oc *objectCodec) field(name string) (objectCodecField, bool) {
+	i := sort.Search(len(oc.fields), func(i int) bool {
+		return oc.fields[i].attrName >= name
+	})
+
+	if i < len(oc.fields) && oc.fields[i].attrName == name {
+		return oc.fields[i], true
+	}
+
+	return objectCodecField{}, false
+}
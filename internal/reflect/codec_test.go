@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"math/big"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestGetCodec(t *testing.T) {
+	t.Parallel()
+
+	// testCodecFlags contains the comparable fields of a codec
+	type testCodecFlags struct {
+		ImplementsAttrValue      bool
+		ImplementsValueConverter bool
+		ImplementsUnknownable    bool
+		ImplementsNullable       bool
+		CanMarshalText           bool
+		CanUnmarshalText         bool
+	}
+
+	testCases := map[string]struct {
+		typ      reflect.Type
+		expected testCodecFlags
+	}{
+		"string": {
+			typ:      reflect.TypeOf(""),
+			expected: testCodecFlags{},
+		},
+		"attr-value": {
+			typ: attrValueType,
+			expected: testCodecFlags{
+				ImplementsAttrValue: true,
+			},
+		},
+		"nullable": {
+			typ: nullableType,
+			expected: testCodecFlags{
+				ImplementsNullable: true,
+			},
+		},
+		"duration": {
+			typ: durationType,
+			expected: testCodecFlags{
+				CanMarshalText:   true,
+				CanUnmarshalText: true,
+			},
+		},
+		"text-marshaler-pointer": {
+			typ:      reflect.TypeOf(&big.Int{}),
+			expected: testCodecFlags{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := getCodec(testCase.typ)
+
+			got := testCodecFlags{
+				ImplementsAttrValue:      c.implementsAttrValue,
+				ImplementsValueConverter: c.implementsValueConverter,
+				ImplementsUnknownable:    c.implementsUnknownable,
+				ImplementsNullable:       c.implementsNullable,
+				CanMarshalText:           c.canMarshalText,
+				CanUnmarshalText:         c.canUnmarshalText,
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if getCodec(testCase.typ) != c {
+				t.Errorf("expected cached codec to be returned")
+			}
+		})
+	}
+}
+
+func TestCodecFields(t *testing.T) {
+	t.Parallel()
+
+	type valid struct {
+		Name string `tfsdk:"name"`
+	}
+
+	type invalid struct {
+		Name string
+	}
+
+	validType := reflect.TypeOf(valid{})
+	validCodec := getCodec(validType)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			fields, err := validCodec.fields(validType, path.Empty())
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+
+			if diff := cmp.Diff(fields, map[string]structField{"name": {index: []int{0}, name: "Name"}}, cmp.AllowUnexported(structField{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// errors include the path of the conversion, so they must not be
+	// cached
+	invalidType := reflect.TypeOf(invalid{})
+	invalidCodec := getCodec(invalidType)
+
+	for _, p := range []path.Path{path.Root("first"), path.Root("second")} {
+		_, err := invalidCodec.fields(invalidType, p)
+
+		expected := p.String() + `: need a struct tag for "tfsdk" on Name`
+
+		if err == nil || err.Error() != expected {
+			t.Errorf("expected error %q, got %v", expected, err)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// converter converts between a Go type and an intermediate Go type that is
+// supported by reflection.
+type converter struct {
+	// intermediateType is the Go type that reflection converts to and from.
+	intermediateType reflect.Type
+
+	// to converts a value of the registered Go type into a value of
+	// intermediateType.
+	to func(reflect.Value) (reflect.Value, error)
+
+	// from converts a value of intermediateType into a value of the
+	// registered Go type.
+	from func(reflect.Value) (reflect.Value, error)
+}
+
+// converters contains the registered converters, keyed by the reflect.Type
+// of the registered Go type.
+var converters sync.Map
+
+// RegisterConverter registers functions to convert between the Go type T and
+// the Go type U, which must be supported by reflection, such as string or
+// types.String. Into and FromValue will then use U in place of T. Registering
+// another converter for T replaces the existing converter.
+func RegisterConverter[T, U any](to func(T) (U, error), from func(U) (T, error)) {
+	converters.Store(reflect.TypeOf((*T)(nil)).Elem(), converter{
+		intermediateType: reflect.TypeOf((*U)(nil)).Elem(),
+		to: func(val reflect.Value) (reflect.Value, error) {
+			u, err := to(val.Interface().(T)) //nolint:forcetypeassert // converters are keyed by type
+
+			return reflect.ValueOf(&u).Elem(), err
+		},
+		from: func(val reflect.Value) (reflect.Value, error) {
+			t, err := from(val.Interface().(U)) //nolint:forcetypeassert // intermediate values are always U
+
+			return reflect.ValueOf(&t).Elem(), err
+		},
+	})
+}
+
+// getConverter returns the converter registered for the Go type `typ`, if any.
+func getConverter(typ reflect.Type) (converter, bool) {
+	c, ok := converters.Load(typ)
+
+	if !ok {
+		return converter{}, false
+	}
+
+	return c.(converter), true //nolint:forcetypeassert // only converters are stored
+}
+
+// ConverterIntermediateType returns the intermediate Go type of the converter
+// registered for the Go type `typ`, if any.
+func ConverterIntermediateType(typ reflect.Type) (reflect.Type, bool) {
+	c, ok := getConverter(typ)
+
+	if !ok {
+		return nil, false
+	}
+
+	return c.intermediateType, true
+}
+
+// newConverted builds a value of the type of `target` with the registered
+// converter for that type, reflecting `val` into the intermediate type first.
+//
+// It is meant to be called through Into, not directly.
+func newConverted(ctx context.Context, c converter, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	intermediate, intermediateDiags := BuildValue(ctx, typ, val, reflect.New(c.intermediateType).Elem(), opts, path)
+
+	diags.Append(intermediateDiags...)
+
+	if diags.HasError() {
+		return target, diags
+	}
+
+	result, err := c.from(intermediate)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Unable to convert %s into %s with the registered converter: %s", c.intermediateType, target.Type(), err),
+		)
+
+		return target, diags
+	}
+
+	return result, diags
+}
+
+// fromConverted returns an attr.Value as produced by `typ` from `val`, using
+// the registered converter for the type of `val` to convert it into the
+// intermediate type first.
+//
+// It is meant to be called through FromValue, not directly.
+func fromConverted(ctx context.Context, c converter, typ attr.Type, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	intermediate, err := c.to(val)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Unable to convert %s into %s with the registered converter: %s", val.Type(), c.intermediateType, err),
+		)
+
+		return nil, diags
+	}
+
+	return FromValue(ctx, typ, intermediate.Interface(), path)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testVersion is a type which is only supported by reflection through a
+// registered converter.
+type testVersion struct {
+	Major int
+	Minor int
+}
+
+func init() {
+	refl.RegisterConverter(
+		func(v testVersion) (string, error) {
+			if v.Major < 0 || v.Minor < 0 {
+				return "", errors.New("negative version")
+			}
+
+			return fmt.Sprintf("%d.%d", v.Major, v.Minor), nil
+		},
+		func(s string) (testVersion, error) {
+			var v testVersion
+
+			_, err := fmt.Sscanf(s, "%d.%d", &v.Major, &v.Minor)
+
+			return v, err
+		},
+	)
+}
+
+func TestInto_converter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           tftypes.Value
+		target        func() any
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, "1.2"),
+			target:   func() any { return new(testVersion) },
+			expected: testVersion{Major: 1, Minor: 2},
+		},
+		"pointer": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, "1.2"),
+			target:   func() any { return new(*testVersion) },
+			expected: &testVersion{Major: 1, Minor: 2},
+		},
+		"pointer-null": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, nil),
+			target:   func() any { return new(*testVersion) },
+			expected: (*testVersion)(nil),
+		},
+		"list": {
+			typ: types.ListType{ElemType: types.StringType},
+			val: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "1.2"),
+				tftypes.NewValue(tftypes.String, "3.4"),
+			}),
+			target:   func() any { return new([]testVersion) },
+			expected: []testVersion{{Major: 1, Minor: 2}, {Major: 3, Minor: 4}},
+		},
+		"intermediate-error": {
+			typ:    types.StringType,
+			val:    tftypes.NewValue(tftypes.String, nil),
+			target: func() any { return new(testVersion) },
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: test\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+		"converter-error": {
+			typ:    types.StringType,
+			val:    tftypes.NewValue(tftypes.String, "invalid"),
+			target: func() any { return new(testVersion) },
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unable to convert string into reflect_test.testVersion with the registered converter: expected integer",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := testCase.target()
+
+			diags := refl.Into(context.Background(), testCase.typ, testCase.val, target, refl.Options{}, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			got := reflect.ValueOf(target).Elem().Interface()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromValue_converter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           any
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			typ:      types.StringType,
+			val:      testVersion{Major: 1, Minor: 2},
+			expected: types.StringValue("1.2"),
+		},
+		"pointer": {
+			typ:      types.StringType,
+			val:      &testVersion{Major: 1, Minor: 2},
+			expected: types.StringValue("1.2"),
+		},
+		"pointer-nil": {
+			typ:      types.StringType,
+			val:      (*testVersion)(nil),
+			expected: types.StringNull(),
+		},
+		"converter-error": {
+			typ: types.StringType,
+			val: testVersion{Major: -1},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unable to convert reflect_test.testVersion into string with the registered converter: negative version",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), testCase.typ, testCase.val, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
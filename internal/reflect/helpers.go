@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -45,45 +46,172 @@ func commaSeparatedString(in []string) string {
 	}
 }
 
-// getStructTags returns a map of Terraform field names to their position in
-// the tags of the struct `in`. `in` must be a struct.
-func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[string]int, error) {
-	tags := map[string]int{}
+// structField describes a struct field that maps to an object attribute.
+type structField struct {
+	// index is the index sequence of the field for FieldByIndex, which
+	// includes the indices of any embedded structs the field is promoted
+	// from.
+	index []int
+
+	// name is the Go name of the field, qualified with the names of any
+	// embedded structs the field is promoted from.
+	name string
+
+	// omitEmpty is set by the "omitempty" tag option. Null values are
+	// reflected into the zero value of the field, and the zero value of the
+	// field is reflected into a null value.
+	omitEmpty bool
+
+	// unknownAsZero is set by the "unknown-as-zero" tag option. Unknown
+	// values are reflected into the zero value of the field, and the zero
+	// value of the field is reflected into an unknown value.
+	unknownAsZero bool
+}
+
+// getStructTags returns a map of Terraform field names to the fields of the
+// struct `in` they map to. `in` must be a struct.
+//
+// Fields of anonymous embedded structs without a "tfsdk" tag are flattened
+// into the returned map, as if they were fields of `in`. Embedded unexported
+// types without any "tfsdk" tags are skipped, like other unexported fields.
+// A "tfsdk" tag may include options after the field name, separated by
+// commas, such as `tfsdk:"name,omitempty"`.
+//
+// The returned map is cached per struct type and must not be modified.
+func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[string]structField, error) {
 	typ := trueReflectValue(in).Type()
 	if typ.Kind() != reflect.Struct {
 		return nil, fmt.Errorf("%s: can't get struct tags of %s, is not a struct", path, in.Type())
 	}
+	return getCodec(typ).fields(typ, path)
+}
+
+// getObjectCodec returns the compiled mapping between the fields of the struct
+// `in` and the attributes of `typ`, following the same "tfsdk" struct tag rules
+// as getStructTags. `in` must be a struct.
+//
+// The returned codec is cached per struct type and object type and must not
+// be modified.
+func getObjectCodec(ctx context.Context, in reflect.Value, typ attr.TypeWithAttributeTypes, path path.Path) (*objectCodec, error) {
+	structType := trueReflectValue(in).Type()
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s: can't get struct tags of %s, is not a struct", path, in.Type())
+	}
+	return getCodec(structType).object(ctx, structType, typ, path)
+}
+
+// addStructFields adds the fields of the struct type `typ` to `tags`, prefixing
+// field indices with `index` and Go field names with `namePrefix`, recursing
+// into anonymous embedded structs.
+func addStructFields(tags map[string]structField, typ reflect.Type, index []int, namePrefix string, path path.Path) error {
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
+		tag, hasTag := field.Tag.Lookup(`tfsdk`)
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldName := namePrefix + field.Name
+		if field.Anonymous && !hasTag {
+			if field.PkgPath != "" && !hasStructTags(field.Type) {
+				// skip unexported embedded types without "tfsdk" struct
+				// tags, including struct pointers, like other unexported
+				// fields
+				continue
+			}
+			if field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct {
+				return fmt.Errorf("%s: can't flatten embedded struct pointer %s, embed %s instead", path, fieldName, field.Type.Elem())
+			}
+			if field.Type.Kind() == reflect.Struct && !field.Type.Implements(attrValueType) {
+				// flatten the fields of embedded structs, which may
+				// be promoted even if the struct type is unexported
+				err := addStructFields(tags, field.Type, fieldIndex, fieldName+".", path)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
 		if field.PkgPath != "" {
 			// skip unexported fields
 			continue
 		}
-		tag := field.Tag.Get(`tfsdk`)
 		if tag == "-" {
 			// skip explicitly excluded fields
 			continue
 		}
-		if tag == "" {
-			return nil, fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, field.Name)
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			return fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, fieldName)
+		}
+		path := path.AtName(name)
+		if !isValidFieldName(name) {
+			return fmt.Errorf("%s: invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter", path)
+		}
+		if other, ok := tags[name]; ok {
+			return fmt.Errorf("%s: can't use field name for both %s and %s", path, other.name, fieldName)
+		}
+		sf := structField{
+			index: fieldIndex,
+			name:  fieldName,
 		}
-		path := path.AtName(tag)
-		if !isValidFieldName(tag) {
-			return nil, fmt.Errorf("%s: invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter", path)
+		if options != "" {
+			for _, option := range strings.Split(options, ",") {
+				switch option {
+				case "omitempty":
+					sf.omitEmpty = true
+				case "unknown-as-zero":
+					sf.unknownAsZero = true
+				default:
+					return fmt.Errorf("%s: unknown struct tag option %q on %s", path, option, fieldName)
+				}
+			}
 		}
-		if other, ok := tags[tag]; ok {
-			return nil, fmt.Errorf("%s: can't use field name for both %s and %s", path, typ.Field(other).Name, field.Name)
+		if sf.omitEmpty && sf.unknownAsZero {
+			return fmt.Errorf("%s: can't use both omitempty and unknown-as-zero struct tag options on %s", path, fieldName)
 		}
-		tags[tag] = i
+		tags[name] = sf
 	}
-	return tags, nil
+	return nil
 }
 
+// hasStructTags returns true if the struct type `typ`, or a struct it embeds,
+// has a field with a "tfsdk" struct tag.
+func hasStructTags(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if _, ok := field.Tag.Lookup(`tfsdk`); ok {
+			return true
+		}
+		if field.Anonymous && hasStructTags(field.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// StructTags returns a map of Terraform field names to the index sequence,
+// for use with FieldByIndex, of the field they map to in the struct type
+// `typ`, following the same "tfsdk" struct tag rules as Into and FromValue.
+func StructTags(ctx context.Context, typ reflect.Type, path path.Path) (map[string][]int, error) {
+	fields, err := getStructTags(ctx, reflect.Zero(typ), path)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]int, len(fields))
+	for name, field := range fields {
+		result[name] = field.index
+	}
+	return result, nil
+}
+
+// validFieldNameRegexp matches valid Terraform field names.
+var validFieldNameRegexp = regexp.MustCompile("^[a-z][a-z0-9_]*$")
+
 // isValidFieldName returns true if `name` can be used as a field name in a
 // Terraform resource or data source.
 func isValidFieldName(name string) bool {
-	re := regexp.MustCompile("^[a-z][a-z0-9_]*$")
-	return re.MatchString(name)
+	return validFieldNameRegexp.MatchString(name)
 }
 
 // canBeNil returns true if `target`'s type can hold a nil value
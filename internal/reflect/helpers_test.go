@@ -4,9 +4,14 @@
 package reflect
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 func TestTrueReflectValue(t *testing.T) {
@@ -174,3 +179,35 @@ func TestCanBeNil_interface(t *testing.T) {
 		t.Errorf("Expected interfaces to be nillable, but canBeNil said they weren't")
 	}
 }
+
+func TestStructTags(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name     string `tfsdk:"name"`
+		Ignored  string `tfsdk:"-"`
+		Count    int64  `tfsdk:"count"`
+		internal string //nolint:unused
+	}
+
+	got, err := StructTags(context.Background(), reflect.TypeOf(testStruct{}), path.Empty())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string][]int{
+		"name":  {0},
+		"count": {2},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = StructTags(context.Background(), reflect.TypeOf(""), path.Empty())
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
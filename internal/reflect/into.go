@@ -25,7 +25,11 @@ import (
 // "tfsdk" tag with the name of the field in the tftypes.Value, and all fields
 // in the tftypes.Value must have a corresponding property in the struct. Into
 // will be called for each struct field. Slices will have Into called for each
-// element.
+// element. Tuples are reflected into structs, using exported fields in
+// declaration order, or into fixed-size arrays. Strings are parsed into
+// time.Time (RFC 3339), time.Duration, json.RawMessage, and
+// encoding.TextUnmarshaler implementations. Types with a converter registered
+// via RegisterConverter are reflected into using the converter.
 func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interface{}, opts Options, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -66,18 +70,24 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		)
 		return target, diags
 	}
+	// if a converter is registered for this type, build the value with
+	// that
+	if c, ok := getConverter(target.Type()); ok {
+		return newConverted(ctx, c, typ, val, target, opts, path)
+	}
+	targetCodec := getCodec(target.Type())
 	// if this is an attr.Value, build the type from that
-	if target.Type().Implements(reflect.TypeOf((*attr.Value)(nil)).Elem()) {
+	if targetCodec.implementsAttrValue {
 		return NewAttributeValue(ctx, typ, val, target, opts, path)
 	}
 	// if this tells tftypes how to build an instance of it out of a
 	// tftypes.Value, well, that's what we want, so do that instead of our
 	// default logic.
-	if target.Type().Implements(reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()) {
+	if targetCodec.implementsValueConverter {
 		return NewValueConverter(ctx, typ, val, target, opts, path)
 	}
 	// if this can explicitly be set to unknown, do that
-	if target.Type().Implements(reflect.TypeOf((*Unknownable)(nil)).Elem()) {
+	if targetCodec.implementsUnknownable {
 		res, unknownableDiags := NewUnknownable(ctx, typ, val, target, opts, path)
 		diags.Append(unknownableDiags...)
 		if diags.HasError() {
@@ -92,7 +102,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		}
 	}
 	// if this can explicitly be set to null, do that
-	if target.Type().Implements(reflect.TypeOf((*Nullable)(nil)).Elem()) {
+	if targetCodec.implementsNullable {
 		res, nullableDiags := NewNullable(ctx, typ, val, target, opts, path)
 		diags.Append(nullableDiags...)
 		if diags.HasError() {
@@ -148,6 +158,11 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 
 		return target, diags
 	}
+	// string values can be parsed into certain types, such as time.Time
+	// or encoding.TextUnmarshaler implementations
+	if targetCodec.canUnmarshalText && isStringType(ctx, typ) {
+		return Text(ctx, typ, val, target, path)
+	}
 	// *big.Float and *big.Int are technically pointers, but we want them
 	// handled as numbers
 	if target.Type() == reflect.TypeOf(big.NewFloat(0)) || target.Type() == reflect.TypeOf(big.NewInt(0)) {
@@ -155,9 +170,18 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 	}
 	switch target.Kind() {
 	case reflect.Struct:
+		if val.Type().Is(tftypes.Tuple{}) {
+			val, valDiags := Tuple(ctx, typ, val, target, opts, path)
+			diags.Append(valDiags...)
+			return val, diags
+		}
 		val, valDiags := Struct(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
 		return val, diags
+	case reflect.Array:
+		val, valDiags := Tuple(ctx, typ, val, target, opts, path)
+		diags.Append(valDiags...)
+		return val, diags
 	case reflect.Bool, reflect.String:
 		val, valDiags := Primitive(ctx, typ, val, target, path)
 		diags.Append(valDiags...)
@@ -173,6 +197,10 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		// let people use the types they want
 		val, valDiags := Number(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
+		// named number types, such as time.Duration, need converting
+		if !diags.HasError() && val.Type() != target.Type() {
+			val = val.Convert(target.Type())
+		}
 		return val, diags
 	case reflect.Slice:
 		val, valDiags := reflectSlice(ctx, typ, val, target, opts, path)
@@ -136,7 +136,10 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 
 		bf := big.NewFloat(float64(floatResult))
 
-		if result.Text('f', -1) != bf.Text('f', -1) {
+		// Values created from a float32 use its shortest decimal
+		// representation, which is not exact in binary, so also accept a
+		// value matching that representation.
+		if result.Text('f', -1) != bf.Text('f', -1) && result.Text('g', -1) != strconv.FormatFloat(float64(floatResult), 'g', -1, 32) {
 			diags.Append(roundingErrorDiag)
 
 			return target, diags
@@ -249,6 +252,32 @@ func FromFloat(ctx context.Context, typ attr.Type, val float64, path path.Path)
 	return num, diags
 }
 
+// FromFloat32 creates an attr.Value using `typ` from a float32. Types with
+// float32 values, such as Float32Type, are converted using the shortest
+// decimal representation of the float32, which matches the values those types
+// create. Other types are converted using the float32 widened to a float64.
+//
+// It is meant to be called through FromValue, not directly.
+func FromFloat32(ctx context.Context, typ attr.Type, val float32, path path.Path) (attr.Value, diag.Diagnostics) {
+	if _, ok := typ.ValueType(ctx).(float32Value); !ok {
+		return FromFloat(ctx, typ, float64(val), path)
+	}
+
+	f64, err := strconv.ParseFloat(strconv.FormatFloat(float64(val), 'g', -1, 32), 64)
+
+	if err != nil {
+		return nil, diag.Diagnostics{validateValueErrorDiag(err, path)}
+	}
+
+	return FromFloat(ctx, typ, f64, path)
+}
+
+// float32Value is implemented by values of types with float32 values, such
+// as Float32Value, including custom values embedding them.
+type float32Value interface {
+	ValueFloat32() float32
+}
+
 // FromBigFloat creates an attr.Value using `typ` from a *big.Float.
 //
 // It is meant to be called through FromValue, not directly.
@@ -576,6 +576,17 @@ func TestNumber_uint64UnderflowError(t *testing.T) {
 
 func TestNumber_float32(t *testing.T) {
 	t.Parallel()
+
+	var n float32
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 0.1), reflect.ValueOf(n), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != 0.1 {
+		t.Errorf("Expected %v, got %v", 0.1, n)
+	}
 }
 
 func TestNumber_float32OverflowError(t *testing.T) {
@@ -880,6 +891,71 @@ func TestFromFloat(t *testing.T) {
 	}
 }
 
+func TestFromFloat32(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		val           float32
+		typ           attr.Type
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"0": {
+			val:      0,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(0)),
+		},
+		"0.1": {
+			val:      0.1,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(float64(float32(0.1)))),
+		},
+		"1.234": {
+			val:      1.234,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(float64(float32(1.234)))),
+		},
+		"Float64Type": {
+			val:      0.1,
+			typ:      types.Float64Type,
+			expected: types.Float64Value(float64(float32(0.1))),
+		},
+		"Float32Type": {
+			val:      1.5,
+			typ:      types.Float32Type,
+			expected: types.Float32Value(1.5),
+		},
+		"Float32Type-0.1": {
+			val:      0.1,
+			typ:      types.Float32Type,
+			expected: types.Float32Value(0.1),
+		},
+		"WithValidateError": {
+			val: 1,
+			typ: testtypes.NumberTypeWithValidateError{},
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestErrorDiagnostic(path.Empty()),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			actualVal, diags := refl.FromFloat32(context.Background(), tc.typ, tc.val, path.Empty())
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if !diags.HasError() && !tc.expected.Equal(actualVal) {
+				t.Fatalf("fail: got %+v, wanted %+v", actualVal, tc.expected)
+			}
+		})
+	}
+}
+
 func TestFromBigFloat(t *testing.T) {
 	t.Parallel()
 
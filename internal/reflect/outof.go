@@ -34,16 +34,27 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 	if v, ok := val.(Nullable); ok {
 		return FromNullable(ctx, typ, v, path)
 	}
+	value := reflect.ValueOf(val)
+	if value.IsValid() {
+		if c, ok := getConverter(value.Type()); ok {
+			return fromConverted(ctx, c, typ, value, path)
+		}
+		if getCodec(value.Type()).canMarshalText && isStringType(ctx, typ) {
+			return FromText(ctx, typ, value, path)
+		}
+	}
 	if bf, ok := val.(*big.Float); ok {
 		return FromBigFloat(ctx, typ, bf, path)
 	}
 	if bi, ok := val.(*big.Int); ok {
 		return FromBigInt(ctx, typ, bi, path)
 	}
-	value := reflect.ValueOf(val)
 	kind := value.Kind()
 	switch kind {
 	case reflect.Struct:
+		if t, ok := typ.(attr.TypeWithElementTypes); ok {
+			return FromTuple(ctx, t, value, path)
+		}
 		t, ok := typ.(attr.TypeWithAttributeTypes)
 		if !ok {
 			err := fmt.Errorf("cannot use type %T as schema type %T; %T must be an attr.TypeWithAttributeTypes to hold %T", val, typ, typ, val)
@@ -61,7 +72,9 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
 		reflect.Uint64:
 		return FromUint(ctx, typ, value.Uint(), path)
-	case reflect.Float32, reflect.Float64:
+	case reflect.Float32:
+		return FromFloat32(ctx, typ, float32(value.Float()), path)
+	case reflect.Float64:
 		return FromFloat(ctx, typ, value.Float(), path)
 	case reflect.Bool:
 		return FromBool(ctx, typ, value.Bool(), path)
@@ -69,6 +82,18 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 		return FromString(ctx, typ, value.String(), path)
 	case reflect.Slice:
 		return FromSlice(ctx, typ, value, path)
+	case reflect.Array:
+		t, ok := typ.(attr.TypeWithElementTypes)
+		if !ok {
+			err := fmt.Errorf("cannot use type %T as schema type %T; %T must be an attr.TypeWithElementTypes to hold %T", val, typ, typ, val)
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
+		return FromTuple(ctx, t, value, path)
 	case reflect.Map:
 		t, ok := typ.(attr.TypeWithElementType)
 		if !ok {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -28,6 +29,12 @@ import (
 // explicitly defining them as not part of the object. This is to catch typos
 // and other mistakes early.
 //
+// The properties of anonymous embedded structs without a "tfsdk" tag are
+// treated as properties of `target`. The "omitempty" tag option, such as
+// `tfsdk:"name,omitempty"`, leaves the zero value of the property in place for
+// null values, and the "unknown-as-zero" tag option does the same for unknown
+// values.
+//
 // Struct is meant to be called from Into, not directly.
 func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -72,9 +79,9 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 		return target, diags
 	}
 
-	// collect a map of fields that are defined in the tags of the struct
-	// passed in
-	targetFields, err := getStructTags(ctx, target, path)
+	// collect the fields that are defined in the tags of the struct passed
+	// in, along with the attribute types they map to
+	targetCodec, err := getObjectCodec(ctx, target, attrsType, path)
 	if err != nil {
 		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
 			Val:        object,
@@ -88,15 +95,18 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 	// leading to surprises, so let's ensure they have the exact same
 	// fields defined
 	var objectMissing, targetMissing []string
-	for field := range targetFields {
-		if _, ok := objectFields[field]; !ok {
-			objectMissing = append(objectMissing, field)
+	for _, targetField := range targetCodec.fields {
+		if _, ok := objectFields[targetField.attrName]; !ok {
+			objectMissing = append(objectMissing, targetField.attrName)
 		}
 	}
-	for field := range objectFields {
-		if _, ok := targetFields[field]; !ok {
-			targetMissing = append(targetMissing, field)
+	if len(objectMissing) > 0 || len(objectFields) != len(targetCodec.fields) {
+		for field := range objectFields {
+			if _, ok := targetCodec.field(field); !ok {
+				targetMissing = append(targetMissing, field)
+			}
 		}
+		sort.Strings(targetMissing)
 	}
 	if len(objectMissing) > 0 || len(targetMissing) > 0 {
 		var missing []string
@@ -114,14 +124,11 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 		return target, diags
 	}
 
-	attrTypes := attrsType.AttributeTypes()
-
 	// now that we know they match perfectly, fill the struct with the
 	// values in the object
 	result := reflect.New(target.Type()).Elem()
-	for field, structFieldPos := range targetFields {
-		attrType, ok := attrTypes[field]
-		if !ok {
+	for _, targetField := range targetCodec.fields {
+		if targetField.attrType == nil {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
 				Val:        object,
 				TargetType: target.Type(),
@@ -129,8 +136,13 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 			}))
 			return target, diags
 		}
-		structField := result.Field(structFieldPos)
-		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[field], structField, opts, path.AtName(field))
+		objectField := objectFields[targetField.attrName]
+		// leave the zero value in place for values handled by tag options
+		if (targetField.omitEmpty && objectField.IsNull()) || (targetField.unknownAsZero && !objectField.IsKnown()) {
+			continue
+		}
+		structField := result.FieldByIndex(targetField.index)
+		fieldVal, fieldValDiags := BuildValue(ctx, targetField.attrType, objectField, structField, opts, path.AtName(targetField.attrName))
 		diags.Append(fieldValDiags...)
 
 		if diags.HasError() {
@@ -145,7 +157,9 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 // `val` must be a struct type, and must have all its properties tagged and be
 // a 1:1 match with the attributes reported by `typ`. FromStruct will recurse
 // into FromValue for each attribute, using the type of the attribute as
-// reported by `typ`.
+// reported by `typ`. Properties with the "omitempty" tag option are null when
+// set to their zero value, and properties with the "unknown-as-zero" tag
+// option are unknown when set to their zero value.
 //
 // It is meant to be called through FromValue, not directly.
 func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
@@ -153,9 +167,9 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 	objTypes := map[string]tftypes.Type{}
 	objValues := map[string]tftypes.Value{}
 
-	// collect a map of fields that are defined in the tags of the struct
-	// passed in
-	targetFields, err := getStructTags(ctx, val, path)
+	// collect the fields that are defined in the tags of the struct passed
+	// in, along with the attribute types they map to
+	valCodec, err := getObjectCodec(ctx, val, typ, path)
 	if err != nil {
 		err = fmt.Errorf("error retrieving field names from struct tags: %w", err)
 		diags.AddAttributeError(
@@ -166,34 +180,18 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		return nil, diags
 	}
 
-	attrTypes := typ.AttributeTypes()
-
-	var objectMissing, structMissing []string
-
-	for field := range targetFields {
-		if _, ok := attrTypes[field]; !ok {
-			objectMissing = append(objectMissing, field)
-		}
-	}
-
-	for attrName, attrType := range attrTypes {
-		if attrType == nil {
-			objectMissing = append(objectMissing, attrName)
-		}
+	if len(valCodec.objectMissing) > 0 || len(valCodec.structMissing) > 0 {
+		missing := make([]string, 0, 2)
 
-		if _, ok := targetFields[attrName]; !ok {
-			structMissing = append(structMissing, attrName)
-		}
-	}
-
-	if len(objectMissing) > 0 || len(structMissing) > 0 {
-		missing := make([]string, 0, len(objectMissing)+len(structMissing))
-
-		if len(objectMissing) > 0 {
+		// commaSeparatedString modifies its argument, so the cached
+		// names are copied
+		if len(valCodec.objectMissing) > 0 {
+			objectMissing := append([]string{}, valCodec.objectMissing...)
 			missing = append(missing, fmt.Sprintf("Struct defines fields not found in object: %s.", commaSeparatedString(objectMissing)))
 		}
 
-		if len(structMissing) > 0 {
+		if len(valCodec.structMissing) > 0 {
+			structMissing := append([]string{}, valCodec.structMissing...)
 			missing = append(missing, fmt.Sprintf("Object defines fields not found in struct: %s.", commaSeparatedString(structMissing)))
 		}
 
@@ -210,20 +208,29 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 		return nil, diags
 	}
 
-	for name, fieldNo := range targetFields {
-		path := path.AtName(name)
-		fieldValue := val.Field(fieldNo)
+	for _, valField := range valCodec.fields {
+		path := path.AtName(valField.attrName)
+		fieldValue := val.FieldByIndex(valField.index)
 
-		attrVal, attrValDiags := FromValue(ctx, attrTypes[name], fieldValue.Interface(), path)
-		diags.Append(attrValDiags...)
+		var tfObjVal tftypes.Value
 
-		if diags.HasError() {
-			return nil, diags
-		}
+		switch {
+		case valField.omitEmpty && fieldValue.IsZero():
+			tfObjVal = tftypes.NewValue(valField.tfType, nil)
+		case valField.unknownAsZero && fieldValue.IsZero():
+			tfObjVal = tftypes.NewValue(valField.tfType, tftypes.UnknownValue)
+		default:
+			attrVal, attrValDiags := FromValue(ctx, valField.attrType, fieldValue.Interface(), path)
+			diags.Append(attrValDiags...)
 
-		tfObjVal, err := attrVal.ToTerraformValue(ctx)
-		if err != nil {
-			return nil, append(diags, toTerraformValueErrorDiag(err, path))
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			tfObjVal, err = attrVal.ToTerraformValue(ctx)
+			if err != nil {
+				return nil, append(diags, toTerraformValueErrorDiag(err, path))
+			}
 		}
 
 		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
@@ -234,8 +241,8 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 			}
 		}
 
-		objValues[name] = tfObjVal
-		objTypes[name] = tfObjVal.Type()
+		objValues[valField.attrName] = tfObjVal
+		objTypes[valField.attrName] = tfObjVal.Type()
 	}
 
 	tfVal := tftypes.NewValue(tftypes.Object{
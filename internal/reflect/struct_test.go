@@ -157,6 +157,87 @@ func TestNewStruct_errors(t *testing.T) {
 				"error retrieving field names from struct tags: %w",
 				errors.New("a: can't use field name for both A and B")),
 		},
+		"struct-has-duplicate-embedded-tags": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id": types.StringType,
+				},
+			},
+			objVal: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			targetVal: reflect.ValueOf(struct {
+				ID string `tfsdk:"id"`
+				testEmbeddedModel
+			}{}),
+			expectedError: fmt.Errorf(
+				"error retrieving field names from struct tags: %w",
+				errors.New("id: can't use field name for both ID and testEmbeddedModel.ID")),
+		},
+		"struct-has-embedded-pointer": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id": types.StringType,
+				},
+			},
+			objVal: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			targetVal: reflect.ValueOf(struct {
+				*TestEmbeddedExportedModel
+			}{}),
+			expectedError: fmt.Errorf(
+				"error retrieving field names from struct tags: %w",
+				errors.New(": can't flatten embedded struct pointer TestEmbeddedExportedModel, embed reflect_test.TestEmbeddedExportedModel instead")),
+		},
+		"struct-has-unknown-tag-option": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": types.StringType,
+				},
+			},
+			objVal: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			targetVal: reflect.ValueOf(struct {
+				A string `tfsdk:"a,invalid"`
+			}{}),
+			expectedError: fmt.Errorf(
+				"error retrieving field names from struct tags: %w",
+				errors.New(`a: unknown struct tag option "invalid" on A`)),
+		},
+		"struct-has-conflicting-tag-options": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": types.StringType,
+				},
+			},
+			objVal: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			targetVal: reflect.ValueOf(struct {
+				A string `tfsdk:"a,omitempty,unknown-as-zero"`
+			}{}),
+			expectedError: fmt.Errorf(
+				"error retrieving field names from struct tags: %w",
+				errors.New("a: can't use both omitempty and unknown-as-zero struct tag options on A")),
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -225,6 +306,192 @@ func TestNewStruct_primitives(t *testing.T) {
 	}
 }
 
+type testEmbeddedModel struct {
+	ID   types.String `tfsdk:"id"`
+	Tags []string     `tfsdk:"tags"`
+}
+
+type testEmbeddedUnexportedModel struct {
+	Name string `tfsdk:"name"`
+}
+
+// TestEmbeddedExportedModel is exported, unlike the other embedded models, as
+// embedded pointers to unexported types are skipped.
+type TestEmbeddedExportedModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+type testEmbeddedUntaggedModel struct {
+	Foo string
+}
+
+func TestNewStruct_embeddedUnexportedUntagged(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		target any
+	}{
+		"struct": {
+			target: struct {
+				testEmbeddedUntaggedModel
+				Name types.String `tfsdk:"name"`
+			}{},
+		},
+		"struct-pointer": {
+			target: struct {
+				*testEmbeddedModel
+				Name types.String `tfsdk:"name"`
+			}{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := refl.Struct(context.Background(), types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+				},
+			}, tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			}), reflect.ValueOf(testCase.target), refl.Options{}, path.Empty())
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+		})
+	}
+}
+
+func TestNewStruct_embedded(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		testEmbeddedModel
+		testEmbeddedUnexportedModel
+		Excluded testEmbeddedModel `tfsdk:"-"`
+		Value    string            `tfsdk:"value"`
+	}
+
+	var s testStruct
+	result, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":    types.StringType,
+			"name":  types.StringType,
+			"tags":  types.ListType{ElemType: types.StringType},
+			"value": types.StringType,
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":    tftypes.String,
+			"name":  tftypes.String,
+			"tags":  tftypes.List{ElementType: tftypes.String},
+			"value": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc"),
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "one"),
+		}),
+		"value": tftypes.NewValue(tftypes.String, "hello"),
+	}), reflect.ValueOf(s), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&s).Elem().Set(result)
+
+	expected := testStruct{
+		testEmbeddedModel: testEmbeddedModel{
+			ID:   types.StringValue("abc"),
+			Tags: []string{"one"},
+		},
+		testEmbeddedUnexportedModel: testEmbeddedUnexportedModel{
+			Name: "test",
+		},
+		Value: "hello",
+	}
+
+	if diff := cmp.Diff(s, expected, cmp.AllowUnexported(testStruct{})); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewStruct_tagOptions(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		OmitEmpty     string       `tfsdk:"omit_empty,omitempty"`
+		UnknownAsZero int64        `tfsdk:"unknown_as_zero,unknown-as-zero"`
+		Both          types.String `tfsdk:"both,omitempty"`
+		Known         string       `tfsdk:"known,unknown-as-zero"`
+	}
+
+	var s testStruct
+	result, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"omit_empty":      types.StringType,
+			"unknown_as_zero": types.Int64Type,
+			"both":            types.StringType,
+			"known":           types.StringType,
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"omit_empty":      tftypes.String,
+			"unknown_as_zero": tftypes.Number,
+			"both":            tftypes.String,
+			"known":           tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"omit_empty":      tftypes.NewValue(tftypes.String, nil),
+		"unknown_as_zero": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"both":            tftypes.NewValue(tftypes.String, nil),
+		"known":           tftypes.NewValue(tftypes.String, "hello"),
+	}), reflect.ValueOf(s), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&s).Elem().Set(result)
+
+	expected := testStruct{
+		Both:  types.StringNull(),
+		Known: "hello",
+	}
+
+	if diff := cmp.Diff(s, expected); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewStruct_tagOptions_unhandled(t *testing.T) {
+	t.Parallel()
+
+	// omitempty does not handle unknown values
+	var s struct {
+		OmitEmpty string `tfsdk:"omit_empty,omitempty"`
+	}
+	_, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"omit_empty": types.StringType,
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"omit_empty": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"omit_empty": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}), reflect.ValueOf(s), refl.Options{}, path.Empty())
+	if !diags.HasError() {
+		t.Fatal("Expected error, got none")
+	}
+}
+
 func TestNewStruct_complex(t *testing.T) {
 	t.Parallel()
 
@@ -607,6 +874,158 @@ func TestFromStruct_primitives(t *testing.T) {
 	}
 }
 
+func TestFromStruct_embedded(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		testEmbeddedModel
+		testEmbeddedUnexportedModel
+		Value string `tfsdk:"value"`
+	}
+
+	attrTypes := map[string]attr.Type{
+		"id":    types.StringType,
+		"name":  types.StringType,
+		"tags":  types.ListType{ElemType: types.StringType},
+		"value": types.StringType,
+	}
+
+	actualVal, diags := refl.FromStruct(context.Background(), types.ObjectType{
+		AttrTypes: attrTypes,
+	}, reflect.ValueOf(testStruct{
+		testEmbeddedModel: testEmbeddedModel{
+			ID:   types.StringValue("abc"),
+			Tags: []string{"one"},
+		},
+		testEmbeddedUnexportedModel: testEmbeddedUnexportedModel{
+			Name: "test",
+		},
+		Value: "hello",
+	}), path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expectedVal := types.ObjectValueMust(
+		attrTypes,
+		map[string]attr.Value{
+			"id":    types.StringValue("abc"),
+			"name":  types.StringValue("test"),
+			"tags":  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			"value": types.StringValue("hello"),
+		},
+	)
+
+	if diff := cmp.Diff(expectedVal, actualVal); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFromStruct_embeddedUnexportedUntagged(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val any
+	}{
+		"struct": {
+			val: struct {
+				testEmbeddedUntaggedModel
+				Name types.String `tfsdk:"name"`
+			}{
+				testEmbeddedUntaggedModel: testEmbeddedUntaggedModel{
+					Foo: "ignored",
+				},
+				Name: types.StringValue("test"),
+			},
+		},
+		"struct-pointer": {
+			val: struct {
+				*testEmbeddedModel
+				Name types.String `tfsdk:"name"`
+			}{
+				testEmbeddedModel: &testEmbeddedModel{
+					ID: types.StringValue("ignored"),
+				},
+				Name: types.StringValue("test"),
+			},
+		},
+	}
+
+	attrTypes := map[string]attr.Type{
+		"name": types.StringType,
+	}
+	expectedVal := types.ObjectValueMust(
+		attrTypes,
+		map[string]attr.Value{
+			"name": types.StringValue("test"),
+		},
+	)
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actualVal, diags := refl.FromStruct(context.Background(), types.ObjectType{
+				AttrTypes: attrTypes,
+			}, reflect.ValueOf(testCase.val), path.Empty())
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(expectedVal, actualVal); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromStruct_tagOptions(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		OmitEmpty        string   `tfsdk:"omit_empty,omitempty"`
+		OmitEmptySet     string   `tfsdk:"omit_empty_set,omitempty"`
+		OmitEmptyList    []string `tfsdk:"omit_empty_list,omitempty"`
+		UnknownAsZero    int64    `tfsdk:"unknown_as_zero,unknown-as-zero"`
+		UnknownAsZeroSet int64    `tfsdk:"unknown_as_zero_set,unknown-as-zero"`
+	}
+
+	attrTypes := map[string]attr.Type{
+		"omit_empty":          types.StringType,
+		"omit_empty_set":      types.StringType,
+		"omit_empty_list":     types.ListType{ElemType: types.StringType},
+		"unknown_as_zero":     types.Int64Type,
+		"unknown_as_zero_set": types.Int64Type,
+	}
+
+	actualVal, diags := refl.FromStruct(context.Background(), types.ObjectType{
+		AttrTypes: attrTypes,
+	}, reflect.ValueOf(testStruct{
+		OmitEmptySet:     "hello",
+		UnknownAsZeroSet: 123,
+	}), path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expectedVal := types.ObjectValueMust(
+		attrTypes,
+		map[string]attr.Value{
+			"omit_empty":          types.StringNull(),
+			"omit_empty_set":      types.StringValue("hello"),
+			"omit_empty_list":     types.ListNull(types.StringType),
+			"unknown_as_zero":     types.Int64Unknown(),
+			"unknown_as_zero_set": types.Int64Value(123),
+		},
+	)
+
+	if diff := cmp.Diff(expectedVal, actualVal); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func TestFromStruct_complex(t *testing.T) {
 	t.Parallel()
 
@@ -1227,3 +1646,213 @@ func TestFromStruct_structtags_ignores(t *testing.T) {
 		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
 	}
 }
+
+func TestFromStruct_objectTypes(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name  string `tfsdk:"name"`
+		Count int64  `tfsdk:"count,omitempty"`
+	}
+
+	// the same struct type is compiled separately for each object type
+	testCases := map[string]struct {
+		typ           types.ObjectType
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"int64": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name":  types.StringType,
+					"count": types.Int64Type,
+				},
+			},
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name":  types.StringType,
+					"count": types.Int64Type,
+				},
+				map[string]attr.Value{
+					"name":  types.StringValue("test"),
+					"count": types.Int64Null(),
+				},
+			),
+		},
+		"number": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name":  types.StringType,
+					"count": types.NumberType,
+				},
+			},
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name":  types.StringType,
+					"count": types.NumberType,
+				},
+				map[string]attr.Value{
+					"name":  types.StringValue("test"),
+					"count": types.NumberNull(),
+				},
+			),
+		},
+		"mismatch": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name":  types.StringType,
+					"other": types.BoolType,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from struct into an object. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Mismatch between struct and object type: Struct defines fields not found in object: count. Object defines fields not found in struct: other.\n"+
+						"Struct: reflect_test.testStruct\n"+
+						"Object type: types.ObjectType[\"name\":basetypes.StringType, \"other\":basetypes.BoolType]",
+				),
+			},
+		},
+	}
+
+	for _, name := range []string{"int64", "number", "mismatch", "int64", "number"} {
+		testCase := testCases[name]
+
+		got, diags := refl.FromStruct(context.Background(), testCase.typ, reflect.ValueOf(testStruct{Name: "test"}), path.Empty())
+
+		if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+			t.Errorf("%s: unexpected diagnostics difference: %s", name, diff)
+		}
+
+		if diff := cmp.Diff(got, testCase.expected); diff != "" {
+			t.Errorf("%s: unexpected difference: %s", name, diff)
+		}
+	}
+}
+
+type benchmarkStructItem struct {
+	Name    types.String `tfsdk:"name"`
+	Count   int64        `tfsdk:"count"`
+	Enabled bool         `tfsdk:"enabled"`
+	Tags    []string     `tfsdk:"tags"`
+	Nested  struct {
+		Description *string      `tfsdk:"description"`
+		Value       types.String `tfsdk:"value"`
+	} `tfsdk:"nested"`
+}
+
+type benchmarkStruct struct {
+	ID    string                `tfsdk:"id"`
+	Items []benchmarkStructItem `tfsdk:"items"`
+}
+
+var benchmarkStructType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id": types.StringType,
+		"items": types.ListType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name":    types.StringType,
+					"count":   types.Int64Type,
+					"enabled": types.BoolType,
+					"tags":    types.ListType{ElemType: types.StringType},
+					"nested": types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"description": types.StringType,
+							"value":       types.StringType,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func benchmarkStructValue(items int) benchmarkStruct {
+	description := "description"
+	result := benchmarkStruct{
+		ID:    "id",
+		Items: make([]benchmarkStructItem, items),
+	}
+
+	for i := range result.Items {
+		result.Items[i].Name = types.StringValue(fmt.Sprintf("item%d", i))
+		result.Items[i].Count = int64(i)
+		result.Items[i].Enabled = i%2 == 0
+		result.Items[i].Tags = []string{"one", "two"}
+		result.Items[i].Nested.Description = &description
+		result.Items[i].Nested.Value = types.StringValue("value")
+	}
+
+	return result
+}
+
+func BenchmarkFromStruct100(b *testing.B) {
+	benchmarkFromStruct(b, 100)
+}
+
+func BenchmarkFromStruct1000(b *testing.B) {
+	benchmarkFromStruct(b, 1000)
+}
+
+func BenchmarkFromStruct10000(b *testing.B) {
+	benchmarkFromStruct(b, 10000)
+}
+
+func benchmarkFromStruct(b *testing.B, items int) {
+	ctx := context.Background()
+	val := reflect.ValueOf(benchmarkStructValue(items))
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, diags := refl.FromStruct(ctx, benchmarkStructType, val, path.Empty())
+
+		if diags.HasError() {
+			b.Fatalf("unexpected FromStruct diagnostics: %v", diags)
+		}
+	}
+}
+
+func BenchmarkNewStruct100(b *testing.B) {
+	benchmarkNewStruct(b, 100)
+}
+
+func BenchmarkNewStruct1000(b *testing.B) {
+	benchmarkNewStruct(b, 1000)
+}
+
+func BenchmarkNewStruct10000(b *testing.B) {
+	benchmarkNewStruct(b, 10000)
+}
+
+func benchmarkNewStruct(b *testing.B, items int) {
+	ctx := context.Background()
+
+	value, diags := refl.FromStruct(ctx, benchmarkStructType, reflect.ValueOf(benchmarkStructValue(items)), path.Empty())
+
+	if diags.HasError() {
+		b.Fatalf("unexpected FromStruct diagnostics: %v", diags)
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		b.Fatalf("unexpected ToTerraformValue error: %s", err)
+	}
+
+	target := reflect.ValueOf(benchmarkStruct{})
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, diags := refl.Struct(ctx, benchmarkStructType, tfValue, target, refl.Options{}, path.Empty())
+
+		if diags.HasError() {
+			b.Fatalf("unexpected Struct diagnostics: %v", diags)
+		}
+	}
+}
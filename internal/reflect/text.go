@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	jsonRawMessageType  = reflect.TypeOf(json.RawMessage{})
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	timeType            = reflect.TypeOf(time.Time{})
+)
+
+// isStringType returns true if `typ` is represented by a Terraform string.
+func isStringType(ctx context.Context, typ attr.Type) bool {
+	return typ.TerraformType(ctx).Is(tftypes.String)
+}
+
+// canUnmarshalText returns true if values of the Go type `typ` can be parsed
+// from the string data of string attributes, such as time.Time,
+// time.Duration, json.RawMessage, and encoding.TextUnmarshaler
+// implementations.
+func canUnmarshalText(typ reflect.Type) bool {
+	switch typ {
+	case timeType, durationType, jsonRawMessageType:
+		return true
+	}
+
+	return typ.Kind() != reflect.Ptr && reflect.PointerTo(typ).Implements(textUnmarshalerType)
+}
+
+// canMarshalText returns true if values of the Go type `typ` can be converted
+// into the string data of string attributes, such as time.Time,
+// time.Duration, json.RawMessage, and encoding.TextMarshaler
+// implementations.
+func canMarshalText(typ reflect.Type) bool {
+	switch typ {
+	case timeType, durationType, jsonRawMessageType:
+		return true
+	}
+
+	return typ.Kind() != reflect.Ptr && typ.Implements(textMarshalerType)
+}
+
+// IsTextType returns true if values of the Go type `typ` are represented by
+// the string data of string attributes, such as time.Time, time.Duration,
+// json.RawMessage, and encoding.TextMarshaler or encoding.TextUnmarshaler
+// implementations.
+func IsTextType(typ reflect.Type) bool {
+	return canMarshalText(typ) || canUnmarshalText(typ)
+}
+
+// Text builds a time.Time, time.Duration, json.RawMessage, or
+// encoding.TextUnmarshaler implementation, depending on the type of `target`,
+// by parsing the string data in `val`. Parsing errors are returned as
+// diagnostics with the given path.
+//
+// It is meant to be called through Into, not directly.
+func Text(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	err := val.As(&s)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	result := reflect.New(target.Type())
+
+	switch target.Type() {
+	case timeType:
+		var t time.Time
+		t, err = time.Parse(time.RFC3339, s)
+		result.Elem().Set(reflect.ValueOf(t))
+	case durationType:
+		var d time.Duration
+		d, err = time.ParseDuration(s)
+		result.Elem().Set(reflect.ValueOf(d))
+	case jsonRawMessageType:
+		if !json.Valid([]byte(s)) {
+			err = errors.New("invalid JSON")
+		}
+		result.Elem().Set(reflect.ValueOf(json.RawMessage(s)))
+	default:
+		unmarshaler, ok := result.Interface().(encoding.TextUnmarshaler)
+		if !ok {
+			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+				Val:        val,
+				TargetType: target.Type(),
+				Err:        fmt.Errorf("%s does not implement encoding.TextUnmarshaler", result.Type()),
+			}))
+			return target, diags
+		}
+		err = unmarshaler.UnmarshalText([]byte(s))
+	}
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to parse a string value. "+
+				"The value may need validation, such as a schema validator. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Path: %s\nTarget Type: %s\nValue: %q\nError: %s", path, target.Type(), s, err),
+		)
+		return target, diags
+	}
+
+	return result.Elem(), diags
+}
+
+// FromText returns an attr.Value as produced by `typ` from the text
+// representation of `val`, which must be a time.Time, time.Duration,
+// json.RawMessage, or encoding.TextMarshaler implementation.
+//
+// It is meant to be called through FromValue, not directly.
+func FromText(ctx context.Context, typ attr.Type, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	switch v := val.Interface().(type) {
+	case time.Time:
+		s = v.Format(time.RFC3339Nano)
+	case time.Duration:
+		s = v.String()
+	case json.RawMessage:
+		s = string(v)
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Unable to marshal %s as text: %s", val.Type(), err),
+			)
+			return nil, diags
+		}
+		s = string(text)
+	default:
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("cannot convert %s to text", val.Type()),
+		)
+		return nil, diags
+	}
+
+	return FromString(ctx, typ, s, path)
+}
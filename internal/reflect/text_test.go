@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testColor is an enumeration which implements encoding.TextMarshaler and
+// encoding.TextUnmarshaler.
+type testColor int
+
+const (
+	testColorUnknown testColor = iota
+	testColorRed
+	testColorBlue
+)
+
+func (c testColor) MarshalText() ([]byte, error) {
+	switch c {
+	case testColorRed:
+		return []byte("red"), nil
+	case testColorBlue:
+		return []byte("blue"), nil
+	default:
+		return nil, errors.New("unknown color")
+	}
+}
+
+func (c *testColor) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "red":
+		*c = testColorRed
+	case "blue":
+		*c = testColorBlue
+	default:
+		return fmt.Errorf("unknown color %q", text)
+	}
+
+	return nil
+}
+
+func TestInto_text(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           tftypes.Value
+		target        func() any
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"time": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, "2023-01-02T03:04:05.5+01:00"),
+			target:   func() any { return new(time.Time) },
+			expected: time.Date(2023, 1, 2, 3, 4, 5, 500000000, time.FixedZone("", 3600)),
+		},
+		"time-pointer-null": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, nil),
+			target:   func() any { return new(*time.Time) },
+			expected: (*time.Time)(nil),
+		},
+		"time-invalid": {
+			typ:    types.StringType,
+			val:    tftypes.NewValue(tftypes.String, "2023-01-02"),
+			target: func() any { return new(time.Time) },
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to parse a string value. "+
+						"The value may need validation, such as a schema validator. Please report the following to the provider developer:\n\n"+
+						"Path: test\n"+
+						"Target Type: time.Time\n"+
+						"Value: \"2023-01-02\"\n"+
+						`Error: parsing time "2023-01-02" as "2006-01-02T15:04:05Z07:00": cannot parse "" as "T"`,
+				),
+			},
+		},
+		"duration": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, "1h30m"),
+			target:   func() any { return new(time.Duration) },
+			expected: 90 * time.Minute,
+		},
+		"duration-number": {
+			typ:      types.Int64Type,
+			val:      tftypes.NewValue(tftypes.Number, 123),
+			target:   func() any { return new(time.Duration) },
+			expected: time.Duration(123),
+		},
+		"duration-invalid": {
+			typ:    types.StringType,
+			val:    tftypes.NewValue(tftypes.String, "soon"),
+			target: func() any { return new(time.Duration) },
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to parse a string value. "+
+						"The value may need validation, such as a schema validator. Please report the following to the provider developer:\n\n"+
+						"Path: test\n"+
+						"Target Type: time.Duration\n"+
+						"Value: \"soon\"\n"+
+						`Error: time: invalid duration "soon"`,
+				),
+			},
+		},
+		"json-raw-message": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, `{"a":1}`),
+			target:   func() any { return new(json.RawMessage) },
+			expected: json.RawMessage(`{"a":1}`),
+		},
+		"json-raw-message-invalid": {
+			typ:    types.StringType,
+			val:    tftypes.NewValue(tftypes.String, `{"a":`),
+			target: func() any { return new(json.RawMessage) },
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to parse a string value. "+
+						"The value may need validation, such as a schema validator. Please report the following to the provider developer:\n\n"+
+						"Path: test\n"+
+						fmt.Sprintf("Target Type: %s\n", reflect.TypeOf(json.RawMessage{}))+
+						"Value: \"{\\\"a\\\":\"\n"+
+						"Error: invalid JSON",
+				),
+			},
+		},
+		"text-unmarshaler": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, "blue"),
+			target:   func() any { return new(testColor) },
+			expected: testColorBlue,
+		},
+		"text-unmarshaler-netip": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, "192.0.2.1"),
+			target:   func() any { return new(netip.Addr) },
+			expected: netip.MustParseAddr("192.0.2.1"),
+		},
+		"text-unmarshaler-slice": {
+			typ: types.ListType{ElemType: types.StringType},
+			val: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "red"),
+				tftypes.NewValue(tftypes.String, "blue"),
+			}),
+			target:   func() any { return new([]testColor) },
+			expected: []testColor{testColorRed, testColorBlue},
+		},
+		"text-unmarshaler-invalid": {
+			typ:    types.StringType,
+			val:    tftypes.NewValue(tftypes.String, "green"),
+			target: func() any { return new(testColor) },
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to parse a string value. "+
+						"The value may need validation, such as a schema validator. Please report the following to the provider developer:\n\n"+
+						"Path: test\n"+
+						"Target Type: reflect_test.testColor\n"+
+						"Value: \"green\"\n"+
+						`Error: unknown color "green"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := testCase.target()
+
+			diags := refl.Into(context.Background(), testCase.typ, testCase.val, target, refl.Options{}, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			got := reflect.ValueOf(target).Elem().Interface()
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.EquateComparable(netip.Addr{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromValue_text(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           any
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"time": {
+			typ:      types.StringType,
+			val:      time.Date(2023, 1, 2, 3, 4, 5, 500000000, time.UTC),
+			expected: types.StringValue("2023-01-02T03:04:05.5Z"),
+		},
+		"time-pointer": {
+			typ: types.StringType,
+			val: func() *time.Time {
+				t := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+				return &t
+			}(),
+			expected: types.StringValue("2023-01-02T03:04:05Z"),
+		},
+		"time-pointer-nil": {
+			typ:      types.StringType,
+			val:      (*time.Time)(nil),
+			expected: types.StringNull(),
+		},
+		"duration": {
+			typ:      types.StringType,
+			val:      90 * time.Minute,
+			expected: types.StringValue("1h30m0s"),
+		},
+		"duration-number": {
+			typ:      types.Int64Type,
+			val:      time.Duration(123),
+			expected: types.Int64Value(123),
+		},
+		"json-raw-message": {
+			typ:      types.StringType,
+			val:      json.RawMessage(`{"a":1}`),
+			expected: types.StringValue(`{"a":1}`),
+		},
+		"text-marshaler": {
+			typ:      types.StringType,
+			val:      testColorRed,
+			expected: types.StringValue("red"),
+		},
+		"text-marshaler-netip": {
+			typ:      types.StringType,
+			val:      netip.MustParsePrefix("192.0.2.0/24"),
+			expected: types.StringValue("192.0.2.0/24"),
+		},
+		"text-marshaler-error": {
+			typ: types.StringType,
+			val: testColorUnknown,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unable to marshal reflect_test.testColor as text: unknown color",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), testCase.typ, testCase.val, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Tuple builds a new struct or fixed-size array using the data in `tuple`, as
+// long as `tuple` is a `tftypes.Tuple`. It will take the type from `target`,
+// which must be a struct or array type.
+//
+// When `target` is a struct, its exported properties are mapped to the tuple
+// elements in declaration order. Properties with a `tfsdk:"-"` tag are
+// skipped. When `target` is an array, its length must match the number of
+// tuple elements.
+//
+// Tuple is meant to be called from Into, not directly.
+func Tuple(ctx context.Context, typ attr.Type, tuple tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if target.Kind() != reflect.Struct && target.Kind() != reflect.Array {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("expected a struct or array type, got %s", target.Type()),
+		}))
+		return target, diags
+	}
+	if !tuple.Type().Is(tftypes.Tuple{}) {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("cannot reflect %s into a %s, must be a tuple", tuple.Type().String(), target.Kind()),
+		}))
+		return target, diags
+	}
+	elemsType, ok := typ.(attr.TypeWithElementTypes)
+	if !ok {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("cannot reflect tuple using type information provided by %T, %T must be an attr.TypeWithElementTypes", typ, typ),
+		}))
+		return target, diags
+	}
+
+	var tupleElems []tftypes.Value
+	err := tuple.As(&tupleElems)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	targetFields := getTupleFields(target)
+	elemTypes := elemsType.ElementTypes()
+
+	if len(targetFields) != len(tupleElems) || len(elemTypes) != len(tupleElems) {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("mismatch between %s and tuple: %s has %d elements, tuple has %d elements", target.Kind(), target.Type(), len(targetFields), len(tupleElems)),
+		}))
+		return target, diags
+	}
+
+	result := reflect.New(target.Type()).Elem()
+	for i, tupleElem := range tupleElems {
+		var elemTarget reflect.Value
+
+		if target.Kind() == reflect.Struct {
+			elemTarget = result.Field(targetFields[i])
+		} else {
+			elemTarget = result.Index(targetFields[i])
+		}
+
+		elemVal, elemValDiags := BuildValue(ctx, elemTypes[i], tupleElem, elemTarget, opts, path.AtTupleIndex(i))
+		diags.Append(elemValDiags...)
+
+		if diags.HasError() {
+			return target, diags
+		}
+		elemTarget.Set(elemVal)
+	}
+	return result, diags
+}
+
+// FromTuple builds an attr.Value as produced by `typ` from the data in `val`.
+// `val` must be a struct or array type, with one exported property or array
+// element for each element type reported by `typ`. FromTuple will recurse
+// into FromValue for each element, using the element type as reported by
+// `typ`.
+//
+// It is meant to be called through FromValue, not directly.
+func FromTuple(ctx context.Context, typ attr.TypeWithElementTypes, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elemTypes := typ.ElementTypes()
+	targetFields := getTupleFields(val)
+
+	if len(targetFields) != len(elemTypes) {
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert into a tuple. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Mismatch between %s and tuple type: %s has %d elements, tuple type has %d elements\n", val.Kind(), val.Type(), len(targetFields), len(elemTypes))+
+				fmt.Sprintf("Tuple type: %s", typ),
+		)
+
+		return nil, diags
+	}
+
+	tfTypes := make([]tftypes.Type, 0, len(elemTypes))
+	tfValues := make([]tftypes.Value, 0, len(elemTypes))
+
+	for i, elemType := range elemTypes {
+		path := path.AtTupleIndex(i)
+
+		var elemValue reflect.Value
+
+		if val.Kind() == reflect.Struct {
+			elemValue = val.Field(targetFields[i])
+		} else {
+			elemValue = val.Index(targetFields[i])
+		}
+
+		attrVal, attrValDiags := FromValue(ctx, elemType, elemValue.Interface(), path)
+		diags.Append(attrValDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		tfElemVal, err := attrVal.ToTerraformValue(ctx)
+		if err != nil {
+			return nil, append(diags, toTerraformValueErrorDiag(err, path))
+		}
+
+		tfValues = append(tfValues, tfElemVal)
+		tfTypes = append(tfTypes, tfElemVal.Type())
+	}
+
+	tfVal := tftypes.NewValue(tftypes.Tuple{
+		ElementTypes: tfTypes,
+	}, tfValues)
+
+	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	ret, err := typ.ValueFromTerraform(ctx, tfVal)
+	if err != nil {
+		return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	return ret, diags
+}
+
+// getTupleFields returns the positions of the tuple elements in `in`, which
+// must be a struct or array. For structs, exported fields are returned in
+// declaration order, skipping fields tagged with `tfsdk:"-"`. For arrays,
+// every index is returned.
+func getTupleFields(in reflect.Value) []int {
+	typ := in.Type()
+
+	if typ.Kind() == reflect.Array {
+		fields := make([]int, typ.Len())
+
+		for i := range fields {
+			fields[i] = i
+		}
+
+		return fields
+	}
+
+	var fields []int
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// skip unexported fields
+			continue
+		}
+		if field.Tag.Get(`tfsdk`) == "-" {
+			// skip explicitly excluded fields
+			continue
+		}
+		fields = append(fields, i)
+	}
+
+	return fields
+}
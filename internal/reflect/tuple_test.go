@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTuple_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		tupleVal      tftypes.Value
+		targetVal     reflect.Value
+		expectedError error
+	}{
+		"not-a-tuple": {
+			typ:           types.StringType,
+			tupleVal:      tftypes.NewValue(tftypes.String, "hello"),
+			targetVal:     reflect.ValueOf(struct{}{}),
+			expectedError: fmt.Errorf("cannot reflect %s into a struct, must be a tuple", tftypes.String),
+		},
+		"not-a-struct-or-array": {
+			typ: types.TupleType{
+				ElemTypes: []attr.Type{types.StringType},
+			},
+			tupleVal: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			targetVal:     reflect.ValueOf(""),
+			expectedError: errors.New("expected a struct or array type, got string"),
+		},
+		"not-an-element-types-type": {
+			typ: types.ListType{
+				ElemType: types.StringType,
+			},
+			tupleVal: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			targetVal:     reflect.ValueOf([1]string{}),
+			expectedError: errors.New("cannot reflect tuple using type information provided by basetypes.ListType, basetypes.ListType must be an attr.TypeWithElementTypes"),
+		},
+		"array-length-mismatch": {
+			typ: types.TupleType{
+				ElemTypes: []attr.Type{types.StringType, types.StringType},
+			},
+			tupleVal: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, "world"),
+			}),
+			targetVal:     reflect.ValueOf([3]string{}),
+			expectedError: errors.New("mismatch between array and tuple: [3]string has 3 elements, tuple has 2 elements"),
+		},
+		"struct-length-mismatch": {
+			typ: types.TupleType{
+				ElemTypes: []attr.Type{types.StringType, types.StringType},
+			},
+			tupleVal: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, "world"),
+			}),
+			targetVal: reflect.ValueOf(struct {
+				A string
+			}{}),
+			expectedError: errors.New("mismatch between struct and tuple: struct { A string } has 1 elements, tuple has 2 elements"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expectedDiags := diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Err:        testCase.expectedError,
+					TargetType: testCase.targetVal.Type(),
+					Val:        testCase.tupleVal,
+				}),
+			}
+
+			_, diags := refl.Tuple(context.Background(), testCase.typ, testCase.tupleVal, testCase.targetVal, refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTuple_array(t *testing.T) {
+	t.Parallel()
+
+	var target [2]string
+
+	result, diags := refl.Tuple(context.Background(), types.TupleType{
+		ElemTypes: []attr.Type{types.StringType, types.StringType},
+	}, tftypes.NewValue(tftypes.Tuple{
+		ElementTypes: []tftypes.Type{tftypes.String, tftypes.String},
+	}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "hello"),
+		tftypes.NewValue(tftypes.String, "world"),
+	}), reflect.ValueOf(target), refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	reflect.ValueOf(&target).Elem().Set(result)
+
+	if diff := cmp.Diff(target, [2]string{"hello", "world"}); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestTuple_struct(t *testing.T) {
+	t.Parallel()
+
+	var target struct {
+		Name    string
+		Ignored string `tfsdk:"-"`
+		Count   int64
+		private bool //nolint:unused
+		Enabled types.Bool
+	}
+
+	result, diags := refl.Tuple(context.Background(), types.TupleType{
+		ElemTypes: []attr.Type{types.StringType, types.Int64Type, types.BoolType},
+	}, tftypes.NewValue(tftypes.Tuple{
+		ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number, tftypes.Bool},
+	}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "hello"),
+		tftypes.NewValue(tftypes.Number, 123),
+		tftypes.NewValue(tftypes.Bool, nil),
+	}), reflect.ValueOf(target), refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	reflect.ValueOf(&target).Elem().Set(result)
+
+	if target.Name != "hello" {
+		t.Errorf("Expected target.Name to be %q, was %q", "hello", target.Name)
+	}
+
+	if target.Count != 123 {
+		t.Errorf("Expected target.Count to be %d, was %d", 123, target.Count)
+	}
+
+	if !target.Enabled.Equal(types.BoolNull()) {
+		t.Errorf("Expected target.Enabled to be %s, was %s", types.BoolNull(), target.Enabled)
+	}
+}
+
+func TestTuple_elementError(t *testing.T) {
+	t.Parallel()
+
+	var target [2]string
+
+	_, diags := refl.Tuple(context.Background(), types.TupleType{
+		ElemTypes: []attr.Type{types.StringType, types.StringType},
+	}, tftypes.NewValue(tftypes.Tuple{
+		ElementTypes: []tftypes.Type{tftypes.String, tftypes.String},
+	}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "hello"),
+		tftypes.NewValue(tftypes.String, nil),
+	}), reflect.ValueOf(target), refl.Options{}, path.Root("test"))
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+
+	if !ok {
+		t.Fatalf("expected diagnostic with path, got: %T", diags[0])
+	}
+
+	if !withPath.Path().Equal(path.Root("test").AtTupleIndex(1)) {
+		t.Errorf("expected path %s, got: %s", path.Root("test").AtTupleIndex(1), withPath.Path())
+	}
+}
+
+func TestFromTuple(t *testing.T) {
+	t.Parallel()
+
+	tupleType := types.TupleType{
+		ElemTypes: []attr.Type{types.StringType, types.Int64Type},
+	}
+
+	type pair struct {
+		Name    string
+		Ignored string `tfsdk:"-"`
+		Count   int64
+	}
+
+	testCases := map[string]struct {
+		typ           attr.TypeWithElementTypes
+		val           reflect.Value
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"array": {
+			typ: types.TupleType{
+				ElemTypes: []attr.Type{types.StringType, types.StringType},
+			},
+			val: reflect.ValueOf([2]string{"hello", "world"}),
+			expected: types.TupleValueMust(
+				[]attr.Type{types.StringType, types.StringType},
+				[]attr.Value{types.StringValue("hello"), types.StringValue("world")},
+			),
+		},
+		"struct": {
+			typ: tupleType,
+			val: reflect.ValueOf(pair{Name: "hello", Ignored: "ignored", Count: 123}),
+			expected: types.TupleValueMust(
+				[]attr.Type{types.StringType, types.Int64Type},
+				[]attr.Value{types.StringValue("hello"), types.Int64Value(123)},
+			),
+		},
+		"length-mismatch": {
+			typ: tupleType,
+			val: reflect.ValueOf([1]string{"hello"}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert into a tuple. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Mismatch between array and tuple type: [1]string has 1 elements, tuple type has 2 elements\n"+
+						"Tuple type: types.TupleType[basetypes.StringType, basetypes.Int64Type]",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromTuple(context.Background(), testCase.typ, testCase.val, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromValue_tuple(t *testing.T) {
+	t.Parallel()
+
+	got, diags := refl.FromValue(context.Background(), types.TupleType{
+		ElemTypes: []attr.Type{types.StringType, types.BoolType},
+	}, struct {
+		Name    string
+		Enabled bool
+	}{
+		Name:    "hello",
+		Enabled: true,
+	}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := types.TupleValueMust(
+		[]attr.Type{types.StringType, types.BoolType},
+		[]attr.Value{types.StringValue("hello"), types.BoolValue(true)},
+	)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
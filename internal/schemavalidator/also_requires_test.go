@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlsoRequiresValidatorValidate(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test":   schema.StringAttribute{Optional: true},
+			"other1": schema.StringAttribute{Optional: true},
+			"other2": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	testConfig := func(test, other1, other2 tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"test":   test,
+					"other1": other1,
+					"other2": other2,
+				},
+			),
+		}
+	}
+
+	expressions := path.Expressions{
+		path.MatchRoot("other1"),
+		path.MatchRoot("other2"),
+	}
+
+	testCases := map[string]struct {
+		req      schemavalidator.AlsoRequiresValidatorRequest
+		expected diag.Diagnostics
+	}{
+		"self-null": {
+			req: schemavalidator.AlsoRequiresValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: nil,
+		},
+		"others-set": {
+			req: schemavalidator.AlsoRequiresValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, "a"), tftypes.NewValue(tftypes.String, "b"), tftypes.NewValue(tftypes.String, "c")),
+				ConfigValue:    types.StringValue("a"),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: nil,
+		},
+		"other-missing": {
+			req: schemavalidator.AlsoRequiresValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, "a"), tftypes.NewValue(tftypes.String, "b"), tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringValue("a"),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					"Attribute \"other2\" must be specified when \"test\" is specified",
+				),
+			},
+		},
+		"others-missing": {
+			req: schemavalidator.AlsoRequiresValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, "a"), tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringValue("a"),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					"Attribute \"other1\" must be specified when \"test\" is specified",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					"Attribute \"other2\" must be specified when \"test\" is specified",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &schemavalidator.AlsoRequiresValidatorResponse{}
+
+			schemavalidator.AlsoRequiresValidator{
+				PathExpressions: expressions,
+			}.Validate(context.Background(), testCase.req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
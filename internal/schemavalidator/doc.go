@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemavalidator contains the shared implementations of the
+// cross-attribute schema validators, such as ConflictsWith, which are exposed
+// by every typed package under schema/validator.
+package schemavalidator
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExactlyOneOfValidatorValidate(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test":   schema.StringAttribute{Optional: true},
+			"other1": schema.StringAttribute{Optional: true},
+			"other2": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	testConfig := func(test, other1, other2 tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"test":   test,
+					"other1": other1,
+					"other2": other2,
+				},
+			),
+		}
+	}
+
+	expressions := path.Expressions{
+		path.MatchRoot("other1"),
+		path.MatchRoot("other2"),
+	}
+
+	testCases := map[string]struct {
+		req      schemavalidator.ExactlyOneOfValidatorRequest
+		expected diag.Diagnostics
+	}{
+		"self-set": {
+			req: schemavalidator.ExactlyOneOfValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, "a"), tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringValue("a"),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: nil,
+		},
+		"other-set": {
+			req: schemavalidator.ExactlyOneOfValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, "b"), tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: nil,
+		},
+		"self-unknown": {
+			req: schemavalidator.ExactlyOneOfValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, "b"), tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringUnknown(),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: nil,
+		},
+		"none-set": {
+			req: schemavalidator.ExactlyOneOfValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					"No attribute specified when one (and only one) of [other1,other2] is required",
+				),
+			},
+		},
+		"multiple-set": {
+			req: schemavalidator.ExactlyOneOfValidatorRequest{
+				Config:         testConfig(tftypes.NewValue(tftypes.String, "a"), tftypes.NewValue(tftypes.String, "b"), tftypes.NewValue(tftypes.String, "c")),
+				ConfigValue:    types.StringValue("a"),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					"3 attributes specified when one (and only one) of [other1,other2] is required",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &schemavalidator.ExactlyOneOfValidatorResponse{}
+
+			schemavalidator.ExactlyOneOfValidator{
+				PathExpressions: expressions,
+			}.Validate(context.Background(), testCase.req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
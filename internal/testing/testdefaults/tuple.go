@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testdefaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+var _ defaults.Tuple = Tuple{}
+
+// Declarative defaults.Tuple for unit testing.
+type Tuple struct {
+	// defaults.Describer interface methods
+	DescriptionMethod         func(context.Context) string
+	MarkdownDescriptionMethod func(context.Context) string
+
+	// defaults.Tuple interface methods
+	DefaultTupleMethod func(context.Context, defaults.TupleRequest, *defaults.TupleResponse)
+}
+
+// Description satisfies the defaults.Describer interface.
+func (v Tuple) Description(ctx context.Context) string {
+	if v.DescriptionMethod == nil {
+		return ""
+	}
+
+	return v.DescriptionMethod(ctx)
+}
+
+// MarkdownDescription satisfies the defaults.Describer interface.
+func (v Tuple) MarkdownDescription(ctx context.Context) string {
+	if v.MarkdownDescriptionMethod == nil {
+		return ""
+	}
+
+	return v.MarkdownDescriptionMethod(ctx)
+}
+
+// DefaultTuple satisfies the defaults.Tuple interface.
+func (v Tuple) DefaultTuple(ctx context.Context, req defaults.TupleRequest, resp *defaults.TupleResponse) {
+	if v.DefaultTupleMethod == nil {
+		return
+	}
+
+	v.DefaultTupleMethod(ctx, req, resp)
+}
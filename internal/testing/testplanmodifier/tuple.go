@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var _ planmodifier.Tuple = &Tuple{}
+
+// Declarative planmodifier.Tuple for unit testing.
+type Tuple struct {
+	// Tuple interface methods
+	DescriptionMethod         func(context.Context) string
+	MarkdownDescriptionMethod func(context.Context) string
+	PlanModifyTupleMethod     func(context.Context, planmodifier.TupleRequest, *planmodifier.TupleResponse)
+}
+
+// Description satisfies the planmodifier.Tuple interface.
+func (v Tuple) Description(ctx context.Context) string {
+	if v.DescriptionMethod == nil {
+		return ""
+	}
+
+	return v.DescriptionMethod(ctx)
+}
+
+// MarkdownDescription satisfies the planmodifier.Tuple interface.
+func (v Tuple) MarkdownDescription(ctx context.Context) string {
+	if v.MarkdownDescriptionMethod == nil {
+		return ""
+	}
+
+	return v.MarkdownDescriptionMethod(ctx)
+}
+
+// PlanModify satisfies the planmodifier.Tuple interface.
+func (v Tuple) PlanModifyTuple(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
+	if v.PlanModifyTupleMethod == nil {
+		return
+	}
+
+	v.PlanModifyTupleMethod(ctx, req, resp)
+}
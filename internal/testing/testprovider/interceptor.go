@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+)
+
+var _ interceptor.Interceptor = &Interceptor{}
+
+// Declarative interceptor.Interceptor for unit testing.
+type Interceptor struct {
+	// Interceptor interface methods
+	AfterMethod  func(context.Context, interceptor.Request, *interceptor.AfterResponse)
+	BeforeMethod func(context.Context, interceptor.Request, *interceptor.BeforeResponse)
+}
+
+// After satisfies the interceptor.Interceptor interface.
+func (i *Interceptor) After(ctx context.Context, req interceptor.Request, resp *interceptor.AfterResponse) {
+	if i.AfterMethod == nil {
+		return
+	}
+
+	i.AfterMethod(ctx, req, resp)
+}
+
+// Before satisfies the interceptor.Interceptor interface.
+func (i *Interceptor) Before(ctx context.Context, req interceptor.Request, resp *interceptor.BeforeResponse) {
+	if i.BeforeMethod == nil {
+		return
+	}
+
+	i.BeforeMethod(ctx, req, resp)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ fwschema.AttributeWithTupleDefaultValue = AttributeWithTupleDefaultValue{}
+
+type AttributeWithTupleDefaultValue struct {
+	ElementTypes        []attr.Type
+	Computed            bool
+	DeprecationMessage  string
+	Description         string
+	MarkdownDescription string
+	Optional            bool
+	Required            bool
+	Sensitive           bool
+	Default             defaults.Tuple
+}
+
+// ApplyTerraform5AttributePathStep satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return a.GetType().ApplyTerraform5AttributePathStep(step)
+}
+
+// TupleDefaultValue satisfies the fwschema.AttributeWithTupleDefaultValue interface.
+func (a AttributeWithTupleDefaultValue) TupleDefaultValue() defaults.Tuple {
+	return a.Default
+}
+
+// Equal satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) Equal(o fwschema.Attribute) bool {
+	_, ok := o.(AttributeWithTupleDefaultValue)
+
+	if !ok {
+		return false
+	}
+
+	return fwschema.AttributesEqual(a, o)
+}
+
+// GetDeprecationMessage satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) GetDeprecationMessage() string {
+	return a.DeprecationMessage
+}
+
+// GetDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) GetDescription() string {
+	return a.Description
+}
+
+// GetMarkdownDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) GetMarkdownDescription() string {
+	return a.MarkdownDescription
+}
+
+// GetType satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) GetType() attr.Type {
+	return types.TupleType{
+		ElemTypes: a.ElementTypes,
+	}
+}
+
+// IsComputed satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) IsComputed() bool {
+	return a.Computed
+}
+
+// IsOptional satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) IsOptional() bool {
+	return a.Optional
+}
+
+// IsRequired satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) IsRequired() bool {
+	return a.Required
+}
+
+// IsSensitive satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleDefaultValue) IsSensitive() bool {
+	return a.Sensitive
+}
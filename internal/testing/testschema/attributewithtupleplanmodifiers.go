@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ fwxschema.AttributeWithTuplePlanModifiers = AttributeWithTuplePlanModifiers{}
+
+type AttributeWithTuplePlanModifiers struct {
+	ElementTypes        []attr.Type
+	Computed            bool
+	DeprecationMessage  string
+	Description         string
+	MarkdownDescription string
+	Optional            bool
+	Required            bool
+	Sensitive           bool
+	PlanModifiers       []planmodifier.Tuple
+}
+
+// ApplyTerraform5AttributePathStep satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return a.GetType().ApplyTerraform5AttributePathStep(step)
+}
+
+// Equal satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) Equal(o fwschema.Attribute) bool {
+	_, ok := o.(AttributeWithTuplePlanModifiers)
+
+	if !ok {
+		return false
+	}
+
+	return fwschema.AttributesEqual(a, o)
+}
+
+// GetDeprecationMessage satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) GetDeprecationMessage() string {
+	return a.DeprecationMessage
+}
+
+// GetDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) GetDescription() string {
+	return a.Description
+}
+
+// GetMarkdownDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) GetMarkdownDescription() string {
+	return a.MarkdownDescription
+}
+
+// GetType satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) GetType() attr.Type {
+	return types.TupleType{
+		ElemTypes: a.ElementTypes,
+	}
+}
+
+// IsComputed satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) IsComputed() bool {
+	return a.Computed
+}
+
+// IsOptional satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) IsOptional() bool {
+	return a.Optional
+}
+
+// IsRequired satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) IsRequired() bool {
+	return a.Required
+}
+
+// IsSensitive satisfies the fwschema.Attribute interface.
+func (a AttributeWithTuplePlanModifiers) IsSensitive() bool {
+	return a.Sensitive
+}
+
+// TuplePlanModifiers satisfies the fwxschema.AttributeWithTuplePlanModifiers interface.
+func (a AttributeWithTuplePlanModifiers) TuplePlanModifiers() []planmodifier.Tuple {
+	return a.PlanModifiers
+}
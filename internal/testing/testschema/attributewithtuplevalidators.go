@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ fwxschema.AttributeWithTupleValidators = AttributeWithTupleValidators{}
+
+type AttributeWithTupleValidators struct {
+	ElementTypes        []attr.Type
+	Computed            bool
+	DeprecationMessage  string
+	Description         string
+	MarkdownDescription string
+	Optional            bool
+	Required            bool
+	Sensitive           bool
+	Validators          []validator.Tuple
+}
+
+// ApplyTerraform5AttributePathStep satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return a.GetType().ApplyTerraform5AttributePathStep(step)
+}
+
+// Equal satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) Equal(o fwschema.Attribute) bool {
+	_, ok := o.(AttributeWithTupleValidators)
+
+	if !ok {
+		return false
+	}
+
+	return fwschema.AttributesEqual(a, o)
+}
+
+// GetDeprecationMessage satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) GetDeprecationMessage() string {
+	return a.DeprecationMessage
+}
+
+// GetDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) GetDescription() string {
+	return a.Description
+}
+
+// GetMarkdownDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) GetMarkdownDescription() string {
+	return a.MarkdownDescription
+}
+
+// GetType satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) GetType() attr.Type {
+	return types.TupleType{
+		ElemTypes: a.ElementTypes,
+	}
+}
+
+// IsComputed satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) IsComputed() bool {
+	return a.Computed
+}
+
+// IsOptional satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) IsOptional() bool {
+	return a.Optional
+}
+
+// IsRequired satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) IsRequired() bool {
+	return a.Required
+}
+
+// IsSensitive satisfies the fwschema.Attribute interface.
+func (a AttributeWithTupleValidators) IsSensitive() bool {
+	return a.Sensitive
+}
+
+// TupleValidators satisfies the fwxschema.AttributeWithTupleValidators interface.
+func (a AttributeWithTupleValidators) TupleValidators() []validator.Tuple {
+	return a.Validators
+}
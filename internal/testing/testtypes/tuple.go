@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testtypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.TupleTypable  = TupleType{}
+	_ basetypes.TupleValuable = TupleValue{}
+)
+
+type TupleType struct {
+	basetypes.TupleType
+}
+
+func (t TupleType) Equal(o attr.Type) bool {
+	other, ok := o.(TupleType)
+
+	if !ok {
+		return false
+	}
+
+	return t.TupleType.Equal(other.TupleType)
+}
+
+type TupleValue struct {
+	basetypes.TupleValue
+}
+
+func (v TupleValue) Equal(o attr.Value) bool {
+	other, ok := o.(TupleValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.TupleValue.Equal(other.TupleValue)
+}
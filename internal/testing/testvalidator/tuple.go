@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Tuple = &Tuple{}
+
+// Declarative validator.Tuple for unit testing.
+type Tuple struct {
+	// Tuple interface methods
+	DescriptionMethod         func(context.Context) string
+	MarkdownDescriptionMethod func(context.Context) string
+	ValidateTupleMethod       func(context.Context, validator.TupleRequest, *validator.TupleResponse)
+}
+
+// Description satisfies the validator.Tuple interface.
+func (v Tuple) Description(ctx context.Context) string {
+	if v.DescriptionMethod == nil {
+		return ""
+	}
+
+	return v.DescriptionMethod(ctx)
+}
+
+// MarkdownDescription satisfies the validator.Tuple interface.
+func (v Tuple) MarkdownDescription(ctx context.Context) string {
+	if v.MarkdownDescriptionMethod == nil {
+		return ""
+	}
+
+	return v.MarkdownDescriptionMethod(ctx)
+}
+
+// Validate satisfies the validator.Tuple interface.
+func (v Tuple) ValidateTuple(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+	if v.ValidateTupleMethod == nil {
+		return
+	}
+
+	v.ValidateTupleMethod(ctx, req, resp)
+}
@@ -5,6 +5,7 @@ package toproto5_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -113,6 +114,19 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"DiagnosticWithPath-WithCode-WithError": {
+			diags: diag.Diagnostics{
+				diag.WithError(errors.New("test error"), diag.WithCode("test_code", diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"))),
+			},
+			expected: []*tfprotov5.Diagnostic{
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					Detail:    "one detail",
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "one summary",
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validatordiag
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// InvalidAttributeValueDiagnostic returns an error Diagnostic to be used when
+// an attribute has an invalid value.
+func InvalidAttributeValueDiagnostic(attributePath path.Path, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %s", attributePath, description, value),
+	)
+}
+
+// InvalidAttributeValueLengthDiagnostic returns an error Diagnostic to be used
+// when an attribute's value has an invalid length.
+func InvalidAttributeValueLengthDiagnostic(attributePath path.Path, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Attribute Value Length",
+		fmt.Sprintf("Attribute %s %s, got: %s", attributePath, description, value),
+	)
+}
+
+// InvalidAttributeValueMatchDiagnostic returns an error Diagnostic to be used
+// when an attribute's value has an invalid match.
+func InvalidAttributeValueMatchDiagnostic(attributePath path.Path, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Attribute Value Match",
+		fmt.Sprintf("Attribute %s %s, got: %s", attributePath, description, value),
+	)
+}
+
+// InvalidAttributeCombinationDiagnostic returns an error Diagnostic to be used
+// when a combination of attributes is invalid.
+func InvalidAttributeCombinationDiagnostic(attributePath path.Path, description string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Attribute Combination",
+		Capitalize(description),
+	)
+}
+
+// Capitalize will uppercase the first letter in a UTF-8 string.
+func Capitalize(str string) string {
+	if str == "" {
+		return ""
+	}
+
+	firstRune, size := utf8.DecodeRuneInString(str)
+
+	return string(unicode.ToUpper(firstRune)) + str[size:]
+}
+
+// InvalidElementTypeDiagnostic returns an error Diagnostic to be used when a
+// collection element validator is used with an element of a different type.
+func InvalidElementTypeDiagnostic(elementPath path.Path, expectedType string, actualType attr.Type) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		elementPath,
+		"Invalid Validator for Element Type",
+		"While performing schema-based validation, an unexpected error occurred. "+
+			"The attribute declares a "+expectedType+" values validator, however its values do not implement types."+expectedType+"Type or the types."+expectedType+"Typable interface for custom "+expectedType+" types. "+
+			"Use the appropriate values validator that matches the element type. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Path: %s\n", elementPath)+
+			fmt.Sprintf("Element Type: %T\n", actualType),
+	)
+}
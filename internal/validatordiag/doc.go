@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validatordiag contains diagnostics helpers for the schema validator
+// implementations in the schema/validator subpackages.
+package validatordiag
@@ -26,10 +26,14 @@ import (
 //   - AtAnyListIndex(): Step into a list at any index
 //   - AtAnyMapKey(): Step into a map at any key
 //   - AtAnySetValue(): Step into a set at any attr.Value element
+//   - AtElementWhere(): Step into a list, map, or set at any object element
+//     with an attribute equal to a specific attr.Value
 //   - AtListIndex(): Step into a list at a specific index
 //   - AtMapKey(): Step into a map at a specific key
 //   - AtName(): Step into an attribute or block with a specific name
 //   - AtParent(): Step backwards one step
+//   - AtRecursiveDescent(): Step into an attribute or block with a specific
+//     name at any depth
 //   - AtSetValue(): Step into a set at a specific attr.Value element
 //
 // For example, to express any list element with a root list attribute named
@@ -87,6 +91,21 @@ func (e Expression) AtAnySetValue() Expression {
 	return copiedPath
 }
 
+// AtElementWhere returns a copied expression with a new element predicate
+// step at the end, which matches any list, map, or set element that is an
+// object with an attribute named attributeName equal to value. The returned
+// path is safe to modify without affecting the original.
+func (e Expression) AtElementWhere(attributeName string, value attr.Value) Expression {
+	copiedPath := e.Copy()
+
+	copiedPath.steps.Append(ExpressionStepElementPredicate{
+		AttributeName: attributeName,
+		Value:         value,
+	})
+
+	return copiedPath
+}
+
 // AtListIndex returns a copied expression with a new list index step at the
 // end. The returned path is safe to modify without affecting the original.
 func (e Expression) AtListIndex(index int) Expression {
@@ -128,6 +147,18 @@ func (e Expression) AtParent() Expression {
 	return copiedPath
 }
 
+// AtRecursiveDescent returns a copied expression with a new recursive descent
+// step at the end, which matches an attribute or block with a specific name
+// at any depth. The returned path is safe to modify without affecting the
+// original.
+func (e Expression) AtRecursiveDescent(name string) Expression {
+	copiedPath := e.Copy()
+
+	copiedPath.steps.Append(ExpressionStepRecursiveDescent(name))
+
+	return copiedPath
+}
+
 // AtSetValue returns a copied expression with a new set value step at the end.
 // The returned path is safe to modify without affecting the original.
 func (e Expression) AtSetValue(value attr.Value) Expression {
@@ -175,6 +206,14 @@ func (e Expression) Matches(path Path) bool {
 	return e.steps.Matches(path.Steps())
 }
 
+// MatchesWithValues returns true if the given Path is valid for the
+// Expression, using valueAt to fetch list and map element values for any
+// ExpressionStepElementPredicate. Any relative expression steps, such as
+// ExpressionStepParent, are automatically resolved before matching.
+func (e Expression) MatchesWithValues(path Path, valueAt ValueAtPathFunc) bool {
+	return e.steps.Resolve().matches(path.Steps(), false, valueAt)
+}
+
 // MatchesParent returns true if the given Path is a valid parent for the
 // Expression. This is helpful for determining if a child Path would
 // potentially match the full Expression during depth-first traversal. Any
@@ -184,6 +223,14 @@ func (e Expression) MatchesParent(path Path) bool {
 	return e.steps.MatchesParent(path.Steps())
 }
 
+// MatchesParentWithValues returns true if the given Path is a valid parent
+// for the Expression, using valueAt to fetch list and map element values for
+// any ExpressionStepElementPredicate. Any relative expression steps, such as
+// ExpressionStepParent, are automatically resolved before matching.
+func (e Expression) MatchesParentWithValues(path Path, valueAt ValueAtPathFunc) bool {
+	return e.steps.Resolve().matches(path.Steps(), true, valueAt)
+}
+
 // Merge returns a copied expression either with the steps of the given
 // expression added to the end of the existing steps, or overwriting the
 // steps if the given expression was a root expression.
@@ -268,6 +315,18 @@ func MatchRelative() Expression {
 	}
 }
 
+// MatchRecursiveDescent creates an attribute path expression starting with
+// ExpressionStepRecursiveDescent, which matches an attribute or block with a
+// specific name at any depth from the root of the schema.
+func MatchRecursiveDescent(name string) Expression {
+	return Expression{
+		root: true,
+		steps: ExpressionSteps{
+			ExpressionStepRecursiveDescent(name),
+		},
+	}
+}
+
 // MatchRoot creates an attribute path expression starting with
 // ExpressionStepAttributeNameExact.
 func MatchRoot(rootAttributeName string) Expression {
@@ -278,3 +337,8 @@ func MatchRoot(rootAttributeName string) Expression {
 		},
 	}
 }
+
+// ValueAtPathFunc returns the value at the given Path, or nil if it is not
+// available. It is used to match ExpressionStepElementPredicate against list
+// and map elements, whose path steps do not include the element value.
+type ValueAtPathFunc func(Path) attr.Value
@@ -28,7 +28,7 @@ func (s ExpressionStepElementKeyValueExact) Equal(o ExpressionStep) bool {
 		return false
 	}
 
-	return s.Value.Equal(other.Value)
+	return valuesEqual(s.Value, other.Value)
 }
 
 // Matches returns true if the given PathStep is fulfilled by the
@@ -40,7 +40,7 @@ func (s ExpressionStepElementKeyValueExact) Matches(pathStep PathStep) bool {
 		return false
 	}
 
-	return s.Value.Equal(pathStepElementKeyValue.Value)
+	return valuesEqual(s.Value, pathStepElementKeyValue.Value)
 }
 
 // String returns the human-readable representation of the element key
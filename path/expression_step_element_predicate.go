@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Ensure ExpressionStepElementPredicate satisfies the ExpressionStep
+// interface.
+var _ ExpressionStep = ExpressionStepElementPredicate{}
+
+// ExpressionStepElementPredicate is an attribute path expression for any
+// list, map, or set element which is an object with an attribute equal to the
+// given value, such as any nested attribute object with a "type" attribute
+// equal to "example".
+//
+// Set element path steps contain the element value, so they are fully
+// matched by Matches. List and map element path steps do not contain the
+// element value, so Matches never matches them. Use the Expression
+// MatchesWithValues method, or PathMatches methods on schema-based data such
+// as tfsdk.Config, to match those element values.
+type ExpressionStepElementPredicate struct {
+	// AttributeName is the name of the element object attribute to compare.
+	AttributeName string
+
+	// Value is the value the element object attribute must be equal to.
+	Value attr.Value
+}
+
+// Equal returns true if the given ExpressionStep is a
+// ExpressionStepElementPredicate and the attribute name and value are
+// equivalent.
+func (s ExpressionStepElementPredicate) Equal(o ExpressionStep) bool {
+	other, ok := o.(ExpressionStepElementPredicate)
+
+	if !ok {
+		return false
+	}
+
+	if s.AttributeName != other.AttributeName {
+		return false
+	}
+
+	if s.Value == nil || other.Value == nil {
+		return s.Value == nil && other.Value == nil
+	}
+
+	return valuesEqual(s.Value, other.Value)
+}
+
+// Matches returns true if the given PathStep is fulfilled by the
+// ExpressionStepElementPredicate condition. List and map element path steps
+// never match, as the element value is not available.
+func (s ExpressionStepElementPredicate) Matches(pathStep PathStep) bool {
+	switch pathStep := pathStep.(type) {
+	case PathStepElementKeyValue:
+		return s.MatchesElement(context.Background(), pathStep.Value)
+	default:
+		return false
+	}
+}
+
+// MatchesElement returns true if the given element value is an object with
+// an attribute equal to the predicate value. Null and unknown elements never
+// match.
+func (s ExpressionStepElementPredicate) MatchesElement(ctx context.Context, element attr.Value) bool {
+	if element == nil || s.Value == nil {
+		return false
+	}
+
+	tfElement, err := element.ToTerraformValue(ctx)
+
+	if err != nil || tfElement.IsNull() || !tfElement.IsKnown() {
+		return false
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := tfElement.As(&attributes); err != nil {
+		return false
+	}
+
+	tfAttribute, ok := attributes[s.AttributeName]
+
+	if !ok {
+		return false
+	}
+
+	if literal, ok := s.Value.(literalValue); ok {
+		return literal.equalTerraformValue(tfAttribute)
+	}
+
+	tfValue, err := s.Value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return false
+	}
+
+	return tfAttribute.Equal(tfValue)
+}
+
+// String returns the human-readable representation of the element predicate
+// expression. It is intended for logging and error messages and is not
+// protected by compatibility guarantees.
+func (s ExpressionStepElementPredicate) String() string {
+	if s.Value == nil {
+		return fmt.Sprintf("[?%s==<nil>]", s.AttributeName)
+	}
+
+	return fmt.Sprintf("[?%s==%s]", s.AttributeName, s.Value.String())
+}
+
+// unexported satisfies the Step interface.
+func (s ExpressionStepElementPredicate) unexported() {}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpressionStepElementPredicateEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepElementPredicate
+		other    path.ExpressionStep
+		expected bool
+	}{
+		"ExpressionStepAttributeNameExact": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			other:    path.ExpressionStepAttributeNameExact("test"),
+			expected: false,
+		},
+		"ExpressionStepElementKeyValueExact": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementKeyValueExact{Value: types.StringValue("test")},
+			expected: false,
+		},
+		"ExpressionStepElementPredicate-different-attribute-name": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementPredicate{AttributeName: "not-test", Value: types.StringValue("test")},
+			expected: false,
+		},
+		"ExpressionStepElementPredicate-different-value": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("not-test")},
+			expected: false,
+		},
+		"ExpressionStepElementPredicate-nil-value": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementPredicate{AttributeName: "test"},
+			expected: false,
+		},
+		"ExpressionStepElementPredicate-equal": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionStepElementPredicateMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepElementPredicate
+		pathStep path.PathStep
+		expected bool
+	}{
+		"StepAttributeName": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			pathStep: path.PathStepAttributeName("test"),
+			expected: false,
+		},
+		"StepElementKeyInt": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			pathStep: path.PathStepElementKeyInt(0),
+			expected: false,
+		},
+		"StepElementKeyString": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			pathStep: path.PathStepElementKeyString("test"),
+			expected: false,
+		},
+		"StepElementKeyValue-match": {
+			step: path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			pathStep: path.PathStepElementKeyValue{
+				Value: types.ObjectValueMust(
+					map[string]attr.Type{"test": types.StringType},
+					map[string]attr.Value{"test": types.StringValue("test")},
+				),
+			},
+			expected: true,
+		},
+		"StepElementKeyValue-mismatch": {
+			step: path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			pathStep: path.PathStepElementKeyValue{
+				Value: types.ObjectValueMust(
+					map[string]attr.Type{"test": types.StringType},
+					map[string]attr.Value{"test": types.StringValue("not-test")},
+				),
+			},
+			expected: false,
+		},
+		"StepElementKeyValue-primitive": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			pathStep: path.PathStepElementKeyValue{Value: types.StringValue("test")},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Matches(testCase.pathStep)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpressionStepElementPredicateMatchesElement(t *testing.T) {
+	t.Parallel()
+
+	objectType := map[string]attr.Type{
+		"test":  types.StringType,
+		"count": types.Int64Type,
+	}
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepElementPredicate
+		element  attr.Value
+		expected bool
+	}{
+		"nil": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			element:  nil,
+			expected: false,
+		},
+		"null": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			element:  types.ObjectNull(objectType),
+			expected: false,
+		},
+		"unknown": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			element:  types.ObjectUnknown(objectType),
+			expected: false,
+		},
+		"object-match": {
+			step: path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			element: types.ObjectValueMust(objectType, map[string]attr.Value{
+				"test":  types.StringValue("test"),
+				"count": types.Int64Value(1),
+			}),
+			expected: true,
+		},
+		"object-match-number": {
+			step: path.ExpressionStepElementPredicate{AttributeName: "count", Value: types.Int64Value(1)},
+			element: types.ObjectValueMust(objectType, map[string]attr.Value{
+				"test":  types.StringValue("test"),
+				"count": types.Int64Value(1),
+			}),
+			expected: true,
+		},
+		"object-mismatch": {
+			step: path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			element: types.ObjectValueMust(objectType, map[string]attr.Value{
+				"test":  types.StringValue("not-test"),
+				"count": types.Int64Value(1),
+			}),
+			expected: false,
+		},
+		"object-mismatch-null-attribute": {
+			step: path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			element: types.ObjectValueMust(objectType, map[string]attr.Value{
+				"test":  types.StringNull(),
+				"count": types.Int64Value(1),
+			}),
+			expected: false,
+		},
+		"object-missing-attribute": {
+			step: path.ExpressionStepElementPredicate{AttributeName: "other", Value: types.StringValue("test")},
+			element: types.ObjectValueMust(objectType, map[string]attr.Value{
+				"test":  types.StringValue("test"),
+				"count": types.Int64Value(1),
+			}),
+			expected: false,
+		},
+		"map-match": {
+			step: path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			element: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"test": types.StringValue("test"),
+			}),
+			expected: true,
+		},
+		"primitive": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			element:  types.StringValue("test"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.MatchesElement(context.Background(), testCase.element)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpressionStepElementPredicateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepElementPredicate
+		expected string
+	}{
+		"string": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+			expected: `[?test=="test"]`,
+		},
+		"number": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.Int64Value(1)},
+			expected: `[?test==1]`,
+		},
+		"nil": {
+			step:     path.ExpressionStepElementPredicate{AttributeName: "test"},
+			expected: `[?test==<nil>]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
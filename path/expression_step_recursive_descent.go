@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path
+
+// Ensure ExpressionStepRecursiveDescent satisfies the ExpressionStep
+// interface.
+var _ ExpressionStep = ExpressionStepRecursiveDescent("")
+
+// ExpressionStepRecursiveDescent is an attribute path expression for an exact
+// attribute name match at any depth, such as any attribute with the name
+// within any nested attributes or blocks. It can match zero or more path
+// steps followed by the attribute name step.
+//
+// The step itself only matches the final attribute name path step. Matching
+// any path steps before that is handled by ExpressionSteps.
+type ExpressionStepRecursiveDescent string
+
+// Equal returns true if the given ExpressionStep is a
+// ExpressionStepRecursiveDescent and the attribute name is equivalent.
+func (s ExpressionStepRecursiveDescent) Equal(o ExpressionStep) bool {
+	other, ok := o.(ExpressionStepRecursiveDescent)
+
+	if !ok {
+		return false
+	}
+
+	return string(s) == string(other)
+}
+
+// Matches returns true if the given PathStep is fulfilled by the
+// attribute name of the ExpressionStepRecursiveDescent condition.
+func (s ExpressionStepRecursiveDescent) Matches(pathStep PathStep) bool {
+	pathStepAttributeName, ok := pathStep.(PathStepAttributeName)
+
+	if !ok {
+		return false
+	}
+
+	return string(s) == string(pathStepAttributeName)
+}
+
+// String returns the human-readable representation of the recursive descent
+// expression. It is intended for logging and error messages and is not
+// protected by compatibility guarantees.
+func (s ExpressionStepRecursiveDescent) String() string {
+	return ".." + string(s)
+}
+
+// unexported satisfies the Step interface.
+func (s ExpressionStepRecursiveDescent) unexported() {}
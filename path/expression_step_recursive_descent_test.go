@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpressionStepRecursiveDescentEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepRecursiveDescent
+		other    path.ExpressionStep
+		expected bool
+	}{
+		"ExpressionStepAttributeNameExact": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			other:    path.ExpressionStepAttributeNameExact("test"),
+			expected: false,
+		},
+		"ExpressionStepElementKeyIntExact": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			other:    path.ExpressionStepElementKeyIntExact(0),
+			expected: false,
+		},
+		"ExpressionStepElementKeyValueExact": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			other:    path.ExpressionStepElementKeyValueExact{Value: types.StringValue("test")},
+			expected: false,
+		},
+		"ExpressionStepRecursiveDescent-different": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			other:    path.ExpressionStepRecursiveDescent("not-test"),
+			expected: false,
+		},
+		"ExpressionStepRecursiveDescent-equal": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			other:    path.ExpressionStepRecursiveDescent("test"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionStepRecursiveDescentMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepRecursiveDescent
+		pathStep path.PathStep
+		expected bool
+	}{
+		"StepAttributeName-different": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			pathStep: path.PathStepAttributeName("not-test"),
+			expected: false,
+		},
+		"StepAttributeName-equal": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			pathStep: path.PathStepAttributeName("test"),
+			expected: true,
+		},
+		"StepElementKeyInt": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			pathStep: path.PathStepElementKeyInt(0),
+			expected: false,
+		},
+		"StepElementKeyString": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			pathStep: path.PathStepElementKeyString("test"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Matches(testCase.pathStep)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpressionStepRecursiveDescentString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepRecursiveDescent
+		expected string
+	}{
+		"basic": {
+			step:     path.ExpressionStepRecursiveDescent("test"),
+			expected: "..test",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@
 
 package path
 
-import "strings"
+import (
+	"context"
+	"strings"
+)
 
 // ExpressionSteps represents an ordered collection of attribute path
 // expressions.
@@ -65,26 +68,11 @@ func (s ExpressionSteps) LastStep() (ExpressionStep, ExpressionSteps) {
 
 // Matches returns true if the given PathSteps match each ExpressionStep.
 //
-// Any ExpressionStepParent will automatically be resolved.
+// Any ExpressionStepParent will automatically be resolved. Any
+// ExpressionStepElementPredicate is only matched against list and map
+// elements structurally, since PathSteps do not include element values.
 func (s ExpressionSteps) Matches(pathSteps PathSteps) bool {
-	resolvedExpressionSteps := s.Resolve()
-
-	// Empty expression should not match anything to prevent false positives.
-	if len(resolvedExpressionSteps) == 0 {
-		return false
-	}
-
-	if len(resolvedExpressionSteps) != len(pathSteps) {
-		return false
-	}
-
-	for stepIndex, expressionStep := range resolvedExpressionSteps {
-		if !expressionStep.Matches(pathSteps[stepIndex]) {
-			return false
-		}
-	}
-
-	return true
+	return s.Resolve().matches(pathSteps, false, nil)
 }
 
 // MatchesParent returns true if the given PathSteps match each ExpressionStep
@@ -94,28 +82,75 @@ func (s ExpressionSteps) Matches(pathSteps PathSteps) bool {
 //
 // Any ExpressionStepParent will automatically be resolved.
 func (s ExpressionSteps) MatchesParent(pathSteps PathSteps) bool {
-	resolvedExpressionSteps := s.Resolve()
+	return s.Resolve().matches(pathSteps, true, nil)
+}
 
+// matches returns true if the given PathSteps match the resolved
+// ExpressionSteps. If parent is true, it instead returns true if the
+// PathSteps could be a parent of matching PathSteps. If valueAt is not nil,
+// it is used to match ExpressionStepElementPredicate against element values.
+func (s ExpressionSteps) matches(pathSteps PathSteps, parent bool, valueAt ValueAtPathFunc) bool {
 	// Empty expression should not match anything to prevent false positives.
-	// Ensure to not return false on an empty path since walking a path always
-	// starts with no steps.
-	if len(resolvedExpressionSteps) == 0 {
+	// Ensure to not return false on an empty path for parents since walking
+	// a path always starts with no steps.
+	if len(s) == 0 {
 		return false
 	}
 
-	// Path steps deeper than or equal to the expression steps should not match
-	// as a potential parent.
-	if len(pathSteps) >= len(resolvedExpressionSteps) {
-		return false
+	return s.matchesFrom(pathSteps, 0, 0, parent, valueAt)
+}
+
+// matchesFrom implements matches starting at the given expression and path
+// step indices, backtracking over the path steps which may be matched by any
+// ExpressionStepRecursiveDescent.
+func (s ExpressionSteps) matchesFrom(pathSteps PathSteps, stepIndex int, pathStepIndex int, parent bool, valueAt ValueAtPathFunc) bool {
+	if stepIndex == len(s) {
+		// Path steps deeper than or equal to the expression steps should
+		// not match as a potential parent.
+		return !parent && pathStepIndex == len(pathSteps)
+	}
+
+	if pathStepIndex == len(pathSteps) {
+		return parent
 	}
 
-	for stepIndex, pathStep := range pathSteps {
-		if !resolvedExpressionSteps[stepIndex].Matches(pathStep) {
+	switch step := s[stepIndex].(type) {
+	case ExpressionStepRecursiveDescent:
+		if step.Matches(pathSteps[pathStepIndex]) && s.matchesFrom(pathSteps, stepIndex+1, pathStepIndex+1, parent, valueAt) {
+			return true
+		}
+
+		// Otherwise the path step is one of the zero or more steps before
+		// the attribute name.
+		return s.matchesFrom(pathSteps, stepIndex, pathStepIndex+1, parent, valueAt)
+	case ExpressionStepElementPredicate:
+		switch pathSteps[pathStepIndex].(type) {
+		case PathStepElementKeyInt, PathStepElementKeyString:
+			// List and map element path steps do not contain the element
+			// value, so the predicate can only be checked against values.
+			if valueAt == nil {
+				return false
+			}
+
+			elementPath := Path{
+				steps: pathSteps[:pathStepIndex+1].Copy(),
+			}
+
+			if !step.MatchesElement(context.Background(), valueAt(elementPath)) {
+				return false
+			}
+		default:
+			if !step.Matches(pathSteps[pathStepIndex]) {
+				return false
+			}
+		}
+	default:
+		if !step.Matches(pathSteps[pathStepIndex]) {
 			return false
 		}
 	}
 
-	return true
+	return s.matchesFrom(pathSteps, stepIndex+1, pathStepIndex+1, parent, valueAt)
 }
 
 // NextStep returns the first ExpressionStep and the remaining ExpressionSteps.
@@ -649,6 +649,84 @@ func TestExpressionStepsMatches(t *testing.T) {
 			},
 			expected: false,
 		},
+		"RecursiveDescent-match-root": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test"),
+			},
+			expected: true,
+		},
+		"RecursiveDescent-match-deep": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test1"),
+				path.PathStepElementKeyInt(0),
+				path.PathStepAttributeName("test"),
+			},
+			expected: true,
+		},
+		"RecursiveDescent-match-repeated-name": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test"),
+				path.PathStepAttributeName("test"),
+			},
+			expected: true,
+		},
+		"RecursiveDescent-mismatch-deeper": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test"),
+				path.PathStepAttributeName("test1"),
+			},
+			expected: false,
+		},
+		"AttributeNameExact-RecursiveDescent-ElementKeyIntAny-match": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test1"),
+				path.ExpressionStepRecursiveDescent("test"),
+				path.ExpressionStepElementKeyIntAny{},
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test1"),
+				path.PathStepAttributeName("test2"),
+				path.PathStepAttributeName("test"),
+				path.PathStepElementKeyInt(1),
+			},
+			expected: true,
+		},
+		"AttributeNameExact-RecursiveDescent-mismatch-root": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test1"),
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test2"),
+				path.PathStepAttributeName("test"),
+			},
+			expected: false,
+		},
+		"AttributeNameExact-ElementPredicate-AttributeNameExact": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test1"),
+				path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+				path.ExpressionStepAttributeNameExact("test2"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test1"),
+				path.PathStepElementKeyInt(0),
+				path.PathStepAttributeName("test2"),
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1065,6 +1143,53 @@ func TestExpressionStepsMatchesParent(t *testing.T) {
 			},
 			expected: false,
 		},
+		"RecursiveDescent-empty": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{},
+			expected:  true,
+		},
+		"RecursiveDescent-deep": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test1"),
+				path.PathStepElementKeyInt(0),
+				path.PathStepAttributeName("test2"),
+			},
+			expected: true,
+		},
+		"RecursiveDescent-matched": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test"),
+			},
+			expected: true,
+		},
+		"RecursiveDescent-AttributeNameExact-matched-descent": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test1"),
+				path.ExpressionStepAttributeNameExact("test2"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test1"),
+			},
+			expected: true,
+		},
+		"AttributeNameExact-RecursiveDescent-mismatch-root": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test1"),
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test2"),
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1391,6 +1516,28 @@ func TestExpressionStepsString(t *testing.T) {
 			},
 			expected: `[Value("test")]`,
 		},
+		"RecursiveDescent": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepRecursiveDescent("test"),
+			},
+			expected: `..test`,
+		},
+		"AttributeName-RecursiveDescent-AttributeName": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test1"),
+				path.ExpressionStepRecursiveDescent("test2"),
+				path.ExpressionStepAttributeNameExact("test3"),
+			},
+			expected: `test1..test2.test3`,
+		},
+		"AttributeName-ElementPredicate-AttributeName": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test1"),
+				path.ExpressionStepElementPredicate{AttributeName: "test", Value: types.StringValue("test")},
+				path.ExpressionStepAttributeNameExact("test2"),
+			},
+			expected: `test1[?test=="test"].test2`,
+		},
 	}
 
 	for name, testCase := range testCases {
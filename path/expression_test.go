@@ -108,6 +108,44 @@ func TestExpressionAtAnySetValue(t *testing.T) {
 	}
 }
 
+func TestExpressionAtElementWhere(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression    path.Expression
+		attributeName string
+		value         attr.Value
+		expected      path.Expression
+	}{
+		"shallow": {
+			expression:    path.MatchRoot("test"),
+			attributeName: "test",
+			value:         types.StringValue("test"),
+			expected:      path.MatchRoot("test").AtElementWhere("test", types.StringValue("test")),
+		},
+		"deep": {
+			expression:    path.MatchRoot("test1").AtListIndex(0).AtName("test2"),
+			attributeName: "test",
+			value:         types.Int64Value(1),
+			expected:      path.MatchRoot("test1").AtListIndex(0).AtName("test2").AtElementWhere("test", types.Int64Value(1)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.expression.AtElementWhere(testCase.attributeName, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpressionAtListIndex(t *testing.T) {
 	t.Parallel()
 
@@ -245,6 +283,41 @@ func TestExpressionAtParent(t *testing.T) {
 	}
 }
 
+func TestExpressionAtRecursiveDescent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		name       string
+		expected   path.Expression
+	}{
+		"relative": {
+			expression: path.MatchRelative(),
+			name:       "test",
+			expected:   path.MatchRelative().AtRecursiveDescent("test"),
+		},
+		"deep": {
+			expression: path.MatchRoot("test1").AtListIndex(0),
+			name:       "test2",
+			expected:   path.MatchRoot("test1").AtListIndex(0).AtRecursiveDescent("test2"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.expression.AtRecursiveDescent(testCase.name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpressionAtSetValue(t *testing.T) {
 	t.Parallel()
 
@@ -490,6 +563,32 @@ func TestExpressionMatches(t *testing.T) {
 			path:       path.Root("test"),
 			expected:   false,
 		},
+		"ElementPredicate-ElementKeyInt": {
+			expression: path.MatchRoot("test").AtElementWhere("type", types.StringValue("a")),
+			path:       path.Root("test").AtListIndex(0),
+			expected:   false,
+		},
+		"ElementPredicate-ElementKeyString": {
+			expression: path.MatchRoot("test").AtElementWhere("type", types.StringValue("a")),
+			path:       path.Root("test").AtMapKey("b"),
+			expected:   false,
+		},
+		"ElementPredicate-ElementKeyValue-match": {
+			expression: path.MatchRoot("test").AtElementWhere("type", types.StringValue("a")),
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(
+				map[string]attr.Type{"type": types.StringType},
+				map[string]attr.Value{"type": types.StringValue("a")},
+			)),
+			expected: true,
+		},
+		"ElementPredicate-ElementKeyValue-mismatch": {
+			expression: path.MatchRoot("test").AtElementWhere("type", types.StringValue("a")),
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(
+				map[string]attr.Type{"type": types.StringType},
+				map[string]attr.Value{"type": types.StringValue("b")},
+			)),
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -712,6 +811,90 @@ func TestExpressionMatchesParent(t *testing.T) {
 	}
 }
 
+func TestExpressionMatchesWithValues(t *testing.T) {
+	t.Parallel()
+
+	elementType := map[string]attr.Type{
+		"test": types.StringType,
+	}
+
+	values := map[string]attr.Value{
+		`test[0]`:     types.ObjectValueMust(elementType, map[string]attr.Value{"test": types.StringValue("test-value")}),
+		`test[1]`:     types.ObjectValueMust(elementType, map[string]attr.Value{"test": types.StringValue("other-value")}),
+		`test["key"]`: types.ObjectValueMust(elementType, map[string]attr.Value{"test": types.StringValue("test-value")}),
+	}
+
+	valueAt := func(p path.Path) attr.Value {
+		return values[p.String()]
+	}
+
+	testCases := map[string]struct {
+		expression     path.Expression
+		path           path.Path
+		expected       bool
+		expectedParent bool
+	}{
+		"ElementPredicate-list-match": {
+			expression: path.MatchRoot("test").AtElementWhere("test", types.StringValue("test-value")),
+			path:       path.Root("test").AtListIndex(0),
+			expected:   true,
+		},
+		"ElementPredicate-list-mismatch": {
+			expression: path.MatchRoot("test").AtElementWhere("test", types.StringValue("test-value")),
+			path:       path.Root("test").AtListIndex(1),
+			expected:   false,
+		},
+		"ElementPredicate-list-missing": {
+			expression: path.MatchRoot("test").AtElementWhere("test", types.StringValue("test-value")),
+			path:       path.Root("test").AtListIndex(2),
+			expected:   false,
+		},
+		"ElementPredicate-map-match": {
+			expression: path.MatchRoot("test").AtElementWhere("test", types.StringValue("test-value")),
+			path:       path.Root("test").AtMapKey("key"),
+			expected:   true,
+		},
+		"ElementPredicate-AttributeNameExact-parent-match": {
+			expression:     path.MatchRoot("test").AtElementWhere("test", types.StringValue("test-value")).AtName("test"),
+			path:           path.Root("test").AtListIndex(0),
+			expected:       false,
+			expectedParent: true,
+		},
+		"ElementPredicate-AttributeNameExact-parent-mismatch": {
+			expression:     path.MatchRoot("test").AtElementWhere("test", types.StringValue("test-value")).AtName("test"),
+			path:           path.Root("test").AtListIndex(1),
+			expected:       false,
+			expectedParent: false,
+		},
+		"RecursiveDescent-match": {
+			expression:     path.MatchRecursiveDescent("test"),
+			path:           path.Root("test").AtListIndex(0).AtName("test"),
+			expected:       true,
+			expectedParent: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.expression.MatchesWithValues(testCase.path, valueAt)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			gotParent := testCase.expression.MatchesParentWithValues(testCase.path, valueAt)
+
+			if diff := cmp.Diff(gotParent, testCase.expectedParent); diff != "" {
+				t.Errorf("unexpected parent difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpressionMerge(t *testing.T) {
 	t.Parallel()
 
@@ -1008,6 +1191,14 @@ func TestExpressionString(t *testing.T) {
 			)).AtName("test_attr_1"),
 			expected: `test[Value({"test_attr_1":true,"test_attr_2":"test-value"})].test_attr_1`,
 		},
+		"RecursiveDescent": {
+			expression: path.MatchRecursiveDescent("test"),
+			expected:   `..test`,
+		},
+		"AttributeNameExact-ElementPredicate": {
+			expression: path.MatchRoot("test").AtElementWhere("test", types.StringValue("test")),
+			expected:   `test[?test=="test"]`,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1024,3 +1215,21 @@ func TestExpressionString(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchRecursiveDescent(t *testing.T) {
+	t.Parallel()
+
+	got := path.MatchRecursiveDescent("test")
+	expected := path.MatchRelative().AtRecursiveDescent("test")
+
+	if diff := cmp.Diff(got.Steps(), expected.Steps()); diff != "" {
+		t.Errorf("unexpected steps difference: %s", diff)
+	}
+
+	// Root expressions overwrite the steps of merged expressions.
+	merged := path.MatchRoot("other").Merge(got)
+
+	if !merged.Equal(got) {
+		t.Errorf("expected root expression, got: %s", merged)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Ensure literalValue satisfies the attr.Value interface.
+var _ attr.Value = literalValue{}
+
+// Ensure literalType satisfies the attr.Type interface.
+var _ attr.Type = literalType{}
+
+// literalValue is a set element or element predicate value parsed from a
+// string by ParsePath or ParseExpression. The path package cannot depend on
+// the types package, so literal values only carry their Terraform value and
+// are considered equal to any attr.Value with the same Terraform value. Path
+// and expression steps use valuesEqual, so this also applies when the literal
+// value is the argument of the step Equal method.
+type literalValue struct {
+	// raw is the literal as it was written, which is used for String.
+	raw string
+
+	// value is the Terraform value of the literal.
+	value tftypes.Value
+}
+
+// Equal returns true if the given value has the same Terraform value.
+func (v literalValue) Equal(o attr.Value) bool {
+	if o == nil {
+		return false
+	}
+
+	other, err := o.ToTerraformValue(context.Background())
+
+	if err != nil {
+		return false
+	}
+
+	return v.equalTerraformValue(other)
+}
+
+// equalTerraformValue returns true if the given Terraform value is equal to
+// the literal. Number literals are parsed with 512 bit precision, so they are
+// rounded to the precision of the given number first, which makes 0.100000
+// equal to a float64 0.1.
+func (v literalValue) equalTerraformValue(other tftypes.Value) bool {
+	if !v.value.Type().Is(tftypes.Number) || other.Type() == nil || !other.Type().Is(tftypes.Number) || !other.IsKnown() || other.IsNull() {
+		return v.value.Equal(other)
+	}
+
+	var literal, number *big.Float
+
+	if err := v.value.As(&literal); err != nil {
+		return false
+	}
+
+	if err := other.As(&number); err != nil {
+		return false
+	}
+
+	if number.Prec() > 0 && number.Prec() < literal.Prec() {
+		literal = new(big.Float).SetPrec(number.Prec()).Set(literal)
+	}
+
+	return literal.Cmp(number) == 0
+}
+
+// valuesEqual returns true if the given values are equal, calling the Equal
+// method of a literalValue on either side, so parsed steps are equal to the
+// steps they were written from regardless of the order of comparison.
+func valuesEqual(a attr.Value, b attr.Value) bool {
+	if literal, ok := b.(literalValue); ok {
+		return literal.Equal(a)
+	}
+
+	return a.Equal(b)
+}
+
+// IsNull returns false, as literal values are always known and not null.
+func (v literalValue) IsNull() bool {
+	return false
+}
+
+// IsUnknown returns false, as literal values are always known and not null.
+func (v literalValue) IsUnknown() bool {
+	return false
+}
+
+// String returns the literal as it was written.
+func (v literalValue) String() string {
+	return v.raw
+}
+
+// ToTerraformValue returns the Terraform value of the literal.
+func (v literalValue) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return v.value, nil
+}
+
+// Type returns a literalType with the Terraform type of the literal.
+func (v literalValue) Type(_ context.Context) attr.Type {
+	return literalType{typ: v.value.Type()}
+}
+
+// literalType is the attr.Type of a literalValue.
+type literalType struct {
+	typ tftypes.Type
+}
+
+// ApplyTerraform5AttributePathStep always returns an error, as literal types
+// are only primitives.
+func (t literalType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is a literalType with the same
+// Terraform type.
+func (t literalType) Equal(o attr.Type) bool {
+	other, ok := o.(literalType)
+
+	if !ok {
+		return false
+	}
+
+	return t.typ.Equal(other.typ)
+}
+
+// String returns a human-readable representation of the type.
+func (t literalType) String() string {
+	return "path.literalType[" + t.typ.String() + "]"
+}
+
+// TerraformType returns the Terraform type of the literal.
+func (t literalType) TerraformType(_ context.Context) tftypes.Type {
+	return t.typ
+}
+
+// ValueFromTerraform returns a literalValue for the given Terraform value.
+func (t literalType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	return literalValue{raw: in.String(), value: in}, nil
+}
+
+// ValueType returns the attr.Value type of the literal type.
+func (t literalType) ValueType(_ context.Context) attr.Value {
+	return literalValue{}
+}
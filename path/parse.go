@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ParsePath returns the Path represented by the given string, which uses the
+// same syntax as the String() method of Path. For example:
+//
+//   - some_attribute
+//   - some_attribute[0].nested_attribute
+//   - some_attribute["map key"]
+//   - some_attribute[Value("set value")]
+//
+// Map keys are double quoted strings using Go string literal escaping. Set
+// values must be string, number, or bool literals. Since the path package
+// cannot depend on the types package, parsed set values are untyped and are
+// compared by their Terraform value, so a parsed number such as 1 is equal to
+// any number value with the same Terraform value, such as types.Int64,
+// types.Float64, or types.Number. Number literals are parsed with 512 bit
+// precision, like Terraform numbers, and are rounded to the precision of the
+// value they are compared to, so 0.100000 is equal to types.Float64Value(0.1).
+//
+// An empty string returns an empty path.
+func ParsePath(s string) (Path, error) {
+	p := &parser{input: s, kind: "path"}
+
+	steps, _, err := p.parse()
+
+	if err != nil {
+		return Empty(), err
+	}
+
+	pathSteps := make(PathSteps, 0, len(steps))
+
+	for _, step := range steps {
+		switch step := step.(type) {
+		case ExpressionStepAttributeNameExact:
+			pathSteps.Append(PathStepAttributeName(step))
+		case ExpressionStepElementKeyIntExact:
+			pathSteps.Append(PathStepElementKeyInt(step))
+		case ExpressionStepElementKeyStringExact:
+			pathSteps.Append(PathStepElementKeyString(step))
+		case ExpressionStepElementKeyValueExact:
+			pathSteps.Append(PathStepElementKeyValue(step))
+		default:
+			return Empty(), fmt.Errorf("invalid path %q: %s is only supported in expressions", s, step)
+		}
+	}
+
+	return Path{
+		steps: pathSteps,
+	}, nil
+}
+
+// ParseExpression returns the Expression represented by the given string,
+// which uses the same syntax as the String() method of Expression. In
+// addition to the ParsePath syntax, expressions support:
+//
+//   - [*]: any list element
+//   - ["*"]: any map element
+//   - [Value(*)]: any set element
+//   - <: the parent step, such as <.other_attribute
+//   - ..name: the name attribute at any depth
+//   - [?name=="value"]: any list, map, or set element which is an object
+//     with a name attribute equal to a string, number, or bool literal
+//
+// Expressions starting with an attribute name are root expressions, as
+// created by MatchRoot() or MatchRecursiveDescent(). Expressions starting
+// with any other step, such as a parent step, are relative expressions, as
+// created by MatchRelative(). Since the String() method does not
+// differentiate between root and relative expressions, a relative expression
+// starting with an attribute name is written with a leading period, such as
+// .nested_attribute or ...name.
+//
+// An empty string returns an empty relative expression.
+func ParseExpression(s string) (Expression, error) {
+	p := &parser{input: s, kind: "expression", expression: true}
+
+	steps, root, err := p.parse()
+
+	if err != nil {
+		return MatchRelative(), err
+	}
+
+	return Expression{
+		root:  root,
+		steps: steps,
+	}, nil
+}
+
+// parser implements ParsePath and ParseExpression. Paths are parsed as
+// expression steps, which are converted by ParsePath.
+type parser struct {
+	// input is the string being parsed.
+	input string
+
+	// kind is either "path" or "expression", for error messages.
+	kind string
+
+	// expression enables expression only syntax.
+	expression bool
+
+	// pos is the current byte offset in input.
+	pos int
+}
+
+// parse returns the steps of the input and whether it is a root expression.
+func (p *parser) parse() (ExpressionSteps, bool, error) {
+	steps := ExpressionSteps{}
+	relative := false
+
+	if p.expression && strings.HasPrefix(p.input, ".") && (!strings.HasPrefix(p.input, "..") || strings.HasPrefix(p.input, "...")) {
+		relative = true
+		p.pos++
+
+		if p.pos == len(p.input) {
+			return nil, false, p.errorf("expected attribute name")
+		}
+	}
+
+	for p.pos < len(p.input) {
+		var step ExpressionStep
+		var err error
+
+		switch {
+		case p.input[p.pos] == '[':
+			step, err = p.parseElementKey()
+		case p.expression && strings.HasPrefix(p.input[p.pos:], ".."):
+			p.pos += len("..")
+			var name string
+			name, err = p.parseAttributeName()
+			step = ExpressionStepRecursiveDescent(name)
+		case p.input[p.pos] == '.' && len(steps) > 0:
+			p.pos++
+			step, err = p.parseName()
+		case len(steps) == 0:
+			step, err = p.parseName()
+		default:
+			err = p.errorf("expected \".\" or \"[\"")
+		}
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		steps.Append(step)
+	}
+
+	if !p.expression || relative || len(steps) == 0 {
+		return steps, false, nil
+	}
+
+	switch steps[0].(type) {
+	case ExpressionStepAttributeNameExact, ExpressionStepRecursiveDescent:
+		return steps, true, nil
+	default:
+		return steps, false, nil
+	}
+}
+
+// parseName parses an attribute name or, in expressions, a parent step.
+func (p *parser) parseName() (ExpressionStep, error) {
+	if p.expression && strings.HasPrefix(p.input[p.pos:], "<") {
+		p.pos++
+
+		return ExpressionStepParent{}, nil
+	}
+
+	name, err := p.parseAttributeName()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ExpressionStepAttributeNameExact(name), nil
+}
+
+// parseAttributeName parses an attribute name.
+func (p *parser) parseAttributeName() (string, error) {
+	start := p.pos
+
+	for p.pos < len(p.input) && isNameByte(p.input[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos == start {
+		return "", p.errorf("expected attribute name")
+	}
+
+	return p.input[start:p.pos], nil
+}
+
+// parseElementKey parses a list index, map key, set value, or element
+// predicate step, starting at the opening bracket.
+func (p *parser) parseElementKey() (ExpressionStep, error) {
+	p.pos++
+
+	rest := p.input[p.pos:]
+
+	switch {
+	case p.expression && strings.HasPrefix(rest, "*]"):
+		p.pos += len("*]")
+
+		return ExpressionStepElementKeyIntAny{}, nil
+	case strings.HasPrefix(rest, `"`):
+		key, err := p.parseQuoted()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+
+		if p.expression && key == "*" {
+			return ExpressionStepElementKeyStringAny{}, nil
+		}
+
+		return ExpressionStepElementKeyStringExact(key), nil
+	case p.expression && strings.HasPrefix(rest, "?"):
+		p.pos++
+
+		attributeName, err := p.parseAttributeName()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect("=="); err != nil {
+			return nil, err
+		}
+
+		value, err := p.parseLiteral("]")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+
+		return ExpressionStepElementPredicate{
+			AttributeName: attributeName,
+			Value:         value,
+		}, nil
+	case strings.HasPrefix(rest, "Value("):
+		p.pos += len("Value(")
+
+		if p.expression && strings.HasPrefix(p.input[p.pos:], "*)]") {
+			p.pos += len("*)]")
+
+			return ExpressionStepElementKeyValueAny{}, nil
+		}
+
+		value, err := p.parseLiteral(")]")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(")]"); err != nil {
+			return nil, err
+		}
+
+		return ExpressionStepElementKeyValueExact{Value: value}, nil
+	}
+
+	start := p.pos
+
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+
+	if p.pos == start {
+		return nil, p.errorf("expected list index, map key, or set value")
+	}
+
+	index, err := strconv.ParseInt(p.input[start:p.pos], 10, 0)
+
+	if err != nil {
+		p.pos = start
+
+		return nil, p.errorf("invalid list index: %s", err)
+	}
+
+	if err := p.expect("]"); err != nil {
+		return nil, err
+	}
+
+	return ExpressionStepElementKeyIntExact(index), nil
+}
+
+// parseQuoted parses a double quoted string with Go string literal escaping.
+func (p *parser) parseQuoted() (string, error) {
+	quoted, err := strconv.QuotedPrefix(p.input[p.pos:])
+
+	if err != nil {
+		return "", p.errorf("invalid quoted string")
+	}
+
+	result, err := strconv.Unquote(quoted)
+
+	if err != nil {
+		return "", p.errorf("invalid quoted string")
+	}
+
+	p.pos += len(quoted)
+
+	return result, nil
+}
+
+// parseLiteral parses a string, number, or bool value, which is followed by
+// the given terminator.
+func (p *parser) parseLiteral(terminator string) (literalValue, error) {
+	start := p.pos
+
+	if strings.HasPrefix(p.input[p.pos:], `"`) {
+		s, err := p.parseQuoted()
+
+		if err != nil {
+			return literalValue{}, err
+		}
+
+		return literalValue{
+			raw:   p.input[start:p.pos],
+			value: tftypes.NewValue(tftypes.String, s),
+		}, nil
+	}
+
+	end := strings.Index(p.input[p.pos:], terminator)
+
+	if end == -1 {
+		return literalValue{}, p.errorf("expected %q", terminator)
+	}
+
+	raw := p.input[p.pos : p.pos+end]
+
+	switch raw {
+	case "true", "false":
+		p.pos += end
+
+		return literalValue{
+			raw:   raw,
+			value: tftypes.NewValue(tftypes.Bool, raw == "true"),
+		}, nil
+	}
+
+	f, _, err := big.ParseFloat(raw, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		return literalValue{}, p.errorf("expected string, number, or bool value")
+	}
+
+	p.pos += end
+
+	return literalValue{
+		raw:   raw,
+		value: tftypes.NewValue(tftypes.Number, f),
+	}, nil
+}
+
+// expect consumes the given string or returns an error.
+func (p *parser) expect(s string) error {
+	if !strings.HasPrefix(p.input[p.pos:], s) {
+		return p.errorf("expected %q", s)
+	}
+
+	p.pos += len(s)
+
+	return nil
+}
+
+// errorf returns an error which includes the input and current offset.
+func (p *parser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("invalid %s %q at offset %d: %s", p.kind, p.input, p.pos, fmt.Sprintf(format, a...))
+}
+
+// isNameByte returns true if the byte can be used in an attribute name.
+func isNameByte(b byte) bool {
+	return b == '_' || b == '-' || (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
@@ -0,0 +1,474 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      path.Path
+		expectedError error
+	}{
+		"empty": {
+			input:    "",
+			expected: path.Empty(),
+		},
+		"root": {
+			input:    "test",
+			expected: path.Root("test"),
+		},
+		"attribute-names": {
+			input:    "test1.test_2.test-3",
+			expected: path.Root("test1").AtName("test_2").AtName("test-3"),
+		},
+		"list-index": {
+			input:    "test[0][12]",
+			expected: path.Root("test").AtListIndex(0).AtListIndex(12),
+		},
+		"list-index-leading": {
+			input:    "[1].test",
+			expected: path.Empty().AtListIndex(1).AtName("test"),
+		},
+		"map-key": {
+			input:    `test["key"]`,
+			expected: path.Root("test").AtMapKey("key"),
+		},
+		"map-key-asterisk": {
+			input:    `test["*"]`,
+			expected: path.Root("test").AtMapKey("*"),
+		},
+		"map-key-escaped": {
+			input:    `test["a \"quoted\" ]key\n"].nested`,
+			expected: path.Root("test").AtMapKey("a \"quoted\" ]key\n").AtName("nested"),
+		},
+		"set-value-string": {
+			input:    `test[Value("value")]`,
+			expected: path.Root("test").AtSetValue(types.StringValue("value")),
+		},
+		"set-value-string-parentheses": {
+			input:    `test[Value("a)]b")].nested`,
+			expected: path.Root("test").AtSetValue(types.StringValue("a)]b")).AtName("nested"),
+		},
+		"set-value-bool": {
+			input:    "test[Value(true)]",
+			expected: path.Root("test").AtSetValue(types.BoolValue(true)),
+		},
+		"set-value-int64": {
+			input:    "test[Value(-12)]",
+			expected: path.Root("test").AtSetValue(types.Int64Value(-12)),
+		},
+		"set-value-float64": {
+			input:    "test[Value(1.500000)]",
+			expected: path.Root("test").AtSetValue(types.Float64Value(1.5)),
+		},
+		"set-value-number": {
+			input:    "test[Value(1.5e+10)]",
+			expected: path.Root("test").AtSetValue(types.NumberValue(big.NewFloat(1.5e10))),
+		},
+		"error-any-list-index": {
+			input:         "test[*]",
+			expectedError: fmt.Errorf(`invalid path "test[*]" at offset 5: expected list index, map key, or set value`),
+		},
+		"error-any-set-value": {
+			input:         "test[Value(*)]",
+			expectedError: fmt.Errorf(`invalid path "test[Value(*)]" at offset 11: expected string, number, or bool value`),
+		},
+		"error-parent": {
+			input:         "test.<",
+			expectedError: fmt.Errorf(`invalid path "test.<" at offset 5: expected attribute name`),
+		},
+		"error-trailing-period": {
+			input:         "test.",
+			expectedError: fmt.Errorf(`invalid path "test." at offset 5: expected attribute name`),
+		},
+		"error-invalid-character": {
+			input:         "test/other",
+			expectedError: fmt.Errorf(`invalid path "test/other" at offset 4: expected "." or "["`),
+		},
+		"error-unclosed-list-index": {
+			input:         "test[0",
+			expectedError: fmt.Errorf(`invalid path "test[0" at offset 6: expected "]"`),
+		},
+		"error-list-index-overflow": {
+			input:         "test[99999999999999999999]",
+			expectedError: fmt.Errorf(`invalid path "test[99999999999999999999]" at offset 5: invalid list index: strconv.ParseInt: parsing "99999999999999999999": value out of range`),
+		},
+		"error-unclosed-map-key": {
+			input:         `test["key]`,
+			expectedError: fmt.Errorf(`invalid path "test[\"key]" at offset 5: invalid quoted string`),
+		},
+		"error-set-value-object": {
+			input:         `test[Value({"a":"b"})]`,
+			expectedError: fmt.Errorf(`invalid path "test[Value({\"a\":\"b\"})]" at offset 11: expected string, number, or bool value`),
+		},
+		"error-recursive-descent": {
+			input:         "test..test",
+			expectedError: fmt.Errorf(`invalid path "test..test" at offset 5: expected attribute name`),
+		},
+		"error-element-predicate": {
+			input:         `test[?type=="example"]`,
+			expectedError: fmt.Errorf(`invalid path "test[?type==\"example\"]" at offset 5: expected list index, map key, or set value`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.ParsePath(testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError.Error()); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if got.String() != testCase.input {
+				t.Errorf("expected string %q, got %q", testCase.input, got.String())
+			}
+		})
+	}
+}
+
+func TestParsePath_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]path.Path{
+		"empty":            path.Empty(),
+		"root":             path.Root("test"),
+		"nested":           path.Root("test1").AtListIndex(0).AtName("test2").AtMapKey("key").AtName("test3"),
+		"tuple-index":      path.Root("test").AtTupleIndex(2),
+		"map-key":          path.Root("test").AtMapKey("\"[.]\"\t"),
+		"set-value":        path.Root("test").AtSetValue(types.StringValue("value")).AtName("nested"),
+		"set-value-bool":   path.Root("test").AtSetValue(types.BoolValue(true)),
+		"set-value-int64":  path.Root("test").AtSetValue(types.Int64Value(-12)),
+		"set-value-float":  path.Root("test").AtSetValue(types.Float64Value(1.5)),
+		"set-value-number": path.Root("test").AtSetValue(types.NumberValue(big.NewFloat(1.5e10))),
+		"set-values": path.Root("test1").AtSetValue(types.Int64Value(1)).
+			AtName("test2").AtSetValue(types.BoolValue(false)),
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.ParsePath(testCase.String())
+
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %s", testCase.String(), err)
+			}
+
+			if !got.Equal(testCase) {
+				t.Errorf("expected %s, got %s", testCase, got)
+			}
+
+			if !testCase.Equal(got) {
+				t.Errorf("expected %s to equal parsed %s", testCase, got)
+			}
+
+			if got.String() != testCase.String() {
+				t.Errorf("expected string %q, got %q", testCase.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParseExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      path.Expression
+		expectedError error
+	}{
+		"empty": {
+			input:    "",
+			expected: path.MatchRelative(),
+		},
+		"root": {
+			input:    "test",
+			expected: path.MatchRoot("test"),
+		},
+		"root-nested": {
+			input:    `test1[0]["key"][Value("value")].test2`,
+			expected: path.MatchRoot("test1").AtListIndex(0).AtMapKey("key").AtSetValue(types.StringValue("value")).AtName("test2"),
+		},
+		"any-list-index": {
+			input:    "test[*].nested",
+			expected: path.MatchRoot("test").AtAnyListIndex().AtName("nested"),
+		},
+		"any-map-key": {
+			input:    `test["*"]`,
+			expected: path.MatchRoot("test").AtAnyMapKey(),
+		},
+		"any-set-value": {
+			input:    "test[Value(*)]",
+			expected: path.MatchRoot("test").AtAnySetValue(),
+		},
+		"relative-parent": {
+			input:    "<.test",
+			expected: path.MatchRelative().AtParent().AtName("test"),
+		},
+		"relative-parents": {
+			input:    "<.<.test[*]",
+			expected: path.MatchRelative().AtParent().AtParent().AtName("test").AtAnyListIndex(),
+		},
+		"relative-element-key": {
+			input:    "[0].test",
+			expected: path.MatchRelative().AtListIndex(0).AtName("test"),
+		},
+		"relative-leading-period": {
+			input:    ".test.<",
+			expected: path.MatchRelative().AtName("test").AtParent(),
+		},
+		"relative-leading-period-names": {
+			input:    ".test1.test2",
+			expected: path.MatchRelative().AtName("test1").AtName("test2"),
+		},
+		"root-parent": {
+			input:    "test1.<.test2",
+			expected: path.MatchRoot("test1").AtParent().AtName("test2"),
+		},
+		"error-leading-period-only": {
+			input:         ".",
+			expectedError: fmt.Errorf(`invalid expression "." at offset 1: expected attribute name`),
+		},
+		"error-parent-without-period": {
+			input:         "test<",
+			expectedError: fmt.Errorf(`invalid expression "test<" at offset 4: expected "." or "["`),
+		},
+		"error-unclosed-any-set-value": {
+			input:         "test[Value(*",
+			expectedError: fmt.Errorf(`invalid expression "test[Value(*" at offset 11: expected ")]"`),
+		},
+		"recursive-descent": {
+			input:    "..test",
+			expected: path.MatchRecursiveDescent("test"),
+		},
+		"recursive-descent-nested": {
+			input:    "test1[*]..test2.test3",
+			expected: path.MatchRoot("test1").AtAnyListIndex().AtRecursiveDescent("test2").AtName("test3"),
+		},
+		"recursive-descent-relative": {
+			input:    "...test",
+			expected: path.MatchRelative().AtRecursiveDescent("test"),
+		},
+		"element-predicate-string": {
+			input:    `test[?type=="a]b"].name`,
+			expected: path.MatchRoot("test").AtElementWhere("type", types.StringValue("a]b")).AtName("name"),
+		},
+		"element-predicate-number": {
+			input:    "test[?count==2]",
+			expected: path.MatchRoot("test").AtElementWhere("count", types.Int64Value(2)),
+		},
+		"element-predicate-bool": {
+			input:    "test[?enabled==true]",
+			expected: path.MatchRoot("test").AtElementWhere("enabled", types.BoolValue(true)),
+		},
+		"error-recursive-descent-parent": {
+			input:         "test..<",
+			expectedError: fmt.Errorf(`invalid expression "test..<" at offset 6: expected attribute name`),
+		},
+		"error-element-predicate-operator": {
+			input:         "test[?type!=1]",
+			expectedError: fmt.Errorf(`invalid expression "test[?type!=1]" at offset 10: expected "=="`),
+		},
+		"error-element-predicate-unclosed": {
+			input:         "test[?type==1",
+			expectedError: fmt.Errorf(`invalid expression "test[?type==1" at offset 12: expected "]"`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.ParseExpression(testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError.Error()); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseExpression_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]path.Expression{
+		"root":               path.MatchRoot("test"),
+		"any-list-index":     path.MatchRoot("test").AtAnyListIndex().AtName("nested"),
+		"any-map-key":        path.MatchRoot("test").AtAnyMapKey().AtMapKey("key"),
+		"any-set-value":      path.MatchRoot("test").AtAnySetValue().AtSetValue(types.Float64Value(1.25)),
+		"relative":           path.MatchRelative(),
+		"relative-parent":    path.MatchRelative().AtParent().AtParent().AtName("test").AtListIndex(1),
+		"merged":             path.MatchRoot("test1").AtListIndex(0).Merge(path.MatchRelative().AtParent().AtName("test2")),
+		"resolved":           path.MatchRoot("test1").AtAnyMapKey().AtName("test2").AtParent().AtName("test3").Resolve(),
+		"recursive-descent":  path.MatchRoot("test1").AtRecursiveDescent("test2").AtAnyMapKey(),
+		"element-predicate":  path.MatchRoot("test").AtElementWhere("type", types.StringValue("example")).AtName("name"),
+		"recursive-descents": path.MatchRecursiveDescent("test1").AtRecursiveDescent("test2"),
+		"list-index":         path.MatchRoot("test").AtListIndex(3),
+		"set-value-string":   path.MatchRoot("test").AtSetValue(types.StringValue("value")),
+		"set-value-bool":     path.MatchRoot("test").AtSetValue(types.BoolValue(true)),
+		"set-value-int64":    path.MatchRoot("test").AtSetValue(types.Int64Value(-12)),
+		"set-value-number":   path.MatchRoot("test").AtSetValue(types.NumberValue(big.NewFloat(1.5e10))),
+		"predicate-bool":     path.MatchRoot("test").AtElementWhere("enabled", types.BoolValue(false)),
+		"predicate-int64":    path.MatchRoot("test").AtElementWhere("port", types.Int64Value(80)),
+		"set-value-float64":  path.MatchRoot("test").AtSetValue(types.Float64Value(0.1)),
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.ParseExpression(testCase.String())
+
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %s", testCase.String(), err)
+			}
+
+			if !got.Equal(testCase) {
+				t.Errorf("expected %s, got %s", testCase, got)
+			}
+
+			if !testCase.Equal(got) {
+				t.Errorf("expected %s to equal parsed %s", testCase, got)
+			}
+
+			if got.String() != testCase.String() {
+				t.Errorf("expected string %q, got %q", testCase.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestParseExpression_matches(t *testing.T) {
+	t.Parallel()
+
+	expression, err := path.ParseExpression(`test[*]["key"][Value("value")]`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !expression.Matches(path.Root("test").AtListIndex(3).AtMapKey("key").AtSetValue(types.StringValue("value"))) {
+		t.Errorf("expected parsed expression to match path")
+	}
+
+	if expression.Matches(path.Root("test").AtListIndex(3).AtMapKey("key").AtSetValue(types.StringValue("other"))) {
+		t.Errorf("expected parsed expression to not match path with other set value")
+	}
+}
+
+func TestParseExpression_matchesNumbers(t *testing.T) {
+	t.Parallel()
+
+	expression, err := path.ParseExpression(`test[Value(1)]`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected bool
+	}{
+		"float64": {
+			path:     path.Root("test").AtSetValue(types.Float64Value(1)),
+			expected: true,
+		},
+		"int64": {
+			path:     path.Root("test").AtSetValue(types.Int64Value(1)),
+			expected: true,
+		},
+		"number": {
+			path:     path.Root("test").AtSetValue(types.NumberValue(big.NewFloat(1))),
+			expected: true,
+		},
+		"number-other": {
+			path:     path.Root("test").AtSetValue(types.NumberValue(big.NewFloat(2))),
+			expected: false,
+		},
+		"string": {
+			path:     path.Root("test").AtSetValue(types.StringValue("1")),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := expression.Matches(testCase.path); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			parsed, err := path.ParsePath(`test[Value(1)]`)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := testCase.path.Equal(parsed); got != testCase.expected {
+				t.Errorf("expected %t from Equal, got %t", testCase.expected, got)
+			}
+
+			if got := parsed.Equal(testCase.path); got != testCase.expected {
+				t.Errorf("expected %t from parsed Equal, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -32,7 +32,7 @@ func (s PathStepElementKeyValue) Equal(o PathStep) bool {
 		return false
 	}
 
-	return s.Value.Equal(other.Value)
+	return valuesEqual(s.Value, other.Value)
 }
 
 // ExpressionStep returns the ExpressionStep for the PathStep.
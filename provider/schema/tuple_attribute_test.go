@@ -0,0 +1,503 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTupleAttributeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute     schema.TupleAttribute
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			attribute:     schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			step:          tftypes.AttributeName("test"),
+			expected:      nil,
+			expectedError: fmt.Errorf("cannot apply step tftypes.AttributeName to TupleType"),
+		},
+		"ElementKeyInt": {
+			attribute:     schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			step:          tftypes.ElementKeyInt(0),
+			expected:      types.StringType,
+			expectedError: nil,
+		},
+		"ElementKeyInt-missing": {
+			attribute:     schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			step:          tftypes.ElementKeyInt(1),
+			expected:      nil,
+			expectedError: fmt.Errorf("no element defined at index 1 in TupleType"),
+		},
+		"ElementKeyString": {
+			attribute:     schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			step:          tftypes.ElementKeyString("test"),
+			expected:      nil,
+			expectedError: fmt.Errorf("cannot apply step tftypes.ElementKeyString to TupleType"),
+		},
+		"ElementKeyValue": {
+			attribute:     schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			step:          tftypes.ElementKeyValue(tftypes.NewValue(tftypes.String, "test")),
+			expected:      nil,
+			expectedError: fmt.Errorf("cannot apply step tftypes.ElementKeyValue to TupleType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.attribute.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeGetDeprecationMessage(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  string
+	}{
+		"no-deprecation-message": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  "",
+		},
+		"deprecation-message": {
+			attribute: schema.TupleAttribute{
+				DeprecationMessage: "test deprecation message",
+			},
+			expected: "test deprecation message",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetDeprecationMessage()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		other     fwschema.Attribute
+		expected  bool
+	}{
+		"different-type": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			other:     testschema.AttributeWithTupleValidators{},
+			expected:  false,
+		},
+		"different-attribute-type": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			other:     schema.TupleAttribute{ElementTypes: []attr.Type{types.BoolType}},
+			expected:  false,
+		},
+		"equal": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			other:     schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeGetDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  string
+	}{
+		"no-description": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  "",
+		},
+		"description": {
+			attribute: schema.TupleAttribute{
+				Description: "test description",
+			},
+			expected: "test description",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetDescription()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeGetMarkdownDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  string
+	}{
+		"no-markdown-description": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  "",
+		},
+		"markdown-description": {
+			attribute: schema.TupleAttribute{
+				MarkdownDescription: "test description",
+			},
+			expected: "test description",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetMarkdownDescription()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeGetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  attr.Type
+	}{
+		"base": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+		},
+		// "custom-type": {
+		// 	attribute: schema.TupleAttribute{
+		// 		CustomType: testtypes.TupleType{},
+		// 	},
+		// 	expected: testtypes.TupleType{},
+		// },
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeIsComputed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  bool
+	}{
+		"not-computed": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsComputed()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeIsOptional(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  bool
+	}{
+		"not-optional": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  false,
+		},
+		"optional": {
+			attribute: schema.TupleAttribute{
+				Optional: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsOptional()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeIsRequired(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  bool
+	}{
+		"not-required": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  false,
+		},
+		"required": {
+			attribute: schema.TupleAttribute{
+				Required: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsRequired()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeIsSensitive(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  bool
+	}{
+		"not-sensitive": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  false,
+		},
+		"sensitive": {
+			attribute: schema.TupleAttribute{
+				Sensitive: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsSensitive()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeTupleValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		expected  []validator.Tuple
+	}{
+		"no-validators": {
+			attribute: schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}},
+			expected:  nil,
+		},
+		"validators": {
+			attribute: schema.TupleAttribute{
+				Validators: []validator.Tuple{},
+			},
+			expected: []validator.Tuple{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.TupleValidators()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleAttributeValidateImplementation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.TupleAttribute
+		request   fwschema.ValidateImplementationRequest
+		expected  *fwschema.ValidateImplementationResponse
+	}{
+		"elementtypes": {
+			attribute: schema.TupleAttribute{
+				ElementTypes: []attr.Type{
+					types.StringType,
+				},
+				Optional: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"elementtypes-missing": {
+			attribute: schema.TupleAttribute{
+				Optional: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test\" is missing the CustomType or ElementTypes field on a tuple Attribute. "+
+							"One of these fields is required to prevent other unexpected errors or panics.",
+					),
+				},
+			},
+		},
+		"customtype": {
+			attribute: schema.TupleAttribute{
+				Optional:   true,
+				CustomType: testtypes.TupleType{},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &fwschema.ValidateImplementationResponse{}
+			testCase.attribute.ValidateImplementation(context.Background(), testCase.request, got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
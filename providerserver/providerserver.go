@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
 	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
@@ -46,6 +47,21 @@ func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer
 	}
 }
 
+// NewProtocol5WithInterceptors returns a protocol version 5 ProviderServer
+// implementation based on the given Provider, similar to NewProtocol5. The
+// interceptors are called around server operations, as described by
+// interceptor.Interceptor.
+func NewProtocol5WithInterceptors(p provider.Provider, interceptors ...interceptor.Interceptor) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &proto5server.Server{
+			FrameworkServer: fwserver.Server{
+				Interceptors: interceptors,
+				Provider:     p,
+			},
+		}
+	}
+}
+
 // NewProtocol6 returns a protocol version 6 ProviderServer implementation
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server.Serve()
@@ -75,6 +91,21 @@ func NewProtocol6WithError(p provider.Provider) func() (tfprotov6.ProviderServer
 	}
 }
 
+// NewProtocol6WithInterceptors returns a protocol version 6 ProviderServer
+// implementation based on the given Provider, similar to NewProtocol6. The
+// interceptors are called around server operations, as described by
+// interceptor.Interceptor.
+func NewProtocol6WithInterceptors(p provider.Provider, interceptors ...interceptor.Interceptor) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		return &proto6server.Server{
+			FrameworkServer: fwserver.Server{
+				Interceptors: interceptors,
+				Provider:     p,
+			},
+		}
+	}
+}
+
 // Serve serves a provider, blocking until the context is canceled.
 func Serve(ctx context.Context, providerFunc func() provider.Provider, opts ServeOpts) error {
 	err := opts.validate(ctx)
@@ -98,7 +129,8 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 
 				return &proto5server.Server{
 					FrameworkServer: fwserver.Server{
-						Provider: provider,
+						Interceptors: opts.Interceptors,
+						Provider:     provider,
 					},
 				}
 			},
@@ -118,7 +150,8 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 
 				return &proto6server.Server{
 					FrameworkServer: fwserver.Server{
-						Provider: provider,
+						Interceptors: opts.Interceptors,
+						Provider:     provider,
 					},
 				}
 			},
@@ -7,11 +7,53 @@ import (
 	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 )
 
+// testInterceptorProvider returns a provider with a data source and an
+// interceptor which records the requests of its Before method and adds a
+// warning diagnostic in its After method.
+func testInterceptorProvider(requests *[]interceptor.Request) (*testprovider.Provider, interceptor.Interceptor) {
+	provider := &testprovider.Provider{
+		DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+			return []func() datasource.DataSource{
+				func() datasource.DataSource {
+					return &testprovider.DataSource{
+						MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+							resp.TypeName = "test_data_source"
+						},
+						SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+							resp.Schema = schema.Schema{}
+						},
+					}
+				},
+			}
+		},
+	}
+
+	testInterceptor := &testprovider.Interceptor{
+		BeforeMethod: func(_ context.Context, req interceptor.Request, _ *interceptor.BeforeResponse) {
+			*requests = append(*requests, interceptor.Request{
+				Operation: req.Operation,
+				TypeName:  req.TypeName,
+			})
+		},
+		AfterMethod: func(_ context.Context, _ interceptor.Request, resp *interceptor.AfterResponse) {
+			resp.Diagnostics.AddWarning("Intercepted", "")
+		},
+	}
+
+	return provider, testInterceptor
+}
+
 func TestNewProtocol5(t *testing.T) {
 	t.Parallel()
 
@@ -81,3 +123,97 @@ func TestNewProtocol6WithError(t *testing.T) {
 		t.Fatalf("unexpected error calling ProviderServer: %s", err)
 	}
 }
+
+func TestNewProtocol5WithInterceptors(t *testing.T) {
+	t.Parallel()
+
+	var requests []interceptor.Request
+
+	provider, testInterceptor := testInterceptorProvider(&requests)
+
+	providerServer := NewProtocol5WithInterceptors(provider, testInterceptor)()
+
+	config, err := tfprotov5.NewDynamicValue(tftypes.Object{}, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating DynamicValue: %s", err)
+	}
+
+	resp, err := providerServer.ValidateDataSourceConfig(context.Background(), &tfprotov5.ValidateDataSourceConfigRequest{
+		Config:   &config,
+		TypeName: "test_data_source",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	expectedDiagnostics := []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Intercepted",
+		},
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	expectedRequests := []interceptor.Request{
+		{
+			Operation: interceptor.OperationValidateDataSourceConfig,
+			TypeName:  "test_data_source",
+		},
+	}
+
+	if diff := cmp.Diff(requests, expectedRequests); diff != "" {
+		t.Errorf("unexpected requests difference: %s", diff)
+	}
+}
+
+func TestNewProtocol6WithInterceptors(t *testing.T) {
+	t.Parallel()
+
+	var requests []interceptor.Request
+
+	provider, testInterceptor := testInterceptorProvider(&requests)
+
+	providerServer := NewProtocol6WithInterceptors(provider, testInterceptor)()
+
+	config, err := tfprotov6.NewDynamicValue(tftypes.Object{}, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error creating DynamicValue: %s", err)
+	}
+
+	resp, err := providerServer.ValidateDataResourceConfig(context.Background(), &tfprotov6.ValidateDataResourceConfigRequest{
+		Config:   &config,
+		TypeName: "test_data_source",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	expectedDiagnostics := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  "Intercepted",
+		},
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	expectedRequests := []interceptor.Request{
+		{
+			Operation: interceptor.OperationValidateDataSourceConfig,
+			TypeName:  "test_data_source",
+		},
+	}
+
+	if diff := cmp.Diff(requests, expectedRequests); diff != "" {
+		t.Errorf("unexpected requests difference: %s", diff)
+	}
+}
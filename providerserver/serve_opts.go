@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/interceptor"
 )
 
 // ServeOpts are options for serving the provider.
@@ -24,6 +26,12 @@ type ServeOpts struct {
 	// os.Interrupt (Ctrl-c) can be used to stop the provider.
 	Debug bool
 
+	// Interceptors are called around server operations, such as creating a
+	// resource, reading a data source, or calling a function. Before
+	// methods are called in order and After methods in reverse order, as
+	// described by interceptor.Interceptor.
+	Interceptors []interceptor.Interceptor
+
 	// ProtocolVersion is the protocol version that should be used when serving
 	// the provider. Either protocol version 5 or protocol version 6 can be
 	// used. Defaults to protocol version 6.
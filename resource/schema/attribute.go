@@ -10,7 +10,9 @@ import (
 // Attribute define a value field inside the Schema. Implementations in this
 // package include:
 //   - BoolAttribute
+//   - Float32Attribute
 //   - Float64Attribute
+//   - Int32Attribute
 //   - Int64Attribute
 //   - ListAttribute
 //   - MapAttribute
@@ -18,6 +20,7 @@ import (
 //   - ObjectAttribute
 //   - SetAttribute
 //   - StringAttribute
+//   - TupleAttribute
 //
 // Additionally, the NestedAttribute interface extends Attribute with nested
 // attributes. Only supported in protocol version 6. Implementations in this
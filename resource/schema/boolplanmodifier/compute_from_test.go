@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComputeFromModifierPlanModifyBool(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.BoolAttribute{Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Bool) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Bool) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Bool) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	computeFunc := func(ctx context.Context, req boolplanmodifier.ComputeFromFuncRequest, resp *boolplanmodifier.ComputeFromFuncResponse) {
+		if len(req.Paths) != 1 || !req.Paths[0].Equal(path.Root("dependency")) {
+			resp.Diagnostics.AddError("Unexpected Paths", req.Paths.String())
+
+			return
+		}
+
+		if !req.Values[0].Equal(types.StringValue("two")) {
+			resp.Diagnostics.AddError("Unexpected Values", req.Values[0].String())
+
+			return
+		}
+
+		resp.PlanValue = types.BoolValue(false)
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.BoolRequest
+		expected *planmodifier.BoolResponse
+	}{
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.BoolNull(),
+				State:          testState(types.StringValue("one"), types.BoolValue(true)),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolNull(),
+			},
+		},
+		"config-value": {
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolValue(true),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.BoolValue(true)),
+				PlanValue:      types.BoolValue(true),
+				State:          testState(types.StringValue("one"), types.BoolValue(true)),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"plan-known": {
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.BoolValue(true)),
+				PlanValue:      types.BoolValue(true),
+				State:          testState(types.StringValue("one"), types.BoolValue(true)),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.BoolUnknown()),
+				PlanValue:      types.BoolUnknown(),
+				State:          testState(types.StringValue("one"), types.BoolValue(true)),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"computed": {
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.BoolUnknown()),
+				PlanValue:      types.BoolUnknown(),
+				State:          testState(types.StringValue("one"), types.BoolValue(true)),
+				StateValue:     types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(false),
+			},
+		},
+		"computed-state-null": {
+			// resource creation
+			request: planmodifier.BoolRequest{
+				ConfigValue:    types.BoolNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.BoolUnknown()),
+				PlanValue:      types.BoolUnknown(),
+				State:          nullState,
+				StateValue:     types.BoolNull(),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(false),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.BoolResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			boolplanmodifier.ComputeFrom(path.Expressions{path.MatchRoot("dependency")}, computeFunc, "test", "test").PlanModifyBool(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
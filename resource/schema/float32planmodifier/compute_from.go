@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// ComputeFrom returns a plan modifier that sets an unknown planned value to
+// the value returned by the given function, which receives the planned values
+// of all attributes matching the given path expressions. For example, an
+// identifier which is derived from a name and region can be shown in the plan
+// instead of "(known after apply)".
+//
+// Relative path expressions are merged with the path expression of this
+// attribute, so path.MatchRelative().AtParent().AtName("name") refers to a
+// sibling attribute within the same nested object or collection element.
+//
+// The function is not called and the plan is not modified if:
+//
+//   - The resource is planned for destroy.
+//   - The attribute is configured.
+//   - The planned value is already known.
+//   - The planned value of any matching attribute is unknown.
+func ComputeFrom(expressions path.Expressions, f ComputeFromFunc, description, markdownDescription string) planmodifier.Float32 {
+	return computeFromModifier{
+		computeFunc:         f,
+		description:         description,
+		expressions:         expressions,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// computeFromModifier is a plan modifier that sets the planned value of the
+// attribute from the result of a given function.
+type computeFromModifier struct {
+	computeFunc         ComputeFromFunc
+	description         string
+	expressions         path.Expressions
+	markdownDescription string
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m computeFromModifier) Description(_ context.Context) string {
+	return m.description
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m computeFromModifier) MarkdownDescription(_ context.Context) string {
+	return m.markdownDescription
+}
+
+// PlanModifyFloat32 implements the plan modification logic.
+func (m computeFromModifier) PlanModifyFloat32(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+	// Do nothing on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a configuration value.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a known planned value.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	paths, values, diags := fwplanmodifier.DependencyPlanValues(ctx, fwplanmodifier.DependenciesRequest{
+		Expressions:    m.expressions,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		State:          req.State,
+	})
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	// Do nothing if any of the inputs are not yet known.
+	if !fwplanmodifier.ValuesKnown(values) {
+		return
+	}
+
+	computeFuncReq := ComputeFromFuncRequest{
+		Paths:   paths,
+		Request: req,
+		Values:  values,
+	}
+	computeFuncResp := &ComputeFromFuncResponse{
+		PlanValue: req.PlanValue,
+	}
+
+	m.computeFunc(ctx, computeFuncReq, computeFuncResp)
+
+	resp.Diagnostics.Append(computeFuncResp.Diagnostics...)
+
+	if computeFuncResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = computeFuncResp.PlanValue
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComputeFromModifierPlanModifyFloat32(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.Float32Attribute{Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Float32) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Float32) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Float32) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	computeFunc := func(ctx context.Context, req float32planmodifier.ComputeFromFuncRequest, resp *float32planmodifier.ComputeFromFuncResponse) {
+		if len(req.Paths) != 1 || !req.Paths[0].Equal(path.Root("dependency")) {
+			resp.Diagnostics.AddError("Unexpected Paths", req.Paths.String())
+
+			return
+		}
+
+		if !req.Values[0].Equal(types.StringValue("two")) {
+			resp.Diagnostics.AddError("Unexpected Values", req.Values[0].String())
+
+			return
+		}
+
+		resp.PlanValue = types.Float32Value(2.4)
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Float32Request
+		expected *planmodifier.Float32Response
+	}{
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.Float32Request{
+				ConfigValue:    types.Float32Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.Float32Null(),
+				State:          testState(types.StringValue("one"), types.Float32Value(1.2)),
+				StateValue:     types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Null(),
+			},
+		},
+		"config-value": {
+			request: planmodifier.Float32Request{
+				ConfigValue:    types.Float32Value(1.2),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.Float32Value(1.2)),
+				PlanValue:      types.Float32Value(1.2),
+				State:          testState(types.StringValue("one"), types.Float32Value(1.2)),
+				StateValue:     types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"plan-known": {
+			request: planmodifier.Float32Request{
+				ConfigValue:    types.Float32Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.Float32Value(1.2)),
+				PlanValue:      types.Float32Value(1.2),
+				State:          testState(types.StringValue("one"), types.Float32Value(1.2)),
+				StateValue:     types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.Float32Request{
+				ConfigValue:    types.Float32Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.Float32Unknown()),
+				PlanValue:      types.Float32Unknown(),
+				State:          testState(types.StringValue("one"), types.Float32Value(1.2)),
+				StateValue:     types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Unknown(),
+			},
+		},
+		"computed": {
+			request: planmodifier.Float32Request{
+				ConfigValue:    types.Float32Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.Float32Unknown()),
+				PlanValue:      types.Float32Unknown(),
+				State:          testState(types.StringValue("one"), types.Float32Value(1.2)),
+				StateValue:     types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(2.4),
+			},
+		},
+		"computed-state-null": {
+			// resource creation
+			request: planmodifier.Float32Request{
+				ConfigValue:    types.Float32Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.Float32Unknown()),
+				PlanValue:      types.Float32Unknown(),
+				State:          nullState,
+				StateValue:     types.Float32Null(),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(2.4),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float32planmodifier.ComputeFrom(path.Expressions{path.MatchRoot("dependency")}, computeFunc, "test", "test").PlanModifyFloat32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
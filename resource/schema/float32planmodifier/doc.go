@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package float32planmodifier provides plan modifiers for types.Float32 attributes.
+package float32planmodifier
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnknownWhenChangedModifierPlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.Float64Attribute{Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Float64) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Float64) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Float64) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Float64Request
+		expected *planmodifier.Float64Response
+	}{
+		"state-null": {
+			// resource creation
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.Float64Unknown()),
+				PlanValue:      types.Float64Unknown(),
+				State:          nullState,
+				StateValue:     types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.Float64Null(),
+				State:          testState(types.StringValue("one"), types.Float64Value(1.2)),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Null(),
+			},
+		},
+		"config-value": {
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Value(2.4),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.Float64Value(2.4)),
+				PlanValue:      types.Float64Value(2.4),
+				State:          testState(types.StringValue("one"), types.Float64Value(1.2)),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(2.4),
+			},
+		},
+		"dependency-unchanged": {
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.Float64Unknown()),
+				PlanValue:      types.Float64Unknown(),
+				State:          testState(types.StringValue("one"), types.Float64Value(1.2)),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"dependency-unchanged-state-null": {
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.Float64Unknown()),
+				PlanValue:      types.Float64Unknown(),
+				State:          testState(types.StringValue("one"), types.Float64Null()),
+				StateValue:     types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"dependency-changed": {
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.Float64Value(1.2)),
+				PlanValue:      types.Float64Value(1.2),
+				State:          testState(types.StringValue("one"), types.Float64Value(1.2)),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.Float64Request{
+				ConfigValue:    types.Float64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.Float64Unknown()),
+				PlanValue:      types.Float64Unknown(),
+				State:          testState(types.StringValue("one"), types.Float64Value(1.2)),
+				StateValue:     types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float64planmodifier.UnknownWhenChanged(path.MatchRoot("dependency")).PlanModifyFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
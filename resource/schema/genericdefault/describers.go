@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericdefault
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+// describers converts typed default value implementations into their common
+// interface so they can be stored by the combinator implementations.
+func describers[T defaults.Describer](defaultValues []T) []defaults.Describer {
+	result := make([]defaults.Describer, len(defaultValues))
+
+	for i, defaultValue := range defaultValues {
+		result[i] = defaultValue
+	}
+
+	return result
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package genericdefault provides default value implementations which combine
+// other default value implementations, such as FirstString. Each combinator
+// has a typed constructor for every typed default interface in the
+// resource/schema/defaults package, such as FirstString for defaults.String
+// and FirstInt64 for defaults.Int64.
+package genericdefault
@@ -0,0 +1,440 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericdefault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ defaults.Bool    = firstDefault{}
+	_ defaults.Float32 = firstDefault{}
+	_ defaults.Float64 = firstDefault{}
+	_ defaults.Int32   = firstDefault{}
+	_ defaults.Int64   = firstDefault{}
+	_ defaults.List    = firstDefault{}
+	_ defaults.Map     = firstDefault{}
+	_ defaults.Number  = firstDefault{}
+	_ defaults.Object  = firstDefault{}
+	_ defaults.Set     = firstDefault{}
+	_ defaults.String  = firstDefault{}
+	_ defaults.Tuple   = firstDefault{}
+)
+
+// FirstBool returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstBool(defaultValues ...defaults.Bool) defaults.Bool {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstFloat32 returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstFloat32(defaultValues ...defaults.Float32) defaults.Float32 {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstFloat64 returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstFloat64(defaultValues ...defaults.Float64) defaults.Float64 {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstInt32 returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstInt32(defaultValues ...defaults.Int32) defaults.Int32 {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstInt64 returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstInt64(defaultValues ...defaults.Int64) defaults.Int64 {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstList returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstList(defaultValues ...defaults.List) defaults.List {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstMap returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstMap(defaultValues ...defaults.Map) defaults.Map {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstNumber returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstNumber(defaultValues ...defaults.Number) defaults.Number {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstObject returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstObject(defaultValues ...defaults.Object) defaults.Object {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstSet returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstSet(defaultValues ...defaults.Set) defaults.Set {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstString returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstString(defaultValues ...defaults.String) defaults.String {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// FirstTuple returns a static or dynamic default value implementation which
+// calls each of the given default value implementations in order and uses the
+// first non-null value. The planned value remains null if no implementation
+// returns a non-null value.
+func FirstTuple(defaultValues ...defaults.Tuple) defaults.Tuple {
+	return firstDefault{
+		defaults: describers(defaultValues),
+	}
+}
+
+// firstDefault is static or dynamic value default handler that uses the
+// first non-null value of a list of default value implementations.
+type firstDefault struct {
+	defaults []defaults.Describer
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d firstDefault) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, defaultValue := range d.defaults {
+		descriptions = append(descriptions, defaultValue.Description(ctx))
+	}
+
+	return fmt.Sprintf("value defaults to the first non-null value of: %s", strings.Join(descriptions, ", "))
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d firstDefault) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, defaultValue := range d.defaults {
+		descriptions = append(descriptions, defaultValue.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("value defaults to the first non-null value of: %s", strings.Join(descriptions, ", "))
+}
+
+// DefaultBool implements the static or dynamic default value logic.
+func (d firstDefault) DefaultBool(ctx context.Context, req defaults.BoolRequest, resp *defaults.BoolResponse) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.BoolResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Bool).DefaultBool(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultFloat32 implements the static or dynamic default value logic.
+func (d firstDefault) DefaultFloat32(ctx context.Context, req defaults.Float32Request, resp *defaults.Float32Response) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.Float32Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Float32).DefaultFloat32(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultFloat64 implements the static or dynamic default value logic.
+func (d firstDefault) DefaultFloat64(ctx context.Context, req defaults.Float64Request, resp *defaults.Float64Response) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.Float64Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Float64).DefaultFloat64(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultInt32 implements the static or dynamic default value logic.
+func (d firstDefault) DefaultInt32(ctx context.Context, req defaults.Int32Request, resp *defaults.Int32Response) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.Int32Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Int32).DefaultInt32(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultInt64 implements the static or dynamic default value logic.
+func (d firstDefault) DefaultInt64(ctx context.Context, req defaults.Int64Request, resp *defaults.Int64Response) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.Int64Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Int64).DefaultInt64(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultList implements the static or dynamic default value logic.
+func (d firstDefault) DefaultList(ctx context.Context, req defaults.ListRequest, resp *defaults.ListResponse) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.ListResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.List).DefaultList(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultMap implements the static or dynamic default value logic.
+func (d firstDefault) DefaultMap(ctx context.Context, req defaults.MapRequest, resp *defaults.MapResponse) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.MapResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Map).DefaultMap(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultNumber implements the static or dynamic default value logic.
+func (d firstDefault) DefaultNumber(ctx context.Context, req defaults.NumberRequest, resp *defaults.NumberResponse) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.NumberResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Number).DefaultNumber(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultObject implements the static or dynamic default value logic.
+func (d firstDefault) DefaultObject(ctx context.Context, req defaults.ObjectRequest, resp *defaults.ObjectResponse) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.ObjectResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Object).DefaultObject(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultSet implements the static or dynamic default value logic.
+func (d firstDefault) DefaultSet(ctx context.Context, req defaults.SetRequest, resp *defaults.SetResponse) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.SetResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Set).DefaultSet(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultString implements the static or dynamic default value logic.
+func (d firstDefault) DefaultString(ctx context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.StringResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.String).DefaultString(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
+
+// DefaultTuple implements the static or dynamic default value logic.
+func (d firstDefault) DefaultTuple(ctx context.Context, req defaults.TupleRequest, resp *defaults.TupleResponse) {
+	for _, defaultValue := range d.defaults {
+		subResp := &defaults.TupleResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		defaultValue.(defaults.Tuple).DefaultTuple(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+
+		if subResp.Diagnostics.HasError() {
+			return
+		}
+
+		if !subResp.PlanValue.IsNull() {
+			resp.PlanValue = subResp.PlanValue
+
+			return
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/genericdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstDescription(t *testing.T) {
+	t.Parallel()
+
+	d := genericdefault.FirstString(
+		stringdefault.StaticString("one"),
+		stringdefault.StaticString("two"),
+	)
+
+	expected := "value defaults to the first non-null value of: value defaults to one, value defaults to two"
+
+	if got := d.Description(context.Background()); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestFirstDefaultString(t *testing.T) {
+	t.Parallel()
+
+	errorDiag := diag.NewAttributeErrorDiagnostic(path.Root("test"), "Default Error", "default error detail")
+
+	testCases := map[string]struct {
+		defaults []defaults.String
+		expected *defaults.StringResponse
+	}{
+		"empty": {
+			defaults: nil,
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"first": {
+			defaults: []defaults.String{
+				stringdefault.StaticString("one"),
+				stringdefault.StaticString("two"),
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("one"),
+			},
+		},
+		"skip-null": {
+			defaults: []defaults.String{
+				testStringDefault{value: types.StringNull()},
+				stringdefault.StaticString("two"),
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("two"),
+			},
+		},
+		"all-null": {
+			defaults: []defaults.String{
+				testStringDefault{value: types.StringNull()},
+				testStringDefault{value: types.StringNull()},
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"error": {
+			defaults: []defaults.String{
+				testStringDefault{diags: diag.Diagnostics{errorDiag}, value: types.StringNull()},
+				stringdefault.StaticString("two"),
+			},
+			expected: &defaults.StringResponse{
+				Diagnostics: diag.Diagnostics{errorDiag},
+				PlanValue:   types.StringNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.StringResponse{
+				PlanValue: types.StringNull(),
+			}
+
+			genericdefault.FirstString(testCase.defaults...).DefaultString(context.Background(), defaults.StringRequest{Path: path.Root("test")}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFirstDefaultString_Concrete(t *testing.T) {
+	t.Parallel()
+
+	resp := &defaults.StringResponse{}
+
+	// Concrete default types are converted to defaults.String by the typed
+	// constructor.
+	genericdefault.FirstString(
+		testStringDefault{value: types.StringNull()},
+		testStringDefault{value: types.StringValue("two")},
+	).DefaultString(context.Background(), defaults.StringRequest{Path: path.Root("test")}, resp)
+
+	expected := &defaults.StringResponse{
+		PlanValue: types.StringValue("two"),
+	}
+
+	if diff := cmp.Diff(expected, resp); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+// testStringDefault returns the configured value and diagnostics.
+type testStringDefault struct {
+	diags diag.Diagnostics
+	value types.String
+}
+
+func (d testStringDefault) Description(_ context.Context) string {
+	return "test default"
+}
+
+func (d testStringDefault) MarkdownDescription(_ context.Context) string {
+	return "test default"
+}
+
+func (d testStringDefault) DefaultString(_ context.Context, _ defaults.StringRequest, resp *defaults.StringResponse) {
+	resp.Diagnostics.Append(d.diags...)
+	resp.PlanValue = d.value
+}
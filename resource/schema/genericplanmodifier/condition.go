@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Condition determines whether the plan modifier passed to If or Unless is
+// run.
+type Condition interface {
+	planmodifier.Describer
+
+	// Evaluate returns true if the condition is met. Returning error
+	// diagnostics prevents the plan modifier from running.
+	Evaluate(context.Context, ConditionRequest) (bool, diag.Diagnostics)
+}
+
+// ConditionRequest is the request passed to Condition Evaluate.
+type ConditionRequest struct {
+	// Path contains the path of the attribute for modification.
+	Path path.Path
+
+	// PathExpression contains the expression matching the exact path
+	// of the attribute for modification.
+	PathExpression path.Expression
+
+	// Config contains the entire configuration of the resource.
+	Config tfsdk.Config
+
+	// ConfigValue contains the value of the attribute for modification from the configuration.
+	ConfigValue attr.Value
+
+	// Plan contains the entire proposed new state of the resource.
+	Plan tfsdk.Plan
+
+	// PlanValue contains the value of the attribute for modification from the proposed new state.
+	PlanValue attr.Value
+
+	// State contains the entire prior state of the resource.
+	State tfsdk.State
+
+	// StateValue contains the value of the attribute for modification from the prior state.
+	StateValue attr.Value
+}
+
+// ConditionFunc returns a Condition which calls the given function. The
+// description is used for both plain text and Markdown descriptions.
+func ConditionFunc(description string, f func(context.Context, ConditionRequest) (bool, diag.Diagnostics)) Condition {
+	return conditionFunc{
+		description: description,
+		f:           f,
+	}
+}
+
+// conditionFunc implements ConditionFunc.
+type conditionFunc struct {
+	description string
+	f           func(context.Context, ConditionRequest) (bool, diag.Diagnostics)
+}
+
+// Description returns a human-readable description of the condition.
+func (c conditionFunc) Description(_ context.Context) string {
+	return c.description
+}
+
+// MarkdownDescription returns a markdown description of the condition.
+func (c conditionFunc) MarkdownDescription(_ context.Context) string {
+	return c.description
+}
+
+// Evaluate implements Condition.
+func (c conditionFunc) Evaluate(ctx context.Context, req ConditionRequest) (bool, diag.Diagnostics) {
+	return c.f(ctx, req)
+}
+
+// IsResourceCreate returns a Condition which is true when the resource is
+// being created, i.e. there is no prior state.
+func IsResourceCreate() Condition {
+	return ConditionFunc(
+		"the resource is being created",
+		func(_ context.Context, req ConditionRequest) (bool, diag.Diagnostics) {
+			return req.State.Raw.IsNull(), nil
+		},
+	)
+}
+
+// IsResourceDestroy returns a Condition which is true when the resource is
+// being destroyed, i.e. the proposed new state is null.
+func IsResourceDestroy() Condition {
+	return ConditionFunc(
+		"the resource is being destroyed",
+		func(_ context.Context, req ConditionRequest) (bool, diag.Diagnostics) {
+			return req.Plan.Raw.IsNull(), nil
+		},
+	)
+}
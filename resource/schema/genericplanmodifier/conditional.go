@@ -0,0 +1,592 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ planmodifier.Bool    = conditionalModifier{}
+	_ planmodifier.Float32 = conditionalModifier{}
+	_ planmodifier.Float64 = conditionalModifier{}
+	_ planmodifier.Int32   = conditionalModifier{}
+	_ planmodifier.Int64   = conditionalModifier{}
+	_ planmodifier.List    = conditionalModifier{}
+	_ planmodifier.Map     = conditionalModifier{}
+	_ planmodifier.Number  = conditionalModifier{}
+	_ planmodifier.Object  = conditionalModifier{}
+	_ planmodifier.Set     = conditionalModifier{}
+	_ planmodifier.String  = conditionalModifier{}
+	_ planmodifier.Tuple   = conditionalModifier{}
+)
+
+// IfBool returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfBool(condition Condition, m planmodifier.Bool) planmodifier.Bool {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfFloat32 returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfFloat32(condition Condition, m planmodifier.Float32) planmodifier.Float32 {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfFloat64 returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfFloat64(condition Condition, m planmodifier.Float64) planmodifier.Float64 {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfInt32 returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfInt32(condition Condition, m planmodifier.Int32) planmodifier.Int32 {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfInt64 returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfInt64(condition Condition, m planmodifier.Int64) planmodifier.Int64 {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfList returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfList(condition Condition, m planmodifier.List) planmodifier.List {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfMap returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfMap(condition Condition, m planmodifier.Map) planmodifier.Map {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfNumber returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfNumber(condition Condition, m planmodifier.Number) planmodifier.Number {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfObject returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfObject(condition Condition, m planmodifier.Object) planmodifier.Object {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfSet returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfSet(condition Condition, m planmodifier.Set) planmodifier.Set {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfString returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfString(condition Condition, m planmodifier.String) planmodifier.String {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// IfTuple returns a plan modifier which only runs the given plan modifier if
+// the given condition is true.
+func IfTuple(condition Condition, m planmodifier.Tuple) planmodifier.Tuple {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     true,
+		planModifier: m,
+	}
+}
+
+// UnlessBool returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessBool(condition Condition, m planmodifier.Bool) planmodifier.Bool {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessFloat32 returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessFloat32(condition Condition, m planmodifier.Float32) planmodifier.Float32 {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessFloat64 returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessFloat64(condition Condition, m planmodifier.Float64) planmodifier.Float64 {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessInt32 returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessInt32(condition Condition, m planmodifier.Int32) planmodifier.Int32 {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessInt64 returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessInt64(condition Condition, m planmodifier.Int64) planmodifier.Int64 {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessList returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessList(condition Condition, m planmodifier.List) planmodifier.List {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessMap returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessMap(condition Condition, m planmodifier.Map) planmodifier.Map {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessNumber returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessNumber(condition Condition, m planmodifier.Number) planmodifier.Number {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessObject returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessObject(condition Condition, m planmodifier.Object) planmodifier.Object {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessSet returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessSet(condition Condition, m planmodifier.Set) planmodifier.Set {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessString returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessString(condition Condition, m planmodifier.String) planmodifier.String {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// UnlessTuple returns a plan modifier which only runs the given plan modifier
+// if the given condition is false.
+func UnlessTuple(condition Condition, m planmodifier.Tuple) planmodifier.Tuple {
+	return conditionalModifier{
+		condition:    condition,
+		expected:     false,
+		planModifier: m,
+	}
+}
+
+// conditionalModifier implements the If and Unless plan modifiers.
+type conditionalModifier struct {
+	condition    Condition
+	expected     bool
+	planModifier planmodifier.Describer
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m conditionalModifier) Description(ctx context.Context) string {
+	if m.expected {
+		return fmt.Sprintf("If %s, then: %s", m.condition.Description(ctx), m.planModifier.Description(ctx))
+	}
+
+	return fmt.Sprintf("Unless %s: %s", m.condition.Description(ctx), m.planModifier.Description(ctx))
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m conditionalModifier) MarkdownDescription(ctx context.Context) string {
+	if m.expected {
+		return fmt.Sprintf("If %s, then: %s", m.condition.MarkdownDescription(ctx), m.planModifier.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("Unless %s: %s", m.condition.MarkdownDescription(ctx), m.planModifier.MarkdownDescription(ctx))
+}
+
+// PlanModifyBool implements planmodifier.Bool.
+func (m conditionalModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Bool).PlanModifyBool(ctx, req, resp)
+}
+
+// PlanModifyFloat32 implements planmodifier.Float32.
+func (m conditionalModifier) PlanModifyFloat32(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Float32).PlanModifyFloat32(ctx, req, resp)
+}
+
+// PlanModifyFloat64 implements planmodifier.Float64.
+func (m conditionalModifier) PlanModifyFloat64(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Float64).PlanModifyFloat64(ctx, req, resp)
+}
+
+// PlanModifyInt32 implements planmodifier.Int32.
+func (m conditionalModifier) PlanModifyInt32(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Int32).PlanModifyInt32(ctx, req, resp)
+}
+
+// PlanModifyInt64 implements planmodifier.Int64.
+func (m conditionalModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Int64).PlanModifyInt64(ctx, req, resp)
+}
+
+// PlanModifyList implements planmodifier.List.
+func (m conditionalModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.List).PlanModifyList(ctx, req, resp)
+}
+
+// PlanModifyMap implements planmodifier.Map.
+func (m conditionalModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Map).PlanModifyMap(ctx, req, resp)
+}
+
+// PlanModifyNumber implements planmodifier.Number.
+func (m conditionalModifier) PlanModifyNumber(ctx context.Context, req planmodifier.NumberRequest, resp *planmodifier.NumberResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Number).PlanModifyNumber(ctx, req, resp)
+}
+
+// PlanModifyObject implements planmodifier.Object.
+func (m conditionalModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Object).PlanModifyObject(ctx, req, resp)
+}
+
+// PlanModifySet implements planmodifier.Set.
+func (m conditionalModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Set).PlanModifySet(ctx, req, resp)
+}
+
+// PlanModifyString implements planmodifier.String.
+func (m conditionalModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.String).PlanModifyString(ctx, req, resp)
+}
+
+// PlanModifyTuple implements planmodifier.Tuple.
+func (m conditionalModifier) PlanModifyTuple(ctx context.Context, req planmodifier.TupleRequest, resp *planmodifier.TupleResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		PlanValue:      req.PlanValue,
+		State:          req.State,
+		StateValue:     req.StateValue,
+	}
+
+	ok, diags := m.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || ok != m.expected {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	m.planModifier.(planmodifier.Tuple).PlanModifyTuple(ctx, req, resp)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/genericplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIfDescription(t *testing.T) {
+	t.Parallel()
+
+	m := genericplanmodifier.IfString(
+		genericplanmodifier.IsResourceCreate(),
+		stringplanmodifier.UseStateForUnknown(),
+	)
+
+	expected := "If the resource is being created, then: Once set, the value of this attribute in state will not change."
+
+	if got := m.Description(context.Background()); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestIfPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	conditionDiag := diag.NewErrorDiagnostic("Condition Error", "condition error detail")
+
+	testCases := map[string]struct {
+		condition genericplanmodifier.Condition
+		expected  *planmodifier.StringResponse
+	}{
+		"true": {
+			condition: genericplanmodifier.ConditionFunc(
+				"state is test",
+				func(_ context.Context, req genericplanmodifier.ConditionRequest) (bool, diag.Diagnostics) {
+					return req.StateValue.Equal(types.StringValue("test")), nil
+				},
+			),
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"false": {
+			condition: genericplanmodifier.ConditionFunc(
+				"state is null",
+				func(_ context.Context, req genericplanmodifier.ConditionRequest) (bool, diag.Diagnostics) {
+					return req.StateValue.IsNull(), nil
+				},
+			),
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"error": {
+			condition: genericplanmodifier.ConditionFunc(
+				"error",
+				func(_ context.Context, _ genericplanmodifier.ConditionRequest) (bool, diag.Diagnostics) {
+					return true, diag.Diagnostics{conditionDiag}
+				},
+			),
+			expected: &planmodifier.StringResponse{
+				Diagnostics: diag.Diagnostics{conditionDiag},
+				PlanValue:   types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				StateValue:  types.StringValue("test"),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: req.PlanValue,
+			}
+
+			genericplanmodifier.IfString(testCase.condition, stringplanmodifier.UseStateForUnknown()).PlanModifyString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnlessPlanModifyInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.Int64Request
+		expected *planmodifier.Int64Response
+	}{
+		"create": {
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Value(2),
+				PlanValue:   types.Int64Value(2),
+				StateValue:  types.Int64Null(),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(2),
+			},
+		},
+		"update": {
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Value(2),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+				},
+				PlanValue: types.Int64Value(2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+				},
+				StateValue: types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue:       types.Int64Value(2),
+				RequiresReplace: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			genericplanmodifier.UnlessInt64(
+				genericplanmodifier.IsResourceCreate(),
+				int64planmodifier.RequiresReplace(),
+			).PlanModifyInt64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIfPlanModifyString_Concrete(t *testing.T) {
+	t.Parallel()
+
+	req := planmodifier.StringRequest{
+		ConfigValue: types.StringNull(),
+		Plan: tfsdk.Plan{
+			Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+		},
+		PlanValue:  types.StringUnknown(),
+		StateValue: types.StringValue("test"),
+	}
+	resp := &planmodifier.StringResponse{
+		PlanValue: req.PlanValue,
+	}
+
+	// Concrete plan modifier types are converted to planmodifier.String by
+	// the typed constructors.
+	genericplanmodifier.IfString(
+		genericplanmodifier.IsResourceDestroy(),
+		testStringPlanModifier{value: types.StringValue("destroy")},
+	).PlanModifyString(context.Background(), req, resp)
+
+	genericplanmodifier.UnlessString(
+		genericplanmodifier.IsResourceDestroy(),
+		testStringPlanModifier{value: types.StringValue("modified")},
+	).PlanModifyString(context.Background(), req, resp)
+
+	expected := &planmodifier.StringResponse{
+		PlanValue: types.StringValue("modified"),
+	}
+
+	if diff := cmp.Diff(expected, resp); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+// testStringPlanModifier sets the configured plan value.
+type testStringPlanModifier struct {
+	value types.String
+}
+
+func (m testStringPlanModifier) Description(_ context.Context) string {
+	return "test plan modifier"
+}
+
+func (m testStringPlanModifier) MarkdownDescription(_ context.Context) string {
+	return "test plan modifier"
+}
+
+func (m testStringPlanModifier) PlanModifyString(_ context.Context, _ planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	resp.PlanValue = m.value
+}
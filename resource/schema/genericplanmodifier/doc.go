@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package genericplanmodifier provides plan modifiers which wrap other plan
+// modifiers, such as IfString and UnlessString. Each wrapper has a typed
+// constructor for every typed plan modifier interface in the
+// resource/schema/planmodifier package, such as IfString for
+// planmodifier.String and IfInt64 for planmodifier.Int64.
+package genericplanmodifier
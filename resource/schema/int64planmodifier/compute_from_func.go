@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ComputeFromFunc is a function used in the ComputeFrom plan modifier to
+// determine the planned value of the attribute.
+type ComputeFromFunc func(context.Context, ComputeFromFuncRequest, *ComputeFromFuncResponse)
+
+// ComputeFromFuncRequest is the request type for a ComputeFromFunc.
+type ComputeFromFuncRequest struct {
+	// Paths are the paths of all attributes matching the path expressions
+	// given to ComputeFrom, in expression order.
+	Paths path.Paths
+
+	// Request is the plan modification request of the attribute.
+	Request planmodifier.Int64Request
+
+	// Values are the known planned values of the attributes at Paths, in the
+	// same order.
+	Values []attr.Value
+}
+
+// ComputeFromFuncResponse is the response type for a ComputeFromFunc.
+type ComputeFromFuncResponse struct {
+	// Diagnostics report errors or warnings related to this logic. An empty
+	// or unset slice indicates success, with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the planned value for the attribute. It defaults to the
+	// unknown planned value of the request.
+	PlanValue types.Int64
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComputeFromModifierPlanModifyInt64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.Int64Attribute{Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Int64) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Int64) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Int64) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	computeFunc := func(ctx context.Context, req int64planmodifier.ComputeFromFuncRequest, resp *int64planmodifier.ComputeFromFuncResponse) {
+		if len(req.Paths) != 1 || !req.Paths[0].Equal(path.Root("dependency")) {
+			resp.Diagnostics.AddError("Unexpected Paths", req.Paths.String())
+
+			return
+		}
+
+		if !req.Values[0].Equal(types.StringValue("two")) {
+			resp.Diagnostics.AddError("Unexpected Values", req.Values[0].String())
+
+			return
+		}
+
+		resp.PlanValue = types.Int64Value(2)
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Int64Request
+		expected *planmodifier.Int64Response
+	}{
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.Int64Null(),
+				State:          testState(types.StringValue("one"), types.Int64Value(1)),
+				StateValue:     types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Null(),
+			},
+		},
+		"config-value": {
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Value(1),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.Int64Value(1)),
+				PlanValue:      types.Int64Value(1),
+				State:          testState(types.StringValue("one"), types.Int64Value(1)),
+				StateValue:     types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(1),
+			},
+		},
+		"plan-known": {
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.Int64Value(1)),
+				PlanValue:      types.Int64Value(1),
+				State:          testState(types.StringValue("one"), types.Int64Value(1)),
+				StateValue:     types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(1),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.Int64Unknown()),
+				PlanValue:      types.Int64Unknown(),
+				State:          testState(types.StringValue("one"), types.Int64Value(1)),
+				StateValue:     types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Unknown(),
+			},
+		},
+		"computed": {
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.Int64Unknown()),
+				PlanValue:      types.Int64Unknown(),
+				State:          testState(types.StringValue("one"), types.Int64Value(1)),
+				StateValue:     types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(2),
+			},
+		},
+		"computed-state-null": {
+			// resource creation
+			request: planmodifier.Int64Request{
+				ConfigValue:    types.Int64Null(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.Int64Unknown()),
+				PlanValue:      types.Int64Unknown(),
+				State:          nullState,
+				StateValue:     types.Int64Null(),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(2),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			int64planmodifier.ComputeFrom(path.Expressions{path.MatchRoot("dependency")}, computeFunc, "test", "test").PlanModifyInt64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UnknownWhenChanged returns a plan modifier that copies a known prior state
+// value into the planned value while the attributes matching the given path
+// expressions keep their prior state values. If the planned value of any of
+// those attributes is unknown or differs from its prior state value, the
+// planned value is set to unknown "(known after apply)" instead.
+//
+// Relative path expressions are merged with the path expression of this
+// attribute, so path.MatchRelative().AtParent().AtName("name") refers to a
+// sibling attribute within the same nested object or collection element.
+//
+// The plan is not modified if the attribute is configured, or on resource
+// creation or destroy.
+func UnknownWhenChanged(expressions ...path.Expression) planmodifier.Int64 {
+	return unknownWhenChangedModifier{
+		expressions: expressions,
+	}
+}
+
+// unknownWhenChangedModifier implements the plan modifier.
+type unknownWhenChangedModifier struct {
+	expressions path.Expressions
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m unknownWhenChangedModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("The value of this attribute in state will not change unless the values of %s change.", m.expressions)
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m unknownWhenChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyInt64 implements the plan modification logic.
+func (m unknownWhenChangedModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// Do nothing on resource creation.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a configuration value.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	changed, diags := fwplanmodifier.DependenciesChanged(ctx, fwplanmodifier.DependenciesRequest{
+		Expressions:    m.expressions,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+		Plan:           req.Plan,
+		State:          req.State,
+	})
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	if changed {
+		resp.PlanValue = types.Int64Unknown()
+
+		return
+	}
+
+	// Do nothing if there is no state value.
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnknownWhenChangedModifierPlanModifyList(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.ListAttribute{ElementType: types.StringType, Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.List) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.List) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.List) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.ListRequest
+		expected *planmodifier.ListResponse
+	}{
+		"state-null": {
+			// resource creation
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.ListUnknown(types.StringType)),
+				PlanValue:      types.ListUnknown(types.StringType),
+				State:          nullState,
+				StateValue:     types.ListNull(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.ListNull(types.StringType),
+				State:          testState(types.StringValue("one"), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListNull(types.StringType),
+			},
+		},
+		"config-value": {
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("two")}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("two")})),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("two")}),
+				State:          testState(types.StringValue("one"), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("two")}),
+			},
+		},
+		"dependency-unchanged": {
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.ListUnknown(types.StringType)),
+				PlanValue:      types.ListUnknown(types.StringType),
+				State:          testState(types.StringValue("one"), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+		},
+		"dependency-unchanged-state-null": {
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.ListUnknown(types.StringType)),
+				PlanValue:      types.ListUnknown(types.StringType),
+				State:          testState(types.StringValue("one"), types.ListNull(types.StringType)),
+				StateValue:     types.ListNull(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"dependency-changed": {
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				PlanValue:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+				State:          testState(types.StringValue("one"), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.ListRequest{
+				ConfigValue:    types.ListNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.ListUnknown(types.StringType)),
+				PlanValue:      types.ListUnknown(types.StringType),
+				State:          testState(types.StringValue("one"), types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ListResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			listplanmodifier.UnknownWhenChanged(path.MatchRoot("dependency")).PlanModifyList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
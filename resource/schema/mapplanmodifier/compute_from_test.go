@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComputeFromModifierPlanModifyMap(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.MapAttribute{ElementType: types.StringType, Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Map) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Map) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Map) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	computeFunc := func(ctx context.Context, req mapplanmodifier.ComputeFromFuncRequest, resp *mapplanmodifier.ComputeFromFuncResponse) {
+		if len(req.Paths) != 1 || !req.Paths[0].Equal(path.Root("dependency")) {
+			resp.Diagnostics.AddError("Unexpected Paths", req.Paths.String())
+
+			return
+		}
+
+		if !req.Values[0].Equal(types.StringValue("two")) {
+			resp.Diagnostics.AddError("Unexpected Values", req.Values[0].String())
+
+			return
+		}
+
+		resp.PlanValue = types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("two")})
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.MapRequest
+		expected *planmodifier.MapResponse
+	}{
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.MapRequest{
+				ConfigValue:    types.MapNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.MapNull(types.StringType),
+				State:          testState(types.StringValue("one"), types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")})),
+				StateValue:     types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapNull(types.StringType),
+			},
+		},
+		"config-value": {
+			request: planmodifier.MapRequest{
+				ConfigValue:    types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")})),
+				PlanValue:      types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+				State:          testState(types.StringValue("one"), types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")})),
+				StateValue:     types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+			},
+		},
+		"plan-known": {
+			request: planmodifier.MapRequest{
+				ConfigValue:    types.MapNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")})),
+				PlanValue:      types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+				State:          testState(types.StringValue("one"), types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")})),
+				StateValue:     types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.MapRequest{
+				ConfigValue:    types.MapNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.MapUnknown(types.StringType)),
+				PlanValue:      types.MapUnknown(types.StringType),
+				State:          testState(types.StringValue("one"), types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")})),
+				StateValue:     types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"computed": {
+			request: planmodifier.MapRequest{
+				ConfigValue:    types.MapNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.MapUnknown(types.StringType)),
+				PlanValue:      types.MapUnknown(types.StringType),
+				State:          testState(types.StringValue("one"), types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")})),
+				StateValue:     types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("one")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("two")}),
+			},
+		},
+		"computed-state-null": {
+			// resource creation
+			request: planmodifier.MapRequest{
+				ConfigValue:    types.MapNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.MapUnknown(types.StringType)),
+				PlanValue:      types.MapUnknown(types.StringType),
+				State:          nullState,
+				StateValue:     types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("two")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.MapResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			mapplanmodifier.ComputeFrom(path.Expressions{path.MatchRoot("dependency")}, computeFunc, "test", "test").PlanModifyMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
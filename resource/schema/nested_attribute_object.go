@@ -14,6 +14,7 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
+	_ fwxschema.NestedAttributeObjectWithKeyAttributes = NestedAttributeObject{}
 	_ fwxschema.NestedAttributeObjectWithPlanModifiers = NestedAttributeObject{}
 	_ fwxschema.NestedAttributeObjectWithValidators    = NestedAttributeObject{}
 )
@@ -66,6 +67,25 @@ type NestedAttributeObject struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Object
+
+	// KeyAttributes is an optional list of underlying attribute names which
+	// together uniquely identify an element of a ListNestedAttribute or
+	// SetNestedAttribute. This field has no effect on MapNestedAttribute or
+	// SingleNestedAttribute.
+	//
+	// By default, the prior state of a list element is the element at the
+	// same index and the prior state of a set element is the element at the
+	// same position in the set, which can be incorrect when elements are
+	// reordered, added, or removed. When this field is set, the prior state
+	// of each planned element is instead the prior state element with equal
+	// values for all of the key attributes. If any key attribute value is
+	// null or unknown in the plan, or no prior state element matches, the
+	// prior state of the element is null.
+	//
+	// The prior state determined here is used for the StateValue of plan
+	// modifiers on the element object and all of its underlying attributes,
+	// such as UseStateForUnknown.
+	KeyAttributes []string
 }
 
 // ApplyTerraform5AttributePathStep performs an AttributeName step on the
@@ -88,6 +108,11 @@ func (o NestedAttributeObject) GetAttributes() fwschema.UnderlyingAttributes {
 	return schemaAttributes(o.Attributes)
 }
 
+// GetKeyAttributes returns the KeyAttributes field value.
+func (o NestedAttributeObject) GetKeyAttributes() []string {
+	return o.KeyAttributes
+}
+
 // ObjectPlanModifiers returns the PlanModifiers field value.
 func (o NestedAttributeObject) ObjectPlanModifiers() []planmodifier.Object {
 	return o.PlanModifiers
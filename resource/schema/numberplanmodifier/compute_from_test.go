@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numberplanmodifier_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComputeFromModifierPlanModifyNumber(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.NumberAttribute{Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Number) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Number) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Number) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	computeFunc := func(ctx context.Context, req numberplanmodifier.ComputeFromFuncRequest, resp *numberplanmodifier.ComputeFromFuncResponse) {
+		if len(req.Paths) != 1 || !req.Paths[0].Equal(path.Root("dependency")) {
+			resp.Diagnostics.AddError("Unexpected Paths", req.Paths.String())
+
+			return
+		}
+
+		if !req.Values[0].Equal(types.StringValue("two")) {
+			resp.Diagnostics.AddError("Unexpected Values", req.Values[0].String())
+
+			return
+		}
+
+		resp.PlanValue = types.NumberValue(big.NewFloat(2))
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.NumberRequest
+		expected *planmodifier.NumberResponse
+	}{
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.NumberNull(),
+				State:          testState(types.StringValue("one"), types.NumberValue(big.NewFloat(1))),
+				StateValue:     types.NumberValue(big.NewFloat(1)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberNull(),
+			},
+		},
+		"config-value": {
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberValue(big.NewFloat(1)),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.NumberValue(big.NewFloat(1))),
+				PlanValue:      types.NumberValue(big.NewFloat(1)),
+				State:          testState(types.StringValue("one"), types.NumberValue(big.NewFloat(1))),
+				StateValue:     types.NumberValue(big.NewFloat(1)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1)),
+			},
+		},
+		"plan-known": {
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.NumberValue(big.NewFloat(1))),
+				PlanValue:      types.NumberValue(big.NewFloat(1)),
+				State:          testState(types.StringValue("one"), types.NumberValue(big.NewFloat(1))),
+				StateValue:     types.NumberValue(big.NewFloat(1)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1)),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.NumberUnknown()),
+				PlanValue:      types.NumberUnknown(),
+				State:          testState(types.StringValue("one"), types.NumberValue(big.NewFloat(1))),
+				StateValue:     types.NumberValue(big.NewFloat(1)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"computed": {
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.NumberUnknown()),
+				PlanValue:      types.NumberUnknown(),
+				State:          testState(types.StringValue("one"), types.NumberValue(big.NewFloat(1))),
+				StateValue:     types.NumberValue(big.NewFloat(1)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(2)),
+			},
+		},
+		"computed-state-null": {
+			// resource creation
+			request: planmodifier.NumberRequest{
+				ConfigValue:    types.NumberNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.NumberUnknown()),
+				PlanValue:      types.NumberUnknown(),
+				State:          nullState,
+				StateValue:     types.NumberNull(),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(2)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.NumberResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			numberplanmodifier.ComputeFrom(path.Expressions{path.MatchRoot("dependency")}, computeFunc, "test", "test").PlanModifyNumber(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
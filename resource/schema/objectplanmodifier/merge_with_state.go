@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package objectplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// MergeAttribute configures how MergeWithState plans a single underlying
+// attribute of the object.
+type MergeAttribute struct {
+	// AlwaysUnknown, if true, leaves an unconfigured underlying attribute
+	// unknown in the plan, regardless of the prior state value. Use this for
+	// values which can change on every update.
+	AlwaysUnknown bool
+
+	// DependsOn is a list of names of other underlying attributes of the same
+	// object. If the planned value of any of these attributes, after it is
+	// merged by this plan modifier, is unknown or differs from its prior
+	// state value, the unconfigured underlying attribute is left unknown in
+	// the plan. Otherwise the prior state value is carried forward.
+	// Dependencies are merged first, so an unconfigured dependency whose
+	// prior state value is carried forward is unchanged. Attributes which
+	// depend on each other in a cycle are left unknown.
+	DependsOn []string
+}
+
+// MergeWithState returns a plan modifier that merges configuration, plan, and
+// prior state for Optional and Computed object attributes, such as a
+// SingleNestedAttribute, or for the NestedObject of a nested attribute, where
+// practitioners configure only some underlying attributes and the remainder
+// are filled in by the provider.
+//
+// The framework marks unconfigured Computed underlying attributes as unknown
+// "(known after apply)" on update, or the whole object if it is unconfigured.
+// This plan modifier instead plans each underlying attribute individually:
+//
+//   - Configured values are always kept as-is.
+//   - Unconfigured values which are unknown in the plan are replaced with the
+//     known, non-null prior state value, unless the attributes argument
+//     declares otherwise for that underlying attribute.
+//
+// Underlying attributes not present in the attributes argument always carry
+// forward their prior state value. The plan is not modified on resource
+// creation or when the object configuration is unknown.
+func MergeWithState(attributes map[string]MergeAttribute) planmodifier.Object {
+	return mergeWithStateModifier{
+		attributes: attributes,
+	}
+}
+
+// mergeWithStateModifier implements the plan modifier.
+type mergeWithStateModifier struct {
+	attributes map[string]MergeAttribute
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m mergeWithStateModifier) Description(_ context.Context) string {
+	var b strings.Builder
+
+	b.WriteString("Unconfigured values of this object in state will not change")
+
+	names := make([]string, 0, len(m.attributes))
+
+	for name := range m.attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		mergeAttribute := m.attributes[name]
+
+		switch {
+		case mergeAttribute.AlwaysUnknown:
+			b.WriteString(fmt.Sprintf(", except %q which is always recomputed", name))
+		case len(mergeAttribute.DependsOn) > 0:
+			b.WriteString(fmt.Sprintf(", except %q which is recomputed when %s change", name, strings.Join(quoteStrings(mergeAttribute.DependsOn), ", ")))
+		}
+	}
+
+	b.WriteString(".")
+
+	return b.String()
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m mergeWithStateModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyObject implements the plan modification logic.
+func (m mergeWithStateModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	// Do nothing if there is no state value.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is a null planned value.
+	if req.PlanValue.IsNull() {
+		return
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	stateAttributes := req.StateValue.Attributes()
+
+	// An unconfigured object is planned as a whole unknown value, so start
+	// from the prior state with all underlying attributes unconfigured.
+	var configAttributes, planAttributes map[string]attr.Value
+
+	if req.PlanValue.IsUnknown() {
+		if !req.ConfigValue.IsNull() {
+			return
+		}
+
+		planAttributes = make(map[string]attr.Value, len(stateAttributes))
+
+		for name, stateAttribute := range stateAttributes {
+			planAttributes[name] = stateAttribute
+		}
+	} else {
+		configAttributes = req.ConfigValue.Attributes()
+		planAttributes = req.PlanValue.Attributes()
+	}
+
+	merger := &attributeMerger{
+		attributes:       m.attributes,
+		path:             req.Path,
+		planUnknown:      req.PlanValue.IsUnknown(),
+		configAttributes: configAttributes,
+		planAttributes:   planAttributes,
+		stateAttributes:  stateAttributes,
+		newAttributes:    make(map[string]attr.Value, len(planAttributes)),
+		merging:          make(map[string]bool),
+	}
+
+	names := make([]string, 0, len(planAttributes))
+
+	for name := range planAttributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		merger.merged(ctx, name)
+
+		if merger.diags.HasError() {
+			resp.Diagnostics.Append(merger.diags...)
+
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(merger.diags...)
+
+	planValue, diags := types.ObjectValue(req.PlanValue.AttributeTypes(ctx), merger.newAttributes)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	resp.PlanValue = planValue
+}
+
+// attributeMerger merges the underlying attributes of a single object,
+// merging the DependsOn attributes of each underlying attribute before the
+// attribute itself.
+type attributeMerger struct {
+	attributes map[string]MergeAttribute
+	path       path.Path
+
+	// planUnknown is true if the whole object is unknown in the plan.
+	planUnknown bool
+
+	configAttributes map[string]attr.Value
+	planAttributes   map[string]attr.Value
+	stateAttributes  map[string]attr.Value
+
+	// newAttributes contains the merged planned values.
+	newAttributes map[string]attr.Value
+
+	// merging contains the underlying attributes being merged, which
+	// detects DependsOn cycles.
+	merging map[string]bool
+
+	diags diag.Diagnostics
+}
+
+// merged returns the merged planned value of the underlying attribute.
+func (m *attributeMerger) merged(ctx context.Context, name string) attr.Value {
+	if value, ok := m.newAttributes[name]; ok {
+		return value
+	}
+
+	m.merging[name] = true
+	value := m.merge(ctx, name)
+	delete(m.merging, name)
+
+	m.newAttributes[name] = value
+
+	return value
+}
+
+// merge returns the planned value of the underlying attribute, merging its
+// DependsOn attributes first if necessary.
+func (m *attributeMerger) merge(ctx context.Context, name string) attr.Value {
+	planAttribute := m.planAttributes[name]
+
+	configAttribute, ok := m.configAttributes[name]
+
+	// Configured values are never modified.
+	if ok && !configAttribute.IsNull() {
+		return planAttribute
+	}
+
+	stateAttribute, ok := m.stateAttributes[name]
+
+	if !ok || stateAttribute.IsNull() || stateAttribute.IsUnknown() {
+		return planAttribute
+	}
+
+	if !planAttribute.IsUnknown() && !m.planUnknown {
+		return planAttribute
+	}
+
+	mergeAttribute := m.attributes[name]
+
+	if mergeAttribute.AlwaysUnknown || m.dependencyChanged(ctx, mergeAttribute) {
+		unknown, diags := unknownValue(ctx, m.path.AtName(name), planAttribute)
+
+		m.diags.Append(diags...)
+
+		return unknown
+	}
+
+	return stateAttribute
+}
+
+// dependencyChanged returns true if any DependsOn attribute merged planned
+// value is unknown or differs from its prior state value. Dependencies which
+// are still being merged form a cycle and are considered changed.
+func (m *attributeMerger) dependencyChanged(ctx context.Context, mergeAttribute MergeAttribute) bool {
+	for _, dependency := range mergeAttribute.DependsOn {
+		if _, ok := m.planAttributes[dependency]; !ok || m.merging[dependency] {
+			return true
+		}
+
+		planAttribute := m.merged(ctx, dependency)
+
+		if planAttribute.IsUnknown() {
+			return true
+		}
+
+		stateAttribute, ok := m.stateAttributes[dependency]
+
+		if !ok || !planAttribute.Equal(stateAttribute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unknownValue returns an unknown value of the same type as the given value.
+func unknownValue(ctx context.Context, p path.Path, value attr.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsUnknown() {
+		return value, diags
+	}
+
+	typ := value.Type(ctx)
+
+	unknown, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), tftypes.UnknownValue))
+
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Object Merge Error",
+			"An unexpected error was encountered trying to create an unknown value for the attribute. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return value, diags
+	}
+
+	return unknown, diags
+}
+
+func quoteStrings(values []string) []string {
+	result := make([]string, len(values))
+
+	for i, value := range values {
+		result[i] = fmt.Sprintf("%q", value)
+	}
+
+	return result
+}
@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package objectplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMergeWithStateModifierPlanModifyObject(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"arn":     types.StringType,
+		"etag":    types.StringType,
+		"id":      types.StringType,
+		"name":    types.StringType,
+		"managed": types.StringType,
+	}
+	attributes := map[string]objectplanmodifier.MergeAttribute{
+		"arn": {
+			DependsOn: []string{"name"},
+		},
+		"etag": {
+			AlwaysUnknown: true,
+		},
+	}
+
+	testCases := map[string]struct {
+		attributes map[string]objectplanmodifier.MergeAttribute
+		request    planmodifier.ObjectRequest
+		expected   *planmodifier.ObjectResponse
+	}{
+		"null-state": {
+			attributes: attributes,
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectNull(attrTypes),
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringUnknown(),
+				}),
+				ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringNull(),
+					"etag":    types.StringNull(),
+					"id":      types.StringNull(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringNull(),
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringUnknown(),
+				}),
+			},
+		},
+		"unknown-config": {
+			attributes: attributes,
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue:   types.ObjectUnknown(attrTypes),
+				ConfigValue: types.ObjectUnknown(attrTypes),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(attrTypes),
+			},
+		},
+		"unconfigured-object": {
+			attributes: attributes,
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue:   types.ObjectUnknown(attrTypes),
+				ConfigValue: types.ObjectNull(attrTypes),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+		},
+		"partially-configured-unchanged": {
+			attributes: attributes,
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("no"),
+				}),
+				ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringNull(),
+					"etag":    types.StringNull(),
+					"id":      types.StringNull(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("no"),
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("no"),
+				}),
+			},
+		},
+		"partially-configured-dependency-changed": {
+			attributes: attributes,
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("changed"),
+					"managed": types.StringValue("yes"),
+				}),
+				ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringNull(),
+					"etag":    types.StringNull(),
+					"id":      types.StringNull(),
+					"name":    types.StringValue("changed"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("changed"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+		},
+		"dependency-unconfigured-computed": {
+			attributes: map[string]objectplanmodifier.MergeAttribute{
+				"arn": {
+					DependsOn: []string{"id"},
+				},
+			},
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringNull(),
+					"etag":    types.StringNull(),
+					"id":      types.StringNull(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+		},
+		"dependency-chain-unchanged": {
+			attributes: map[string]objectplanmodifier.MergeAttribute{
+				"arn": {
+					DependsOn: []string{"id"},
+				},
+				"id": {
+					DependsOn: []string{"name"},
+				},
+			},
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringNull(),
+					"etag":    types.StringNull(),
+					"id":      types.StringNull(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+		},
+		"dependency-chain-changed": {
+			attributes: map[string]objectplanmodifier.MergeAttribute{
+				"arn": {
+					DependsOn: []string{"id"},
+				},
+				"id": {
+					DependsOn: []string{"name"},
+				},
+			},
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("changed"),
+					"managed": types.StringValue("yes"),
+				}),
+				ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringNull(),
+					"etag":    types.StringNull(),
+					"id":      types.StringNull(),
+					"name":    types.StringValue("changed"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("changed"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+		},
+		"dependency-cycle": {
+			attributes: map[string]objectplanmodifier.MergeAttribute{
+				"arn": {
+					DependsOn: []string{"id"},
+				},
+				"id": {
+					DependsOn: []string{"arn"},
+				},
+			},
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringNull(),
+					"etag":    types.StringNull(),
+					"id":      types.StringNull(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringUnknown(),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+		},
+		"known-plan-kept": {
+			attributes: nil,
+			request: planmodifier.ObjectRequest{
+				StateValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:test"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:other"),
+					"etag":    types.StringUnknown(),
+					"id":      types.StringUnknown(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+				ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringNull(),
+					"etag":    types.StringNull(),
+					"id":      types.StringNull(),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+					"arn":     types.StringValue("arn:other"),
+					"etag":    types.StringValue("etag1"),
+					"id":      types.StringValue("id1"),
+					"name":    types.StringValue("test"),
+					"managed": types.StringValue("yes"),
+				}),
+			},
+		},
+		"unknown-value-error": {
+			attributes: map[string]objectplanmodifier.MergeAttribute{
+				"invalid": {
+					AlwaysUnknown: true,
+				},
+			},
+			request: planmodifier.ObjectRequest{
+				Path: path.Root("test"),
+				StateValue: types.ObjectValueMust(
+					map[string]attr.Type{
+						"invalid": testtypes.InvalidType{},
+					},
+					map[string]attr.Value{
+						"invalid": testtypes.Invalid{},
+					},
+				),
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{
+					"invalid": testtypes.InvalidType{},
+				}),
+				ConfigValue: types.ObjectNull(map[string]attr.Type{
+					"invalid": testtypes.InvalidType{},
+				}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtName("invalid"),
+						"Object Merge Error",
+						"An unexpected error was encountered trying to create an unknown value for the attribute. "+
+							"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"intentional ValueFromTerraform error",
+					),
+				},
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{
+					"invalid": testtypes.InvalidType{},
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ObjectResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			objectplanmodifier.MergeWithState(testCase.attributes).PlanModifyObject(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeWithStateModifierDescription(t *testing.T) {
+	t.Parallel()
+
+	got := objectplanmodifier.MergeWithState(map[string]objectplanmodifier.MergeAttribute{
+		"arn": {
+			DependsOn: []string{"name", "region"},
+		},
+		"etag": {
+			AlwaysUnknown: true,
+		},
+		"id": {},
+	}).Description(context.Background())
+	expected := `Unconfigured values of this object in state will not change, except "arn" which is recomputed when "name", "region" change, except "etag" which is always recomputed.`
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package objectplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnknownWhenChangedModifierPlanModifyObject(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.ObjectAttribute{AttributeTypes: map[string]attr.Type{"nested": types.StringType}, Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Object) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Object) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Object) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.ObjectRequest
+		expected *planmodifier.ObjectResponse
+	}{
+		"state-null": {
+			// resource creation
+			request: planmodifier.ObjectRequest{
+				ConfigValue:    types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType})),
+				PlanValue:      types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType}),
+				State:          nullState,
+				StateValue:     types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType}),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.ObjectRequest{
+				ConfigValue:    types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+				State:          testState(types.StringValue("one"), types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")})),
+				StateValue:     types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+			},
+		},
+		"config-value": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue:    types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("two")}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("two")})),
+				PlanValue:      types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("two")}),
+				State:          testState(types.StringValue("one"), types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")})),
+				StateValue:     types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("two")}),
+			},
+		},
+		"dependency-unchanged": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue:    types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType})),
+				PlanValue:      types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType}),
+				State:          testState(types.StringValue("one"), types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")})),
+				StateValue:     types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")}),
+			},
+		},
+		"dependency-unchanged-state-null": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue:    types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType})),
+				PlanValue:      types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType}),
+				State:          testState(types.StringValue("one"), types.ObjectNull(map[string]attr.Type{"nested": types.StringType})),
+				StateValue:     types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType}),
+			},
+		},
+		"dependency-changed": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue:    types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")})),
+				PlanValue:      types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")}),
+				State:          testState(types.StringValue("one"), types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")})),
+				StateValue:     types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType}),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue:    types.ObjectNull(map[string]attr.Type{"nested": types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType})),
+				PlanValue:      types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType}),
+				State:          testState(types.StringValue("one"), types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")})),
+				StateValue:     types.ObjectValueMust(map[string]attr.Type{"nested": types.StringType}, map[string]attr.Value{"nested": types.StringValue("one")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"nested": types.StringType}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ObjectResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			objectplanmodifier.UnknownWhenChanged(path.MatchRoot("dependency")).PlanModifyObject(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -209,3 +209,19 @@ func nonComputedAttributeWithDefaultDiag(path path.Path) diag.Diagnostic {
 			"This is an issue with the provider and should be reported to the provider developers.",
 	)
 }
+
+// invalidKeyAttributeDiag returns a diagnostic for use when a nested attribute
+// object declares a key attribute which is not one of its underlying
+// attributes.
+func invalidKeyAttributeDiag(path path.Path, keyAttribute string) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q declares key attribute %q, which is not an attribute of the nested object. ", path.String(), keyAttribute)+
+			"Key attributes must be names of attributes in the nested object.",
+	)
+}
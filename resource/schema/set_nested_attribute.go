@@ -270,6 +270,12 @@ func (a SetNestedAttribute) SetValidators() []validator.Set {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a SetNestedAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	for _, keyAttribute := range a.NestedObject.KeyAttributes {
+		if _, ok := a.NestedObject.Attributes[keyAttribute]; !ok {
+			resp.Diagnostics.Append(invalidKeyAttributeDiag(req.Path, keyAttribute))
+		}
+	}
+
 	if a.SetDefaultValue() != nil {
 		if !a.IsComputed() {
 			resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
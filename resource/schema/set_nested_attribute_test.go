@@ -739,6 +739,52 @@ func TestSetNestedAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"key-attributes": {
+			attribute: schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_attr": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					KeyAttributes: []string{"test_attr"},
+				},
+				Optional: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"key-attributes-invalid": {
+			attribute: schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_attr": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					KeyAttributes: []string{"other_attr"},
+				},
+				Optional: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test\" declares key attribute \"other_attr\", which is not an attribute of the nested object. "+
+							"Key attributes must be names of attributes in the nested object.",
+					),
+				},
+			},
+		},
 		"default-without-computed": {
 			attribute: schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
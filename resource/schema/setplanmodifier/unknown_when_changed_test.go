@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnknownWhenChangedModifierPlanModifySet(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.SetAttribute{ElementType: types.StringType, Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Set) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Set) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Set) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.SetRequest
+		expected *planmodifier.SetResponse
+	}{
+		"state-null": {
+			// resource creation
+			request: planmodifier.SetRequest{
+				ConfigValue:    types.SetNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.SetUnknown(types.StringType)),
+				PlanValue:      types.SetUnknown(types.StringType),
+				State:          nullState,
+				StateValue:     types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.SetRequest{
+				ConfigValue:    types.SetNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.SetNull(types.StringType),
+				State:          testState(types.StringValue("one"), types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+		"config-value": {
+			request: planmodifier.SetRequest{
+				ConfigValue:    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("two")}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.SetValueMust(types.StringType, []attr.Value{types.StringValue("two")})),
+				PlanValue:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("two")}),
+				State:          testState(types.StringValue("one"), types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("two")}),
+			},
+		},
+		"dependency-unchanged": {
+			request: planmodifier.SetRequest{
+				ConfigValue:    types.SetNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.SetUnknown(types.StringType)),
+				PlanValue:      types.SetUnknown(types.StringType),
+				State:          testState(types.StringValue("one"), types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+		},
+		"dependency-unchanged-state-null": {
+			request: planmodifier.SetRequest{
+				ConfigValue:    types.SetNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.SetUnknown(types.StringType)),
+				PlanValue:      types.SetUnknown(types.StringType),
+				State:          testState(types.StringValue("one"), types.SetNull(types.StringType)),
+				StateValue:     types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"dependency-changed": {
+			request: planmodifier.SetRequest{
+				ConfigValue:    types.SetNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				PlanValue:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+				State:          testState(types.StringValue("one"), types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.SetRequest{
+				ConfigValue:    types.SetNull(types.StringType),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.SetUnknown(types.StringType)),
+				PlanValue:      types.SetUnknown(types.StringType),
+				State:          testState(types.StringValue("one"), types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.SetResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			setplanmodifier.UnknownWhenChanged(path.MatchRoot("dependency")).PlanModifySet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
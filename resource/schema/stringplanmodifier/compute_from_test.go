@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComputeFromModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.StringAttribute{Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.String) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.String) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.String) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	computeFunc := func(ctx context.Context, req stringplanmodifier.ComputeFromFuncRequest, resp *stringplanmodifier.ComputeFromFuncResponse) {
+		if len(req.Paths) != 1 || !req.Paths[0].Equal(path.Root("dependency")) {
+			resp.Diagnostics.AddError("Unexpected Paths", req.Paths.String())
+
+			return
+		}
+
+		if !req.Values[0].Equal(types.StringValue("two")) {
+			resp.Diagnostics.AddError("Unexpected Values", req.Values[0].String())
+
+			return
+		}
+
+		resp.PlanValue = types.StringValue("two")
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.StringNull(),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"config-value": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringValue("one"),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.StringValue("one")),
+				PlanValue:      types.StringValue("one"),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("one"),
+			},
+		},
+		"plan-known": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.StringValue("one")),
+				PlanValue:      types.StringValue("one"),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("one"),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.StringUnknown()),
+				PlanValue:      types.StringUnknown(),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"computed": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.StringUnknown()),
+				PlanValue:      types.StringUnknown(),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("two"),
+			},
+		},
+		"computed-state-null": {
+			// resource creation
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.StringUnknown()),
+				PlanValue:      types.StringUnknown(),
+				State:          nullState,
+				StateValue:     types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("two"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.ComputeFrom(path.Expressions{path.MatchRoot("dependency")}, computeFunc, "test", "test").PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
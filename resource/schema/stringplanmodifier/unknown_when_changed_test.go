@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnknownWhenChangedModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.StringAttribute{Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.String) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.String) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.String) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"state-null": {
+			// resource creation
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.StringUnknown()),
+				PlanValue:      types.StringUnknown(),
+				State:          nullState,
+				StateValue:     types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.StringNull(),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"config-value": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringValue("two"),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.StringValue("two")),
+				PlanValue:      types.StringValue("two"),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("two"),
+			},
+		},
+		"dependency-unchanged": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.StringUnknown()),
+				PlanValue:      types.StringUnknown(),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("one"),
+			},
+		},
+		"dependency-unchanged-state-null": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.StringUnknown()),
+				PlanValue:      types.StringUnknown(),
+				State:          testState(types.StringValue("one"), types.StringNull()),
+				StateValue:     types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"dependency-changed": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.StringValue("one")),
+				PlanValue:      types.StringValue("one"),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.StringUnknown()),
+				PlanValue:      types.StringUnknown(),
+				State:          testState(types.StringValue("one"), types.StringValue("one")),
+				StateValue:     types.StringValue("one"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.UnknownWhenChanged(path.MatchRoot("dependency")).PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnknownWhenChangedModifierPlanModifyStringNested(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"list": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":   schema.StringAttribute{Computed: true},
+						"name": schema.StringAttribute{Required: true},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+
+	elemType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}
+
+	testRaw := func(ids []string, names []string) tftypes.Value {
+		var elems []tftypes.Value
+
+		for i := range names {
+			var id tftypes.Value
+
+			if ids[i] == "" {
+				id = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+			} else {
+				id = tftypes.NewValue(tftypes.String, ids[i])
+			}
+
+			elems = append(elems, tftypes.NewValue(elemType, map[string]tftypes.Value{
+				"id":   id,
+				"name": tftypes.NewValue(tftypes.String, names[i]),
+			}))
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: elemType}, elems),
+			},
+		)
+	}
+
+	state := tfsdk.State{
+		Schema: testSchema,
+		Raw:    testRaw([]string{"id-one", "id-two"}, []string{"one", "two"}),
+	}
+	plan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw:    testRaw([]string{"", ""}, []string{"one", "changed"}),
+	}
+
+	testCases := map[string]struct {
+		index    int
+		expected *planmodifier.StringResponse
+	}{
+		"sibling-unchanged": {
+			index: 0,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("id-one"),
+			},
+		},
+		"sibling-changed": {
+			index: 1,
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributePath := path.Root("list").AtListIndex(testCase.index).AtName("id")
+
+			var stateValue types.String
+
+			if diags := state.GetAttribute(context.Background(), attributePath, &stateValue); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			req := planmodifier.StringRequest{
+				ConfigValue:    types.StringNull(),
+				Path:           attributePath,
+				PathExpression: attributePath.Expression(),
+				Plan:           plan,
+				PlanValue:      types.StringUnknown(),
+				State:          state,
+				StateValue:     stateValue,
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: req.PlanValue,
+			}
+
+			stringplanmodifier.UnknownWhenChanged(path.MatchRelative().AtParent().AtName("name")).PlanModifyString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tupledefault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StaticValue returns a static tuple value default handler.
+//
+// Use StaticValue if a static default value for a tuple should be set.
+func StaticValue(defaultVal types.Tuple) defaults.Tuple {
+	return staticValueDefault{
+		defaultVal: defaultVal,
+	}
+}
+
+// staticValueDefault is static value default handler that
+// sets a value on a tuple attribute.
+type staticValueDefault struct {
+	defaultVal types.Tuple
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d staticValueDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to %v", d.defaultVal)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d staticValueDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to `%v`", d.defaultVal)
+}
+
+// DefaultTuple implements the static default value logic.
+func (d staticValueDefault) DefaultTuple(ctx context.Context, req defaults.TupleRequest, resp *defaults.TupleResponse) {
+	resp.PlanValue = d.defaultVal
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tupledefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/tupledefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStaticValueDefaultTuple(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		defaultVal types.Tuple
+		expected   *defaults.TupleResponse
+	}{
+		"tuple": {
+			defaultVal: types.TupleValueMust(
+				[]attr.Type{
+					types.StringType,
+					types.Int64Type,
+				},
+				[]attr.Value{
+					types.StringValue("test-value"),
+					types.Int64Value(1),
+				},
+			),
+			expected: &defaults.TupleResponse{
+				PlanValue: types.TupleValueMust(
+					[]attr.Type{
+						types.StringType,
+						types.Int64Type,
+					},
+					[]attr.Value{
+						types.StringValue("test-value"),
+						types.Int64Value(1),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.TupleResponse{}
+
+			tupledefault.StaticValue(testCase.defaultVal).DefaultTuple(context.Background(), defaults.TupleRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
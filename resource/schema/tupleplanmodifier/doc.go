@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tupleplanmodifier provides plan modifiers for types.Tuple attributes.
+package tupleplanmodifier
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tupleplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/tupleplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfModifierPlanModifyTuple(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"testattr": schema.TupleAttribute{
+				ElementTypes: []attr.Type{types.StringType},
+			},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testPlan := func(value types.Tuple) tfsdk.Plan {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testState := func(value types.Tuple) tfsdk.State {
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"testattr": tfValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.TupleRequest
+		ifFunc   tupleplanmodifier.RequiresReplaceIfFunc
+		expected *planmodifier.TupleResponse
+	}{
+		"state-null": {
+			// resource creation
+			request: planmodifier.TupleRequest{
+				Plan:       testPlan(types.TupleUnknown([]attr.Type{types.StringType})),
+				PlanValue:  types.TupleUnknown([]attr.Type{types.StringType}),
+				State:      nullState,
+				StateValue: types.TupleNull([]attr.Type{types.StringType}),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.TupleRequest, resp *tupleplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = true // should never reach here
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue:       types.TupleUnknown([]attr.Type{types.StringType}),
+				RequiresReplace: false,
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.TupleRequest{
+				Plan:       nullPlan,
+				PlanValue:  types.TupleNull([]attr.Type{types.StringType}),
+				State:      testState(types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")})),
+				StateValue: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.TupleRequest, resp *tupleplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = true // should never reach here
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue:       types.TupleNull([]attr.Type{types.StringType}),
+				RequiresReplace: false,
+			},
+		},
+		"planvalue-statevalue-different-if-false": {
+			request: planmodifier.TupleRequest{
+				Plan:       testPlan(types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("other")})),
+				PlanValue:  types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("other")}),
+				State:      testState(types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")})),
+				StateValue: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.TupleRequest, resp *tupleplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = false // no change
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue:       types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("other")}),
+				RequiresReplace: false,
+			},
+		},
+		"planvalue-statevalue-different-if-true": {
+			request: planmodifier.TupleRequest{
+				Plan:       testPlan(types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("other")})),
+				PlanValue:  types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("other")}),
+				State:      testState(types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")})),
+				StateValue: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.TupleRequest, resp *tupleplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = true // should reach here
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue:       types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("other")}),
+				RequiresReplace: true,
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.TupleRequest{
+				Plan:       testPlan(types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")})),
+				PlanValue:  types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+				State:      testState(types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")})),
+				StateValue: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+			ifFunc: func(ctx context.Context, req planmodifier.TupleRequest, resp *tupleplanmodifier.RequiresReplaceIfFuncResponse) {
+				resp.RequiresReplace = true // should never reach here
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue:       types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+				RequiresReplace: false,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.TupleResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			tupleplanmodifier.RequiresReplaceIf(testCase.ifFunc, "test", "test").PlanModifyTuple(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tupleplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/tupleplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnknownWhenChangedModifierPlanModifyTuple(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dependency": schema.StringAttribute{Optional: true},
+			"testattr":   schema.TupleAttribute{ElementTypes: []attr.Type{types.StringType}, Computed: true},
+		},
+	}
+
+	nullPlan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	nullState := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			nil,
+		),
+	}
+
+	testRaw := func(dependency types.String, value types.Tuple) tftypes.Value {
+		dependencyValue, err := dependency.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		tfValue, err := value.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"dependency": dependencyValue,
+				"testattr":   tfValue,
+			},
+		)
+	}
+
+	testPlan := func(dependency types.String, value types.Tuple) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testState := func(dependency types.String, value types.Tuple) tfsdk.State {
+		return tfsdk.State{
+			Schema: testSchema,
+			Raw:    testRaw(dependency, value),
+		}
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.TupleRequest
+		expected *planmodifier.TupleResponse
+	}{
+		"state-null": {
+			// resource creation
+			request: planmodifier.TupleRequest{
+				ConfigValue:    types.TupleNull([]attr.Type{types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.TupleUnknown([]attr.Type{types.StringType})),
+				PlanValue:      types.TupleUnknown([]attr.Type{types.StringType}),
+				State:          nullState,
+				StateValue:     types.TupleNull([]attr.Type{types.StringType}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			request: planmodifier.TupleRequest{
+				ConfigValue:    types.TupleNull([]attr.Type{types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           nullPlan,
+				PlanValue:      types.TupleNull([]attr.Type{types.StringType}),
+				State:          testState(types.StringValue("one"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleNull([]attr.Type{types.StringType}),
+			},
+		},
+		"config-value": {
+			request: planmodifier.TupleRequest{
+				ConfigValue:    types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("two")}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("two")})),
+				PlanValue:      types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("two")}),
+				State:          testState(types.StringValue("one"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("two")}),
+			},
+		},
+		"dependency-unchanged": {
+			request: planmodifier.TupleRequest{
+				ConfigValue:    types.TupleNull([]attr.Type{types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.TupleUnknown([]attr.Type{types.StringType})),
+				PlanValue:      types.TupleUnknown([]attr.Type{types.StringType}),
+				State:          testState(types.StringValue("one"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")}),
+			},
+		},
+		"dependency-unchanged-state-null": {
+			request: planmodifier.TupleRequest{
+				ConfigValue:    types.TupleNull([]attr.Type{types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("one"), types.TupleUnknown([]attr.Type{types.StringType})),
+				PlanValue:      types.TupleUnknown([]attr.Type{types.StringType}),
+				State:          testState(types.StringValue("one"), types.TupleNull([]attr.Type{types.StringType})),
+				StateValue:     types.TupleNull([]attr.Type{types.StringType}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+		},
+		"dependency-changed": {
+			request: planmodifier.TupleRequest{
+				ConfigValue:    types.TupleNull([]attr.Type{types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringValue("two"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")})),
+				PlanValue:      types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")}),
+				State:          testState(types.StringValue("one"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+		},
+		"dependency-unknown": {
+			request: planmodifier.TupleRequest{
+				ConfigValue:    types.TupleNull([]attr.Type{types.StringType}),
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           testPlan(types.StringUnknown(), types.TupleUnknown([]attr.Type{types.StringType})),
+				PlanValue:      types.TupleUnknown([]attr.Type{types.StringType}),
+				State:          testState(types.StringValue("one"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")})),
+				StateValue:     types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("one")}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.TupleResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			tupleplanmodifier.UnknownWhenChanged(path.MatchRoot("dependency")).PlanModifyTuple(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tupleplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/tupleplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseStateForUnknownModifierPlanModifyTuple(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.TupleRequest
+		expected *planmodifier.TupleResponse
+	}{
+		"null-state": {
+			// when we first create the resource, use the unknown
+			// value
+			request: planmodifier.TupleRequest{
+				StateValue:  types.TupleNull([]attr.Type{types.StringType}),
+				PlanValue:   types.TupleUnknown([]attr.Type{types.StringType}),
+				ConfigValue: types.TupleNull([]attr.Type{types.StringType}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+		},
+		"known-plan": {
+			// this would really only happen if we had a plan
+			// modifier setting the value before this plan modifier
+			// got to it
+			//
+			// but we still want to preserve that value, in this
+			// case
+			request: planmodifier.TupleRequest{
+				StateValue:  types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("other")}),
+				PlanValue:   types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+				ConfigValue: types.TupleNull([]attr.Type{types.StringType}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"non-null-state-unknown-plan": {
+			// this is the situation we want to preserve the state
+			// in
+			request: planmodifier.TupleRequest{
+				StateValue:  types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.TupleUnknown([]attr.Type{types.StringType}),
+				ConfigValue: types.TupleNull([]attr.Type{types.StringType}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"unknown-config": {
+			// this is the situation in which a user is
+			// interpolating into a field. We want that to still
+			// show up as unknown, otherwise they'll get apply-time
+			// errors for changing the value even though we knew it
+			// was legitimately possible for it to change and the
+			// provider can't prevent this from happening
+			request: planmodifier.TupleRequest{
+				StateValue:  types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+				PlanValue:   types.TupleUnknown([]attr.Type{types.StringType}),
+				ConfigValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+		},
+		"under-list": {
+			request: planmodifier.TupleRequest{
+				ConfigValue: types.TupleNull([]attr.Type{types.StringType}),
+				Path:        path.Root("test").AtListIndex(0).AtName("nested_test"),
+				PlanValue:   types.TupleUnknown([]attr.Type{types.StringType}),
+				StateValue:  types.TupleNull([]attr.Type{types.StringType}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+		},
+		"under-set": {
+			request: planmodifier.TupleRequest{
+				ConfigValue: types.TupleNull([]attr.Type{types.StringType}),
+				Path: path.Root("test").AtSetValue(
+					types.SetValueMust(
+						types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested_test": types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+							},
+						},
+						[]attr.Value{
+							types.ObjectValueMust(
+								map[string]attr.Type{
+									"nested_test": types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+								},
+								map[string]attr.Value{
+									"nested_test": types.TupleUnknown([]attr.Type{types.StringType}),
+								},
+							),
+						},
+					),
+				).AtName("nested_test"),
+				PlanValue:  types.TupleUnknown([]attr.Type{types.StringType}),
+				StateValue: types.TupleNull([]attr.Type{types.StringType}),
+			},
+			expected: &planmodifier.TupleResponse{
+				PlanValue: types.TupleUnknown([]attr.Type{types.StringType}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.TupleResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			tupleplanmodifier.UseStateForUnknown().PlanModifyTuple(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
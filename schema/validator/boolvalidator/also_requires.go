@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AlsoRequires checks that a set of path.Expression has a non-null value,
+// if the current attribute also has a non-null value.
+//
+// Relative path.Expression will be resolved using the attribute being
+// validated.
+func AlsoRequires(expressions ...path.Expression) validator.Bool {
+	return schemavalidator.AlsoRequiresValidator{
+		PathExpressions: expressions,
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAtLeastOneOfValidateBool(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"other": schema.StringAttribute{Optional: true},
+			"test":  schema.BoolAttribute{Optional: true},
+		},
+	}
+
+	testValue := types.BoolNull()
+
+	testConfig := func(other tftypes.Value) tfsdk.Config {
+		tfValue, err := testValue.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"other": other,
+					"test":  tfValue,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		other    tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"other-null": {
+			other: tftypes.NewValue(tftypes.String, nil),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Combination",
+					"At least one attribute out of [other] must be specified",
+				),
+			},
+		},
+		"other-unknown": {
+			other:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: nil,
+		},
+		"other-value": {
+			other:    tftypes.NewValue(tftypes.String, "test"),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.BoolRequest{
+				Config:         testConfig(testCase.other),
+				ConfigValue:    testValue,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.BoolResponse{}
+
+			boolvalidator.AtLeastOneOf(path.MatchRoot("other")).ValidateBool(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
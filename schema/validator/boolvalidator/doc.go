@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package boolvalidator provides validators for types.Bool attributes.
+package boolvalidator
@@ -33,4 +33,7 @@
 //
 // Validators that are not type dependent need to implement all interfaces,
 // but can use shared logic to reduce implementation code.
+//
+// Common use case implementations are provided in the typed subpackages, such
+// as stringvalidator and listvalidator.
 package validator
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float32 = atLeastValidator{}
+
+// atLeastValidator validates that a float32 attribute value is greater than or equal to the given minimum.
+type atLeastValidator struct {
+	min float32
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %f", v.min)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat32 performs the validation.
+func (v atLeastValidator) ValidateFloat32(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat32()
+
+	if value < v.min {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%f", value),
+		))
+	}
+}
+
+// AtLeast returns a validator which ensures that any configured attribute value
+// is greater than or equal to the given minimum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func AtLeast(min float32) validator.Float32 {
+	return atLeastValidator{
+		min: min,
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AtLeastOneOf checks that of a set of path.Expression, including the
+// attribute this validator is applied to, at least one attribute out of all
+// specified is has a non-null value.
+//
+// Relative path.Expression will be resolved using the attribute being
+// validated.
+func AtLeastOneOf(expressions ...path.Expression) validator.Float32 {
+	return schemavalidator.AtLeastOneOfValidator{
+		PathExpressions: expressions,
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float32 = betweenValidator{}
+
+// betweenValidator validates that a float32 attribute value is greater than or equal to the given minimum and less than or equal to the given maximum.
+type betweenValidator struct {
+	min float32
+	max float32
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %f and %f", v.min, v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat32 performs the validation.
+func (v betweenValidator) ValidateFloat32(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat32()
+
+	if value < v.min || value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%f", value),
+		))
+	}
+}
+
+// Between returns a validator which ensures that any configured attribute value
+// is greater than or equal to the given minimum and less than or equal to the given maximum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func Between(min, max float32) validator.Float32 {
+	return betweenValidator{
+		min: min,
+		max: max,
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float32 = noneOfValidator{}
+
+// noneOfValidator validates that a float32 attribute value is none of the given values.
+type noneOfValidator struct {
+	values []float32
+}
+
+// Description describes the validation in plain text formatting.
+func (v noneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %v", v.values)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v noneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat32 performs the validation.
+func (v noneOfValidator) ValidateFloat32(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat32()
+
+	for _, otherValue := range v.values {
+		if value != otherValue {
+			continue
+		}
+
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%f", value),
+		))
+
+		break
+	}
+}
+
+// NoneOf returns a validator which ensures that any configured attribute value
+// is none of the given values. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func NoneOf(values ...float32) validator.Float32 {
+	return noneOfValidator{
+		values: values,
+	}
+}
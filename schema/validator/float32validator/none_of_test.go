@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNoneOfValidateFloat32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Float32
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.Float32Unknown(),
+			expected: nil,
+		},
+		"null": {
+			value:    types.Float32Null(),
+			expected: nil,
+		},
+		"no-match": {
+			value:    types.Float32Value(3.3),
+			expected: nil,
+		},
+		"match": {
+			value: types.Float32Value(2.2),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					"Attribute test value must be none of: [1.1 2.2], got: 2.200000",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Float32Request{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.Float32Response{}
+
+			float32validator.NoneOf(1.1, 2.2).ValidateFloat32(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float64 = atMostValidator{}
+
+// atMostValidator validates that a float64 attribute value is less than or equal to the given maximum.
+type atMostValidator struct {
+	max float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %f", v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v atMostValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%f", value),
+		))
+	}
+}
+
+// AtMost returns a validator which ensures that any configured attribute value
+// is less than or equal to the given maximum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func AtMost(max float64) validator.Float64 {
+	return atMostValidator{
+		max: max,
+	}
+}
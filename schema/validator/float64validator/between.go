@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Float64 = betweenValidator{}
+
+// betweenValidator validates that a float64 attribute value is greater than or equal to the given minimum and less than or equal to the given maximum.
+type betweenValidator struct {
+	min float64
+	max float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %f and %f", v.min, v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 performs the validation.
+func (v betweenValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value < v.min || value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%f", value),
+		))
+	}
+}
+
+// Between returns a validator which ensures that any configured attribute value
+// is greater than or equal to the given minimum and less than or equal to the given maximum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func Between(min, max float64) validator.Float64 {
+	return betweenValidator{
+		min: min,
+		max: max,
+	}
+}
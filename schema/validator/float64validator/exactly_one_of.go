@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ExactlyOneOf checks that of a set of path.Expression, including the
+// attribute this validator is applied to, one and only one attribute has a
+// value. It will also cause a validation error if none are specified.
+//
+// Relative path.Expression will be resolved using the attribute being
+// validated.
+func ExactlyOneOf(expressions ...path.Expression) validator.Float64 {
+	return schemavalidator.ExactlyOneOfValidator{
+		PathExpressions: expressions,
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNoneOfValidateFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Float64
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.Float64Unknown(),
+			expected: nil,
+		},
+		"null": {
+			value:    types.Float64Null(),
+			expected: nil,
+		},
+		"no-match": {
+			value:    types.Float64Value(3.3),
+			expected: nil,
+		},
+		"match": {
+			value: types.Float64Value(2.2),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					"Attribute test value must be none of: [1.1 2.2], got: 2.200000",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Float64Request{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.Float64Response{}
+
+			float64validator.NoneOf(1.1, 2.2).ValidateFloat64(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
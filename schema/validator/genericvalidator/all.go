@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ validator.Bool    = allValidator{}
+	_ validator.Float32 = allValidator{}
+	_ validator.Float64 = allValidator{}
+	_ validator.Int32   = allValidator{}
+	_ validator.Int64   = allValidator{}
+	_ validator.List    = allValidator{}
+	_ validator.Map     = allValidator{}
+	_ validator.Number  = allValidator{}
+	_ validator.Object  = allValidator{}
+	_ validator.Set     = allValidator{}
+	_ validator.String  = allValidator{}
+	_ validator.Tuple   = allValidator{}
+)
+
+// AllBool returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyBool to group
+// validations which must be satisfied together.
+func AllBool(validators ...validator.Bool) validator.Bool {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllFloat32 returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyFloat32 to group
+// validations which must be satisfied together.
+func AllFloat32(validators ...validator.Float32) validator.Float32 {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllFloat64 returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyFloat64 to group
+// validations which must be satisfied together.
+func AllFloat64(validators ...validator.Float64) validator.Float64 {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllInt32 returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyInt32 to group
+// validations which must be satisfied together.
+func AllInt32(validators ...validator.Int32) validator.Int32 {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllInt64 returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyInt64 to group
+// validations which must be satisfied together.
+func AllInt64(validators ...validator.Int64) validator.Int64 {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllList returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyList to group
+// validations which must be satisfied together.
+func AllList(validators ...validator.List) validator.List {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllMap returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyMap to group
+// validations which must be satisfied together.
+func AllMap(validators ...validator.Map) validator.Map {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllNumber returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyNumber to group
+// validations which must be satisfied together.
+func AllNumber(validators ...validator.Number) validator.Number {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllObject returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyObject to group
+// validations which must be satisfied together.
+func AllObject(validators ...validator.Object) validator.Object {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllSet returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnySet to group
+// validations which must be satisfied together.
+func AllSet(validators ...validator.Set) validator.Set {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllString returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyString to group
+// validations which must be satisfied together.
+func AllString(validators ...validator.String) validator.String {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// AllTuple returns a validator which runs all of the given validators and
+// returns all of their diagnostics. This is useful within AnyTuple to group
+// validations which must be satisfied together.
+func AllTuple(validators ...validator.Tuple) validator.Tuple {
+	return allValidator{
+		validators: describers(validators),
+	}
+}
+
+// allValidator implements the All validator.
+type allValidator struct {
+	validators []validator.Describer
+}
+
+// Description describes the validation in plain text formatting.
+func (v allValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy all of the validations: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v allValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy all of the validations: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateBool implements validator.Bool.
+func (v allValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.BoolResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Bool).ValidateBool(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateFloat32 implements validator.Float32.
+func (v allValidator) ValidateFloat32(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.Float32Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Float32).ValidateFloat32(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateFloat64 implements validator.Float64.
+func (v allValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.Float64Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Float64).ValidateFloat64(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateInt32 implements validator.Int32.
+func (v allValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.Int32Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Int32).ValidateInt32(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateInt64 implements validator.Int64.
+func (v allValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.Int64Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Int64).ValidateInt64(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateList implements validator.List.
+func (v allValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.ListResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.List).ValidateList(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateMap implements validator.Map.
+func (v allValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.MapResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Map).ValidateMap(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateNumber implements validator.Number.
+func (v allValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.NumberResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Number).ValidateNumber(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateObject implements validator.Object.
+func (v allValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.ObjectResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Object).ValidateObject(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateSet implements validator.Set.
+func (v allValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.SetResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Set).ValidateSet(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateString implements validator.String.
+func (v allValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.StringResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.String).ValidateString(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
+
+// ValidateTuple implements validator.Tuple.
+func (v allValidator) ValidateTuple(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+	for _, subValidator := range v.validators {
+		subResp := &validator.TupleResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Tuple).ValidateTuple(ctx, req, subResp)
+
+		resp.Diagnostics.Append(subResp.Diagnostics...)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/genericvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAllDescription(t *testing.T) {
+	t.Parallel()
+
+	v := genericvalidator.AllString(
+		stringvalidator.LengthAtLeast(2),
+		stringvalidator.LengthAtMost(4),
+	)
+
+	expected := "value must satisfy all of the validations: string length must be at least 2 + string length must be at most 4"
+
+	if got := v.Description(context.Background()); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestAllValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Int64
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.Int64Null(),
+			expected: nil,
+		},
+		"valid": {
+			value:    types.Int64Value(3),
+			expected: nil,
+		},
+		"invalid-one": {
+			value: types.Int64Value(1),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at least 2, got: 1",
+				),
+			},
+		},
+		"invalid-both": {
+			value: types.Int64Value(10),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at most 4, got: 10",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					"Attribute test value must be none of: [10], got: 10",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int64Request{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.Int64Response{}
+
+			genericvalidator.AllInt64(
+				int64validator.AtLeast(2),
+				int64validator.AtMost(4),
+				int64validator.NoneOf(10),
+			).ValidateInt64(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAllValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.String
+		expected diag.Diagnostics
+	}{
+		"valid": {
+			value:    types.StringValue("abc"),
+			expected: nil,
+		},
+		"invalid": {
+			value: types.StringValue("a"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be at least 2, got: 1",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.StringResponse{}
+
+			genericvalidator.AllString(
+				stringvalidator.LengthAtLeast(2),
+				stringvalidator.LengthAtMost(4),
+			).ValidateString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAllValidateString_Concrete(t *testing.T) {
+	t.Parallel()
+
+	first := diag.NewAttributeWarningDiagnostic(path.Root("test"), "First", "first detail")
+	second := diag.NewAttributeErrorDiagnostic(path.Root("test"), "Second", "second detail")
+
+	req := validator.StringRequest{
+		ConfigValue:    types.StringValue("a"),
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+	}
+	resp := &validator.StringResponse{}
+
+	// Concrete validator types are converted to validator.String by the
+	// typed constructors.
+	genericvalidator.NotString(genericvalidator.AllString(
+		testStringValidator{diags: diag.Diagnostics{first}},
+		testStringValidator{diags: diag.Diagnostics{second}},
+	)).ValidateString(context.Background(), req, resp)
+
+	if diff := cmp.Diff(diag.Diagnostics(nil), resp.Diagnostics); diff != "" {
+		t.Errorf("unexpected Not difference: %s", diff)
+	}
+
+	resp = &validator.StringResponse{}
+
+	genericvalidator.AllString(
+		testStringValidator{diags: diag.Diagnostics{first}},
+		testStringValidator{diags: diag.Diagnostics{second}},
+	).ValidateString(context.Background(), req, resp)
+
+	if diff := cmp.Diff(diag.Diagnostics{first, second}, resp.Diagnostics); diff != "" {
+		t.Errorf("unexpected All difference: %s", diff)
+	}
+}
@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ validator.Bool    = anyValidator{}
+	_ validator.Float32 = anyValidator{}
+	_ validator.Float64 = anyValidator{}
+	_ validator.Int32   = anyValidator{}
+	_ validator.Int64   = anyValidator{}
+	_ validator.List    = anyValidator{}
+	_ validator.Map     = anyValidator{}
+	_ validator.Number  = anyValidator{}
+	_ validator.Object  = anyValidator{}
+	_ validator.Set     = anyValidator{}
+	_ validator.String  = anyValidator{}
+	_ validator.Tuple   = anyValidator{}
+)
+
+// AnyBool returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyBool(validators ...validator.Bool) validator.Bool {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyFloat32 returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyFloat32(validators ...validator.Float32) validator.Float32 {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyFloat64 returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyFloat64(validators ...validator.Float64) validator.Float64 {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyInt32 returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyInt32(validators ...validator.Int32) validator.Int32 {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyInt64 returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyInt64(validators ...validator.Int64) validator.Int64 {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyList returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyList(validators ...validator.List) validator.List {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyMap returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyMap(validators ...validator.Map) validator.Map {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyNumber returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyNumber(validators ...validator.Number) validator.Number {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyObject returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyObject(validators ...validator.Object) validator.Object {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnySet returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnySet(validators ...validator.Set) validator.Set {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyString returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyString(validators ...validator.String) validator.String {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// AnyTuple returns a validator which passes if any of the given validators
+// pass. Validators are run in order until one returns no error diagnostics, in
+// which case only the diagnostics of that validator, such as warnings, are
+// returned. If no validator passes, the diagnostics of all validators are
+// returned.
+func AnyTuple(validators ...validator.Tuple) validator.Tuple {
+	return anyValidator{
+		validators: describers(validators),
+	}
+}
+
+// anyValidator implements the Any validator.
+type anyValidator struct {
+	validators []validator.Describer
+}
+
+// Description describes the validation in plain text formatting.
+func (v anyValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy at least one of the validations: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v anyValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("value must satisfy at least one of the validations: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateBool implements validator.Bool.
+func (v anyValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.BoolResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Bool).ValidateBool(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateFloat32 implements validator.Float32.
+func (v anyValidator) ValidateFloat32(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.Float32Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Float32).ValidateFloat32(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateFloat64 implements validator.Float64.
+func (v anyValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.Float64Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Float64).ValidateFloat64(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateInt32 implements validator.Int32.
+func (v anyValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.Int32Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Int32).ValidateInt32(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateInt64 implements validator.Int64.
+func (v anyValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.Int64Response{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Int64).ValidateInt64(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateList implements validator.List.
+func (v anyValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.ListResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.List).ValidateList(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateMap implements validator.Map.
+func (v anyValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.MapResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Map).ValidateMap(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateNumber implements validator.Number.
+func (v anyValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.NumberResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Number).ValidateNumber(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateObject implements validator.Object.
+func (v anyValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.ObjectResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Object).ValidateObject(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateSet implements validator.Set.
+func (v anyValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.SetResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Set).ValidateSet(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateString implements validator.String.
+func (v anyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.StringResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.String).ValidateString(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateTuple implements validator.Tuple.
+func (v anyValidator) ValidateTuple(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+	var diags diag.Diagnostics
+
+	for _, subValidator := range v.validators {
+		subResp := &validator.TupleResponse{}
+
+		//nolint:forcetypeassert // Guaranteed by the typed constructors
+		subValidator.(validator.Tuple).ValidateTuple(ctx, req, subResp)
+
+		if !subResp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(subResp.Diagnostics...)
+
+			return
+		}
+
+		diags.Append(subResp.Diagnostics...)
+	}
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/genericvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAnyDescription(t *testing.T) {
+	t.Parallel()
+
+	v := genericvalidator.AnyString(
+		stringvalidator.OneOf("a"),
+		stringvalidator.LengthAtLeast(3),
+	)
+
+	expected := "value must satisfy at least one of the validations: value must be one of: [\"a\"] + string length must be at least 3"
+
+	if got := v.Description(context.Background()); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestAnyValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.String
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.StringNull(),
+			expected: nil,
+		},
+		"first": {
+			value:    types.StringValue("a"),
+			expected: nil,
+		},
+		"second": {
+			value:    types.StringValue("abcd"),
+			expected: nil,
+		},
+		"all-invalid": {
+			value: types.StringValue("ab"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					"Attribute test value must be one of: [\"a\"], got: \"ab\"",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be at least 3, got: 2",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.StringResponse{}
+
+			genericvalidator.AnyString(
+				stringvalidator.OneOf("a"),
+				stringvalidator.LengthAtLeast(3),
+			).ValidateString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAnyValidateString_Warnings(t *testing.T) {
+	t.Parallel()
+
+	warning := diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning", "warning detail")
+
+	warningValidator := genericvalidator.AllString(
+		stringvalidator.LengthAtLeast(1),
+		testStringValidator{diags: diag.Diagnostics{warning}},
+	)
+	ignoredValidator := testStringValidator{
+		diags: diag.Diagnostics{
+			diag.NewAttributeWarningDiagnostic(path.Root("test"), "Ignored", "ignored detail"),
+		},
+	}
+
+	req := validator.StringRequest{
+		ConfigValue:    types.StringValue("a"),
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+	}
+	resp := &validator.StringResponse{}
+
+	genericvalidator.AnyString(
+		stringvalidator.LengthAtLeast(3),
+		warningValidator,
+		ignoredValidator,
+	).ValidateString(context.Background(), req, resp)
+
+	if diff := cmp.Diff(diag.Diagnostics{warning}, resp.Diagnostics); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestAnyValidateString_ExistingDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	existing := diag.NewAttributeWarningDiagnostic(path.Root("other"), "Existing", "existing detail")
+	warning := diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning", "warning detail")
+
+	req := validator.StringRequest{
+		ConfigValue:    types.StringValue("a"),
+		Path:           path.Root("test"),
+		PathExpression: path.MatchRoot("test"),
+	}
+	resp := &validator.StringResponse{
+		Diagnostics: diag.Diagnostics{existing},
+	}
+
+	genericvalidator.AnyString(
+		testStringValidator{diags: diag.Diagnostics{warning}},
+	).ValidateString(context.Background(), req, resp)
+
+	if diff := cmp.Diff(diag.Diagnostics{existing, warning}, resp.Diagnostics); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+// testStringValidator returns the configured diagnostics.
+type testStringValidator struct {
+	diags diag.Diagnostics
+}
+
+func (v testStringValidator) Description(_ context.Context) string {
+	return "test validator"
+}
+
+func (v testStringValidator) MarkdownDescription(_ context.Context) string {
+	return "test validator"
+}
+
+func (v testStringValidator) ValidateString(_ context.Context, _ validator.StringRequest, resp *validator.StringResponse) {
+	resp.Diagnostics.Append(v.diags...)
+}
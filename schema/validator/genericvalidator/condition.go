@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Condition determines whether the validator passed to If is run.
+type Condition interface {
+	validator.Describer
+
+	// Evaluate returns true if the condition is met. Returning error
+	// diagnostics prevents the validator from running.
+	Evaluate(context.Context, ConditionRequest) (bool, diag.Diagnostics)
+}
+
+// ConditionRequest is the request passed to Condition Evaluate.
+type ConditionRequest struct {
+	// Config contains the entire configuration of the data source, provider,
+	// or resource.
+	Config tfsdk.Config
+
+	// ConfigValue contains the value of the attribute for validation from
+	// the configuration.
+	ConfigValue attr.Value
+
+	// Path contains the path of the attribute for validation.
+	Path path.Path
+
+	// PathExpression contains the expression matching the exact path of the
+	// attribute for validation.
+	PathExpression path.Expression
+}
+
+// ConditionFunc returns a Condition which calls the given function. The
+// description is used for both plain text and Markdown descriptions.
+func ConditionFunc(description string, f func(context.Context, ConditionRequest) (bool, diag.Diagnostics)) Condition {
+	return conditionFunc{
+		description: description,
+		f:           f,
+	}
+}
+
+// conditionFunc implements ConditionFunc.
+type conditionFunc struct {
+	description string
+	f           func(context.Context, ConditionRequest) (bool, diag.Diagnostics)
+}
+
+// Description describes the condition in plain text formatting.
+func (c conditionFunc) Description(_ context.Context) string {
+	return c.description
+}
+
+// MarkdownDescription describes the condition in Markdown formatting.
+func (c conditionFunc) MarkdownDescription(_ context.Context) string {
+	return c.description
+}
+
+// Evaluate implements Condition.
+func (c conditionFunc) Evaluate(ctx context.Context, req ConditionRequest) (bool, diag.Diagnostics) {
+	return c.f(ctx, req)
+}
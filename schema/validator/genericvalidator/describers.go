@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// describers converts typed validators into their common interface so they
+// can be stored by the combinator implementations.
+func describers[T validator.Describer](validators []T) []validator.Describer {
+	result := make([]validator.Describer, len(validators))
+
+	for i, v := range validators {
+		result[i] = v
+	}
+
+	return result
+}
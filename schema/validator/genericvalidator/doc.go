@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package genericvalidator provides validators which combine other
+// validators, such as AllString and AnyString. Each combinator has a typed
+// constructor for every typed validator interface in the schema/validator
+// package, such as AllString for validator.String and AllInt64 for
+// validator.Int64.
+package genericvalidator
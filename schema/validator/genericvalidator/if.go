@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ validator.Bool    = ifValidator{}
+	_ validator.Float32 = ifValidator{}
+	_ validator.Float64 = ifValidator{}
+	_ validator.Int32   = ifValidator{}
+	_ validator.Int64   = ifValidator{}
+	_ validator.List    = ifValidator{}
+	_ validator.Map     = ifValidator{}
+	_ validator.Number  = ifValidator{}
+	_ validator.Object  = ifValidator{}
+	_ validator.Set     = ifValidator{}
+	_ validator.String  = ifValidator{}
+	_ validator.Tuple   = ifValidator{}
+)
+
+// IfBool returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfBool(condition Condition, v validator.Bool) validator.Bool {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfFloat32 returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfFloat32(condition Condition, v validator.Float32) validator.Float32 {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfFloat64 returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfFloat64(condition Condition, v validator.Float64) validator.Float64 {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfInt32 returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfInt32(condition Condition, v validator.Int32) validator.Int32 {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfInt64 returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfInt64(condition Condition, v validator.Int64) validator.Int64 {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfList returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfList(condition Condition, v validator.List) validator.List {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfMap returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfMap(condition Condition, v validator.Map) validator.Map {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfNumber returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfNumber(condition Condition, v validator.Number) validator.Number {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfObject returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfObject(condition Condition, v validator.Object) validator.Object {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfSet returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfSet(condition Condition, v validator.Set) validator.Set {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfString returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfString(condition Condition, v validator.String) validator.String {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// IfTuple returns a validator which only runs the given validator if the
+// given condition is true. Use When to create a condition based on the values
+// of other attributes, or ConditionFunc for provider-defined logic.
+func IfTuple(condition Condition, v validator.Tuple) validator.Tuple {
+	return ifValidator{
+		condition: condition,
+		validator: v,
+	}
+}
+
+// ifValidator implements the If validator.
+type ifValidator struct {
+	condition Condition
+	validator validator.Describer
+}
+
+// Description describes the validation in plain text formatting.
+func (v ifValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("if %s, then %s", v.condition.Description(ctx), v.validator.Description(ctx))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v ifValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("if %s, then %s", v.condition.MarkdownDescription(ctx), v.validator.MarkdownDescription(ctx))
+}
+
+// ValidateBool implements validator.Bool.
+func (v ifValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Bool).ValidateBool(ctx, req, resp)
+}
+
+// ValidateFloat32 implements validator.Float32.
+func (v ifValidator) ValidateFloat32(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Float32).ValidateFloat32(ctx, req, resp)
+}
+
+// ValidateFloat64 implements validator.Float64.
+func (v ifValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Float64).ValidateFloat64(ctx, req, resp)
+}
+
+// ValidateInt32 implements validator.Int32.
+func (v ifValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Int32).ValidateInt32(ctx, req, resp)
+}
+
+// ValidateInt64 implements validator.Int64.
+func (v ifValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Int64).ValidateInt64(ctx, req, resp)
+}
+
+// ValidateList implements validator.List.
+func (v ifValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.List).ValidateList(ctx, req, resp)
+}
+
+// ValidateMap implements validator.Map.
+func (v ifValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Map).ValidateMap(ctx, req, resp)
+}
+
+// ValidateNumber implements validator.Number.
+func (v ifValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Number).ValidateNumber(ctx, req, resp)
+}
+
+// ValidateObject implements validator.Object.
+func (v ifValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Object).ValidateObject(ctx, req, resp)
+}
+
+// ValidateSet implements validator.Set.
+func (v ifValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Set).ValidateSet(ctx, req, resp)
+}
+
+// ValidateString implements validator.String.
+func (v ifValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.String).ValidateString(ctx, req, resp)
+}
+
+// ValidateTuple implements validator.Tuple.
+func (v ifValidator) ValidateTuple(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+	conditionReq := ConditionRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+
+	ok, diags := v.condition.Evaluate(ctx, conditionReq)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || !ok {
+		return
+	}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Tuple).ValidateTuple(ctx, req, resp)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/genericvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIfDescription(t *testing.T) {
+	t.Parallel()
+
+	v := genericvalidator.IfString(
+		genericvalidator.When(path.MatchRoot("mode"), genericvalidator.Equals(types.StringValue("strict"))),
+		stringvalidator.LengthAtLeast(3),
+	)
+
+	expected := "if mode is \"strict\", then string length must be at least 3"
+
+	if got := v.Description(context.Background()); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestIfValidateString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{Optional: true},
+			"test": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	testValue := types.StringValue("a")
+
+	testConfig := func(mode tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"mode": mode,
+					"test": tftypes.NewValue(tftypes.String, "a"),
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		mode     tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"condition-true": {
+			mode: tftypes.NewValue(tftypes.String, "strict"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be at least 3, got: 1",
+				),
+			},
+		},
+		"condition-false": {
+			mode:     tftypes.NewValue(tftypes.String, "lenient"),
+			expected: nil,
+		},
+		"condition-null": {
+			mode:     tftypes.NewValue(tftypes.String, nil),
+			expected: nil,
+		},
+		"condition-unknown": {
+			mode:     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Config:         testConfig(testCase.mode),
+				ConfigValue:    testValue,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.StringResponse{}
+
+			genericvalidator.IfString(
+				genericvalidator.When(path.MatchRoot("mode"), genericvalidator.Equals(types.StringValue("strict"))),
+				stringvalidator.LengthAtLeast(3),
+			).ValidateString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIfValidateString_ConditionFunc(t *testing.T) {
+	t.Parallel()
+
+	conditionDiag := diag.NewErrorDiagnostic("Condition Error", "condition error detail")
+
+	testCases := map[string]struct {
+		condition genericvalidator.Condition
+		expected  diag.Diagnostics
+	}{
+		"true": {
+			condition: genericvalidator.ConditionFunc(
+				"always",
+				func(_ context.Context, _ genericvalidator.ConditionRequest) (bool, diag.Diagnostics) {
+					return true, nil
+				},
+			),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be at least 3, got: 1",
+				),
+			},
+		},
+		"false": {
+			condition: genericvalidator.ConditionFunc(
+				"never",
+				func(_ context.Context, _ genericvalidator.ConditionRequest) (bool, diag.Diagnostics) {
+					return false, nil
+				},
+			),
+			expected: nil,
+		},
+		"error": {
+			condition: genericvalidator.ConditionFunc(
+				"error",
+				func(_ context.Context, _ genericvalidator.ConditionRequest) (bool, diag.Diagnostics) {
+					return true, diag.Diagnostics{conditionDiag}
+				},
+			),
+			expected: diag.Diagnostics{conditionDiag},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				ConfigValue:    types.StringValue("a"),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.StringResponse{}
+
+			genericvalidator.IfString(testCase.condition, stringvalidator.LengthAtLeast(3)).ValidateString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
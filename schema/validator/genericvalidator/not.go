@@ -0,0 +1,427 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ validator.Bool    = notValidator{}
+	_ validator.Float32 = notValidator{}
+	_ validator.Float64 = notValidator{}
+	_ validator.Int32   = notValidator{}
+	_ validator.Int64   = notValidator{}
+	_ validator.List    = notValidator{}
+	_ validator.Map     = notValidator{}
+	_ validator.Number  = notValidator{}
+	_ validator.Object  = notValidator{}
+	_ validator.Set     = notValidator{}
+	_ validator.String  = notValidator{}
+	_ validator.Tuple   = notValidator{}
+)
+
+// NotBool returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotBool(v validator.Bool) validator.Bool {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotFloat32 returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotFloat32(v validator.Float32) validator.Float32 {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotFloat64 returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotFloat64(v validator.Float64) validator.Float64 {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotInt32 returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotInt32(v validator.Int32) validator.Int32 {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotInt64 returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotInt64(v validator.Int64) validator.Int64 {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotList returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotList(v validator.List) validator.List {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotMap returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotMap(v validator.Map) validator.Map {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotNumber returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotNumber(v validator.Number) validator.Number {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotObject returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotObject(v validator.Object) validator.Object {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotSet returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotSet(v validator.Set) validator.Set {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotString returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotString(v validator.String) validator.String {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// NotTuple returns a validator which passes if the given validator returns
+// error diagnostics and fails if it does not. Diagnostics from the given
+// validator are discarded. Null (unconfigured) and unknown (known after apply)
+// values are skipped, as most validators do not raise errors for them.
+func NotTuple(v validator.Tuple) validator.Tuple {
+	return notValidator{
+		validator: v,
+	}
+}
+
+// notValidator implements the Not validator.
+type notValidator struct {
+	validator validator.Describer
+}
+
+// Description describes the validation in plain text formatting.
+func (v notValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must not satisfy the validation: %s", v.validator.Description(ctx))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v notValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must not satisfy the validation: %s", v.validator.MarkdownDescription(ctx))
+}
+
+// ValidateBool implements validator.Bool.
+func (v notValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.BoolResponse{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Bool).ValidateBool(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateFloat32 implements validator.Float32.
+func (v notValidator) ValidateFloat32(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.Float32Response{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Float32).ValidateFloat32(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateFloat64 implements validator.Float64.
+func (v notValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.Float64Response{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Float64).ValidateFloat64(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateInt32 implements validator.Int32.
+func (v notValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.Int32Response{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Int32).ValidateInt32(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateInt64 implements validator.Int64.
+func (v notValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.Int64Response{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Int64).ValidateInt64(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateList implements validator.List.
+func (v notValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.ListResponse{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.List).ValidateList(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateMap implements validator.Map.
+func (v notValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.MapResponse{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Map).ValidateMap(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateNumber implements validator.Number.
+func (v notValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.NumberResponse{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Number).ValidateNumber(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateObject implements validator.Object.
+func (v notValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.ObjectResponse{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Object).ValidateObject(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateSet implements validator.Set.
+func (v notValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.SetResponse{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Set).ValidateSet(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateString implements validator.String.
+func (v notValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.StringResponse{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.String).ValidateString(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// ValidateTuple implements validator.Tuple.
+func (v notValidator) ValidateTuple(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	subResp := &validator.TupleResponse{}
+
+	//nolint:forcetypeassert // Guaranteed by the typed constructors
+	v.validator.(validator.Tuple).ValidateTuple(ctx, req, subResp)
+
+	if subResp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
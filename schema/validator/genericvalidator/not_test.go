@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/genericvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNotValidateList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.List
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.ListNull(types.StringType),
+			expected: nil,
+		},
+		"unknown": {
+			value:    types.ListUnknown(types.StringType),
+			expected: nil,
+		},
+		"valid": {
+			value: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{types.StringValue("a")},
+			),
+			expected: nil,
+		},
+		"invalid": {
+			value: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{types.StringValue("a"), types.StringValue("b")},
+			),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must not satisfy the validation: list must contain at least 2 elements, got: [\"a\",\"b\"]",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ListRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.ListResponse{}
+
+			genericvalidator.NotList(listvalidator.SizeAtLeast(2)).ValidateList(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
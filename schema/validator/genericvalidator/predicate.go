@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Predicate tests a single attribute value for use with When.
+type Predicate interface {
+	validator.Describer
+
+	// Test returns true if the value satisfies the predicate.
+	Test(context.Context, attr.Value) bool
+}
+
+// Equals returns a Predicate which is satisfied if the value is equal to the
+// given value, as determined by the attr.Value Equal method.
+func Equals(value attr.Value) Predicate {
+	return PredicateFunc(
+		fmt.Sprintf("is %s", value),
+		func(_ context.Context, v attr.Value) bool {
+			return value.Equal(v)
+		},
+	)
+}
+
+// IsNull returns a Predicate which is satisfied if the value is null.
+func IsNull() Predicate {
+	return PredicateFunc(
+		"is null",
+		func(_ context.Context, v attr.Value) bool {
+			return v.IsNull()
+		},
+	)
+}
+
+// IsNotNull returns a Predicate which is satisfied if the value is not null.
+func IsNotNull() Predicate {
+	return PredicateFunc(
+		"is not null",
+		func(_ context.Context, v attr.Value) bool {
+			return !v.IsNull()
+		},
+	)
+}
+
+// PredicateFunc returns a Predicate which calls the given function. The
+// description is used for both plain text and Markdown descriptions.
+func PredicateFunc(description string, f func(context.Context, attr.Value) bool) Predicate {
+	return predicateFunc{
+		description: description,
+		f:           f,
+	}
+}
+
+// predicateFunc implements PredicateFunc.
+type predicateFunc struct {
+	description string
+	f           func(context.Context, attr.Value) bool
+}
+
+// Description describes the predicate in plain text formatting.
+func (p predicateFunc) Description(_ context.Context) string {
+	return p.description
+}
+
+// MarkdownDescription describes the predicate in Markdown formatting.
+func (p predicateFunc) MarkdownDescription(_ context.Context) string {
+	return p.description
+}
+
+// Test implements Predicate.
+func (p predicateFunc) Test(ctx context.Context, v attr.Value) bool {
+	return p.f(ctx, v)
+}
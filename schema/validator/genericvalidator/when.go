@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// When returns a Condition which is true if the given path expression matches
+// at least one attribute in the configuration and every matched value
+// satisfies the given predicate. Relative path expressions are resolved
+// against the path of the attribute being validated.
+//
+// The condition is false if any matched value is unknown, as the final value
+// cannot be tested until it is known.
+func When(expression path.Expression, predicate Predicate) Condition {
+	return whenCondition{
+		expression: expression,
+		predicate:  predicate,
+	}
+}
+
+// whenCondition implements When.
+type whenCondition struct {
+	expression path.Expression
+	predicate  Predicate
+}
+
+// Description describes the condition in plain text formatting.
+func (c whenCondition) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s %s", c.expression, c.predicate.Description(ctx))
+}
+
+// MarkdownDescription describes the condition in Markdown formatting.
+func (c whenCondition) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("%s %s", c.expression, c.predicate.MarkdownDescription(ctx))
+}
+
+// Evaluate implements Condition.
+func (c whenCondition) Evaluate(ctx context.Context, req ConditionRequest) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expressions := req.PathExpression.MergeExpressions(c.expression)
+
+	matched := false
+
+	for _, expression := range expressions {
+		matchedPaths, matchedPathsDiags := req.Config.PathMatches(ctx, expression)
+
+		diags.Append(matchedPathsDiags...)
+
+		if matchedPathsDiags.HasError() {
+			return false, diags
+		}
+
+		for _, matchedPath := range matchedPaths {
+			var matchedValue attr.Value
+
+			getAttributeDiags := req.Config.GetAttribute(ctx, matchedPath, &matchedValue)
+
+			diags.Append(getAttributeDiags...)
+
+			if getAttributeDiags.HasError() {
+				return false, diags
+			}
+
+			if matchedValue.IsUnknown() {
+				return false, diags
+			}
+
+			if !c.predicate.Test(ctx, matchedValue) {
+				return false, diags
+			}
+
+			matched = true
+		}
+	}
+
+	return matched, diags
+}
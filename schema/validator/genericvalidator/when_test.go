@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package genericvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/genericvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWhenEvaluate(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"block": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{Optional: true},
+						"test":    schema.StringAttribute{Optional: true},
+					},
+				},
+				Optional: true,
+			},
+			"other": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	elementType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"test":    tftypes.String,
+		},
+	}
+
+	testConfig := func(other tftypes.Value, enabled ...tftypes.Value) tfsdk.Config {
+		var elements []tftypes.Value
+
+		for _, e := range enabled {
+			elements = append(elements, tftypes.NewValue(elementType, map[string]tftypes.Value{
+				"enabled": e,
+				"test":    tftypes.NewValue(tftypes.String, "a"),
+			}))
+		}
+
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"block": tftypes.NewValue(tftypes.List{ElementType: elementType}, elements),
+					"other": other,
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		config     tfsdk.Config
+		path       path.Path
+		expression path.Expression
+		predicate  genericvalidator.Predicate
+		expected   bool
+	}{
+		"root-equals": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "x")),
+			path:       path.Root("other"),
+			expression: path.MatchRoot("other"),
+			predicate:  genericvalidator.Equals(types.StringValue("x")),
+			expected:   true,
+		},
+		"root-not-equals": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "y")),
+			path:       path.Root("other"),
+			expression: path.MatchRoot("other"),
+			predicate:  genericvalidator.Equals(types.StringValue("x")),
+			expected:   false,
+		},
+		"root-null": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, nil)),
+			path:       path.Root("other"),
+			expression: path.MatchRoot("other"),
+			predicate:  genericvalidator.IsNull(),
+			expected:   true,
+		},
+		"root-not-null": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, "x")),
+			path:       path.Root("other"),
+			expression: path.MatchRoot("other"),
+			predicate:  genericvalidator.IsNotNull(),
+			expected:   true,
+		},
+		"root-unknown": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			path:       path.Root("other"),
+			expression: path.MatchRoot("other"),
+			predicate:  genericvalidator.IsNotNull(),
+			expected:   false,
+		},
+		"relative-sibling": {
+			config: testConfig(
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.Bool, false),
+				tftypes.NewValue(tftypes.Bool, true),
+			),
+			path:       path.Root("block").AtListIndex(1).AtName("test"),
+			expression: path.MatchRelative().AtParent().AtName("enabled"),
+			predicate:  genericvalidator.Equals(types.BoolValue(true)),
+			expected:   true,
+		},
+		"relative-sibling-false": {
+			config: testConfig(
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.Bool, false),
+				tftypes.NewValue(tftypes.Bool, true),
+			),
+			path:       path.Root("block").AtListIndex(0).AtName("test"),
+			expression: path.MatchRelative().AtParent().AtName("enabled"),
+			predicate:  genericvalidator.Equals(types.BoolValue(true)),
+			expected:   false,
+		},
+		"all-elements": {
+			config: testConfig(
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.Bool, true),
+				tftypes.NewValue(tftypes.Bool, true),
+			),
+			path:       path.Root("other"),
+			expression: path.MatchRoot("block").AtAnyListIndex().AtName("enabled"),
+			predicate:  genericvalidator.Equals(types.BoolValue(true)),
+			expected:   true,
+		},
+		"all-elements-mixed": {
+			config: testConfig(
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.Bool, true),
+				tftypes.NewValue(tftypes.Bool, false),
+			),
+			path:       path.Root("other"),
+			expression: path.MatchRoot("block").AtAnyListIndex().AtName("enabled"),
+			predicate:  genericvalidator.Equals(types.BoolValue(true)),
+			expected:   false,
+		},
+		"no-matches": {
+			config:     testConfig(tftypes.NewValue(tftypes.String, nil)),
+			path:       path.Root("other"),
+			expression: path.MatchRoot("block").AtAnyListIndex().AtName("enabled"),
+			predicate:  genericvalidator.IsNull(),
+			expected:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := genericvalidator.ConditionRequest{
+				Config:         testCase.config,
+				Path:           testCase.path,
+				PathExpression: testCase.path.Expression(),
+			}
+
+			got, diags := genericvalidator.When(testCase.expression, testCase.predicate).Evaluate(context.Background(), req)
+
+			if diff := cmp.Diff(diag.Diagnostics(nil), diags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
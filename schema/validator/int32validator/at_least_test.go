@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAtLeastValidateInt32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Int32
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.Int32Unknown(),
+			expected: nil,
+		},
+		"null": {
+			value:    types.Int32Null(),
+			expected: nil,
+		},
+		"equal": {
+			value:    types.Int32Value(2),
+			expected: nil,
+		},
+		"greater": {
+			value:    types.Int32Value(3),
+			expected: nil,
+		},
+		"less": {
+			value: types.Int32Value(1),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at least 2, got: 1",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int32Request{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.Int32Response{}
+
+			int32validator.AtLeast(2).ValidateInt32(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int32 = atMostValidator{}
+
+// atMostValidator validates that a int32 attribute value is less than or equal to the given maximum.
+type atMostValidator struct {
+	max int32
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %d", v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt32 performs the validation.
+func (v atMostValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt32()
+
+	if value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%d", value),
+		))
+	}
+}
+
+// AtMost returns a validator which ensures that any configured attribute value
+// is less than or equal to the given maximum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func AtMost(max int32) validator.Int32 {
+	return atMostValidator{
+		max: max,
+	}
+}
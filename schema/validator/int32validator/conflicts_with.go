@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32validator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ConflictsWith checks that a set of path.Expression, including the attribute
+// this validator is applied to, do not have a value simultaneously.
+//
+// Relative path.Expression will be resolved using the attribute being
+// validated.
+func ConflictsWith(expressions ...path.Expression) validator.Int32 {
+	return schemavalidator.ConflictsWithValidator{
+		PathExpressions: expressions,
+	}
+}
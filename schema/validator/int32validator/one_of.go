@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int32 = oneOfValidator{}
+
+// oneOfValidator validates that a int32 attribute value is one of the given values.
+type oneOfValidator struct {
+	values []int32
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %v", v.values)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt32 performs the validation.
+func (v oneOfValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt32()
+
+	for _, otherValue := range v.values {
+		if value == otherValue {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		fmt.Sprintf("%d", value),
+	))
+}
+
+// OneOf returns a validator which ensures that any configured attribute value
+// is one of the given values. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func OneOf(values ...int32) validator.Int32 {
+	return oneOfValidator{
+		values: values,
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = atLeastValidator{}
+
+// atLeastValidator validates that a int64 attribute value is greater than or equal to the given minimum.
+type atLeastValidator struct {
+	min int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.min)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (v atLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value < v.min {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%d", value),
+		))
+	}
+}
+
+// AtLeast returns a validator which ensures that any configured attribute value
+// is greater than or equal to the given minimum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func AtLeast(min int64) validator.Int64 {
+	return atLeastValidator{
+		min: min,
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAtLeastValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Int64
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.Int64Unknown(),
+			expected: nil,
+		},
+		"null": {
+			value:    types.Int64Null(),
+			expected: nil,
+		},
+		"equal": {
+			value:    types.Int64Value(2),
+			expected: nil,
+		},
+		"greater": {
+			value:    types.Int64Value(3),
+			expected: nil,
+		},
+		"less": {
+			value: types.Int64Value(1),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at least 2, got: 1",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int64Request{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.Int64Response{}
+
+			int64validator.AtLeast(2).ValidateInt64(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
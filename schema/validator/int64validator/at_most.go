@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = atMostValidator{}
+
+// atMostValidator validates that a int64 attribute value is less than or equal to the given maximum.
+type atMostValidator struct {
+	max int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %d", v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (v atMostValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%d", value),
+		))
+	}
+}
+
+// AtMost returns a validator which ensures that any configured attribute value
+// is less than or equal to the given maximum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func AtMost(max int64) validator.Int64 {
+	return atMostValidator{
+		max: max,
+	}
+}
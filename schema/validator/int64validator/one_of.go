@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = oneOfValidator{}
+
+// oneOfValidator validates that a int64 attribute value is one of the given values.
+type oneOfValidator struct {
+	values []int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %v", v.values)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (v oneOfValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	for _, otherValue := range v.values {
+		if value == otherValue {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		fmt.Sprintf("%d", value),
+	))
+}
+
+// OneOf returns a validator which ensures that any configured attribute value
+// is one of the given values. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func OneOf(values ...int64) validator.Int64 {
+	return oneOfValidator{
+		values: values,
+	}
+}
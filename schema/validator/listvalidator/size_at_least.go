@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.List = sizeAtLeastValidator{}
+
+// sizeAtLeastValidator validates that a list attribute value contains at least the given minimum number of elements.
+type sizeAtLeastValidator struct {
+	min int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("list must contain at least %d elements", v.min)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList performs the validation.
+func (v sizeAtLeastValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elems := req.ConfigValue.Elements()
+
+	if len(elems) < v.min {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%d", len(elems)),
+		))
+	}
+}
+
+// SizeAtLeast returns a validator which ensures that any configured attribute value
+// contains at least the given minimum number of elements. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func SizeAtLeast(min int) validator.List {
+	return sizeAtLeastValidator{
+		min: min,
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSizeBetweenValidateList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.List
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.ListUnknown(types.StringType),
+			expected: nil,
+		},
+		"null": {
+			value:    types.ListNull(types.StringType),
+			expected: nil,
+		},
+		"min": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			expected: nil,
+		},
+		"max": {
+			value:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			expected: nil,
+		},
+		"less": {
+			value: types.ListValueMust(types.StringType, []attr.Value{}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test list must contain at least 1 elements and at most 2 elements, got: 0",
+				),
+			},
+		},
+		"greater": {
+			value: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b"), types.StringValue("c")}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test list must contain at least 1 elements and at most 2 elements, got: 3",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ListRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.ListResponse{}
+
+			listvalidator.SizeBetween(1, 2).ValidateList(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
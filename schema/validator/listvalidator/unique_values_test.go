@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUniqueValuesValidateList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.List
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.ListUnknown(types.StringType),
+			expected: nil,
+		},
+		"null": {
+			value:    types.ListNull(types.StringType),
+			expected: nil,
+		},
+		"unique": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+			expected: nil,
+		},
+		"unknown-elements": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringUnknown(),
+				types.StringUnknown(),
+			}),
+			expected: nil,
+		},
+		"duplicate": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+				types.StringValue("one"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Duplicate List Value",
+					"This attribute contains duplicate values of: \"one\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ListRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.ListResponse{}
+
+			listvalidator.UniqueValues().ValidateList(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
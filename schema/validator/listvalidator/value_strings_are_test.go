@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueStringsAreValidateList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.List
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.ListUnknown(types.StringType),
+			expected: nil,
+		},
+		"null": {
+			value:    types.ListNull(types.StringType),
+			expected: nil,
+		},
+		"valid": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringUnknown(),
+			}),
+			expected: nil,
+		},
+		"invalid": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("three"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(1),
+					"Invalid Attribute Value Length",
+					"Attribute test[1] string length must be at most 3, got: 5",
+				),
+			},
+		},
+		"invalid-element-type": {
+			value: types.ListValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(1),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Invalid Validator for Element Type",
+					"While performing schema-based validation, an unexpected error occurred. "+
+						"The attribute declares a String values validator, however its values do not implement types.StringType or the types.StringTypable interface for custom String types. "+
+						"Use the appropriate values validator that matches the element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Path: test[0]\n"+
+						"Element Type: basetypes.Int64Type\n",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ListRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.ListResponse{}
+
+			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(3)).ValidateList(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
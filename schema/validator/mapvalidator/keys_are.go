@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Map = keysAreValidator{}
+
+// keysAreValidator validates that each key of a map attribute value is valid
+// according to all of the given validators.
+type keysAreValidator struct {
+	keyValidators []validator.String
+}
+
+// Description describes the validation in plain text formatting.
+func (v keysAreValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, keyValidator := range v.keyValidators {
+		descriptions = append(descriptions, keyValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("key must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v keysAreValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, keyValidator := range v.keyValidators {
+		descriptions = append(descriptions, keyValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("key must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateMap performs the validation.
+func (v keysAreValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for key := range req.ConfigValue.Elements() {
+		keyPath := req.Path.AtMapKey(key)
+
+		keyReq := validator.StringRequest{
+			Path:           keyPath,
+			PathExpression: keyPath.Expression(),
+			ConfigValue:    types.StringValue(key),
+			Config:         req.Config,
+		}
+
+		for _, keyValidator := range v.keyValidators {
+			keyResp := &validator.StringResponse{}
+
+			keyValidator.ValidateString(ctx, keyReq, keyResp)
+
+			resp.Diagnostics.Append(keyResp.Diagnostics...)
+		}
+	}
+}
+
+// KeysAre returns a validator which ensures that any defined keys of a map
+// attribute value satisfy all of the given validators. Diagnostics from the
+// key validators use the path of each map element. Null (unconfigured) and
+// unknown (known after apply) values are skipped.
+func KeysAre(keyValidators ...validator.String) validator.Map {
+	return keysAreValidator{
+		keyValidators: keyValidators,
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSizeAtMostValidateMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Map
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.MapUnknown(types.StringType),
+			expected: nil,
+		},
+		"null": {
+			value:    types.MapNull(types.StringType),
+			expected: nil,
+		},
+		"equal": {
+			value:    types.MapValueMust(types.StringType, map[string]attr.Value{"k0": types.StringValue("a"), "k1": types.StringValue("b")}),
+			expected: nil,
+		},
+		"greater": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{"k0": types.StringValue("a"), "k1": types.StringValue("b"), "k2": types.StringValue("c")}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test map must contain at most 2 elements, got: 3",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.MapRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.MapResponse{}
+
+			mapvalidator.SizeAtMost(2).ValidateMap(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Map = sizeBetweenValidator{}
+
+// sizeBetweenValidator validates that a map attribute value contains at least the given minimum and at most the given maximum number of elements.
+type sizeBetweenValidator struct {
+	max int
+	min int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("map must contain at least %d elements and at most %d elements", v.min, v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateMap performs the validation.
+func (v sizeBetweenValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elems := req.ConfigValue.Elements()
+
+	if len(elems) < v.min || len(elems) > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%d", len(elems)),
+		))
+	}
+}
+
+// SizeBetween returns a validator which ensures that any configured attribute value
+// contains at least the given minimum and at most the given maximum number of elements. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func SizeBetween(min, max int) validator.Map {
+	return sizeBetweenValidator{
+		max: max,
+		min: min,
+	}
+}
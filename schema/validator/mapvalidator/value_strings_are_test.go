@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueStringsAreValidateMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Map
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.MapUnknown(types.StringType),
+			expected: nil,
+		},
+		"null": {
+			value:    types.MapNull(types.StringType),
+			expected: nil,
+		},
+		"valid": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"one": types.StringValue("one"),
+				"two": types.StringUnknown(),
+			}),
+			expected: nil,
+		},
+		"invalid": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"one":   types.StringValue("one"),
+				"three": types.StringValue("three"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("three"),
+					"Invalid Attribute Value Length",
+					"Attribute test[\"three\"] string length must be at most 3, got: 5",
+				),
+			},
+		},
+		"invalid-element-type": {
+			value: types.MapValueMust(types.Int64Type, map[string]attr.Value{
+				"one": types.Int64Value(1),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("one"),
+					"Invalid Validator for Element Type",
+					"While performing schema-based validation, an unexpected error occurred. "+
+						"The attribute declares a String values validator, however its values do not implement types.StringType or the types.StringTypable interface for custom String types. "+
+						"Use the appropriate values validator that matches the element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Path: test[\"one\"]\n"+
+						"Element Type: basetypes.Int64Type\n",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.MapRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.MapResponse{}
+
+			mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(3)).ValidateMap(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
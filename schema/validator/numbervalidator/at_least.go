@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = atLeastValidator{}
+
+// atLeastValidator validates that a number attribute value is greater than or equal to the given
+// minimum.
+type atLeastValidator struct {
+	min *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %s", v.min.Text('f', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v atLeastValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if value.Cmp(v.min) < 0 {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			value.Text('f', -1),
+		))
+	}
+}
+
+// AtLeast returns a validator which ensures that any configured attribute value
+// is numerically greater than or equal to the given minimum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func AtLeast(min *big.Float) validator.Number {
+	return atLeastValidator{
+		min: min,
+	}
+}
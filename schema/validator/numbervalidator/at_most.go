@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = atMostValidator{}
+
+// atMostValidator validates that a number attribute value is less than or equal to the given
+// maximum.
+type atMostValidator struct {
+	max *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", v.max.Text('f', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v atMostValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if value.Cmp(v.max) > 0 {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			value.Text('f', -1),
+		))
+	}
+}
+
+// AtMost returns a validator which ensures that any configured attribute value
+// is numerically less than or equal to the given maximum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func AtMost(max *big.Float) validator.Number {
+	return atMostValidator{
+		max: max,
+	}
+}
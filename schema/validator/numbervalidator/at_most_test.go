@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAtMostValidateNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.Number
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.NumberUnknown(),
+			expected: nil,
+		},
+		"null": {
+			value:    types.NumberNull(),
+			expected: nil,
+		},
+		"equal": {
+			value:    types.NumberValue(big.NewFloat(1.5)),
+			expected: nil,
+		},
+		"less": {
+			value:    types.NumberValue(big.NewFloat(1)),
+			expected: nil,
+		},
+		"greater": {
+			value: types.NumberValue(big.NewFloat(1.75)),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at most 1.5, got: 1.75",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.NumberRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.NumberResponse{}
+
+			numbervalidator.AtMost(big.NewFloat(1.5)).ValidateNumber(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
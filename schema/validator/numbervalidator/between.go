@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = betweenValidator{}
+
+// betweenValidator validates that a number attribute value is greater than or equal to the given
+// minimum and less than or equal to the given maximum.
+type betweenValidator struct {
+	min *big.Float
+	max *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", v.min.Text('f', -1), v.max.Text('f', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v betweenValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if value.Cmp(v.min) < 0 || value.Cmp(v.max) > 0 {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			value.Text('f', -1),
+		))
+	}
+}
+
+// Between returns a validator which ensures that any configured attribute value
+// is numerically greater than or equal to the given minimum and less than or
+// equal to the given maximum. Null (unconfigured) and unknown (known after apply) values are
+// skipped.
+func Between(min, max *big.Float) validator.Number {
+	return betweenValidator{
+		min: min,
+		max: max,
+	}
+}
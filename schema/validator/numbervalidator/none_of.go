@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = noneOfValidator{}
+
+// noneOfValidator validates that a number attribute value is none of the
+// given values.
+type noneOfValidator struct {
+	values []*big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v noneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: [%s]", formatValues(v.values))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v noneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v noneOfValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	for _, otherValue := range v.values {
+		if value.Cmp(otherValue) != 0 {
+			continue
+		}
+
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			value.Text('f', -1),
+		))
+
+		break
+	}
+}
+
+// NoneOf returns a validator which ensures that any configured attribute
+// value is not numerically equal to any of the given values. Null
+// (unconfigured) and unknown (known after apply) values are skipped.
+func NoneOf(values ...*big.Float) validator.Number {
+	return noneOfValidator{
+		values: values,
+	}
+}
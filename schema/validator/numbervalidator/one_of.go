@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = oneOfValidator{}
+
+// oneOfValidator validates that a number attribute value is one of the given
+// values.
+type oneOfValidator struct {
+	values []*big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: [%s]", formatValues(v.values))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber performs the validation.
+func (v oneOfValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	for _, otherValue := range v.values {
+		if value.Cmp(otherValue) == 0 {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		value.Text('f', -1),
+	))
+}
+
+// OneOf returns a validator which ensures that any configured attribute value
+// is numerically equal to one of the given values. Null (unconfigured) and
+// unknown (known after apply) values are skipped.
+func OneOf(values ...*big.Float) validator.Number {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+// formatValues returns the given values as a space separated string.
+func formatValues(values []*big.Float) string {
+	result := make([]string, len(values))
+
+	for i, value := range values {
+		result[i] = value.Text('f', -1)
+	}
+
+	return strings.Join(result, " ")
+}
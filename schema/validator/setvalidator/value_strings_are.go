@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ validator.Set = valueStringsAreValidator{}
+
+// valueStringsAreValidator validates that each string element of a set
+// attribute value is valid according to all of the given validators.
+type valueStringsAreValidator struct {
+	elementValidators []validator.String
+}
+
+// Description describes the validation in plain text formatting.
+func (v valueStringsAreValidator) Description(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.elementValidators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element value must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valueStringsAreValidator) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, elementValidator := range v.elementValidators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element value must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateSet performs the validation.
+func (v valueStringsAreValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		elementPath := req.Path.AtSetValue(element)
+
+		elementValuable, ok := element.(basetypes.StringValuable)
+
+		if !ok {
+			resp.Diagnostics.Append(validatordiag.InvalidElementTypeDiagnostic(elementPath, "String", element.Type(ctx)))
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		// Only return early if the new diagnostics indicate an issue since
+		// it likely will be the same for all elements.
+		if diags.HasError() {
+			return
+		}
+
+		elementReq := validator.StringRequest{
+			Path:           elementPath,
+			PathExpression: elementPath.Expression(),
+			ConfigValue:    elementValue,
+			Config:         req.Config,
+		}
+
+		for _, elementValidator := range v.elementValidators {
+			elementResp := &validator.StringResponse{}
+
+			elementValidator.ValidateString(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
+
+// ValueStringsAre returns a validator which ensures that any defined string
+// elements of a set attribute value satisfy all of the given validators.
+// Diagnostics from the element validators use the path of each element. Null
+// (unconfigured) and unknown (known after apply) values are skipped.
+func ValueStringsAre(elementValidators ...validator.String) validator.Set {
+	return valueStringsAreValidator{
+		elementValidators: elementValidators,
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = lengthAtLeastValidator{}
+
+// lengthAtLeastValidator validates that a string attribute value has at least
+// a minimum number of characters.
+type lengthAtLeastValidator struct {
+	minLength int
+}
+
+// Description describes the validation in plain text formatting.
+func (v lengthAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be at least %d", v.minLength)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v lengthAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v lengthAtLeastValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	length := utf8.RuneCountInString(req.ConfigValue.ValueString())
+
+	if length < v.minLength {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueLengthDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%d", length),
+		))
+	}
+}
+
+// LengthAtLeast returns a validator which ensures that any configured
+// attribute value has a character length greater than or equal to the given
+// minimum. Characters are counted as Unicode code points, consistent with the
+// Terraform length() function. Null (unconfigured) and unknown (known after
+// apply) values are skipped.
+func LengthAtLeast(minLength int) validator.String {
+	return lengthAtLeastValidator{
+		minLength: minLength,
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLengthAtLeastValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.String
+		expected diag.Diagnostics
+	}{
+		"unknown": {
+			value:    types.StringUnknown(),
+			expected: nil,
+		},
+		"null": {
+			value:    types.StringNull(),
+			expected: nil,
+		},
+		"equal": {
+			value:    types.StringValue("abc"),
+			expected: nil,
+		},
+		"multibyte": {
+			value:    types.StringValue("äöü"),
+			expected: nil,
+		},
+		"less": {
+			value: types.StringValue("ab"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be at least 3, got: 2",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				ConfigValue:    testCase.value,
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+			}
+			resp := &validator.StringResponse{}
+
+			stringvalidator.LengthAtLeast(3).ValidateString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.Diagnostics); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = lengthAtMostValidator{}
+
+// lengthAtMostValidator validates that a string attribute value has at most
+// a maximum number of characters.
+type lengthAtMostValidator struct {
+	maxLength int
+}
+
+// Description describes the validation in plain text formatting.
+func (v lengthAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be at most %d", v.maxLength)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v lengthAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v lengthAtMostValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	length := utf8.RuneCountInString(req.ConfigValue.ValueString())
+
+	if length > v.maxLength {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueLengthDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%d", length),
+		))
+	}
+}
+
+// LengthAtMost returns a validator which ensures that any configured
+// attribute value has a character length less than or equal to the given
+// maximum. Characters are counted as Unicode code points, consistent with the
+// Terraform length() function. Null (unconfigured) and unknown (known after
+// apply) values are skipped.
+func LengthAtMost(maxLength int) validator.String {
+	return lengthAtMostValidator{
+		maxLength: maxLength,
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = lengthBetweenValidator{}
+
+// lengthBetweenValidator validates that a string attribute value has a number
+// of characters between a minimum and maximum.
+type lengthBetweenValidator struct {
+	maxLength int
+	minLength int
+}
+
+// Description describes the validation in plain text formatting.
+func (v lengthBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be between %d and %d", v.minLength, v.maxLength)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v lengthBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v lengthBetweenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	length := utf8.RuneCountInString(req.ConfigValue.ValueString())
+
+	if length < v.minLength || length > v.maxLength {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueLengthDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%d", length),
+		))
+	}
+}
+
+// LengthBetween returns a validator which ensures that any configured
+// attribute value has a character length greater than or equal to the given
+// minimum and less than or equal to the given maximum. Characters are counted
+// as Unicode code points, consistent with the Terraform length() function.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func LengthBetween(minLength, maxLength int) validator.String {
+	return lengthBetweenValidator{
+		maxLength: maxLength,
+		minLength: minLength,
+	}
+}
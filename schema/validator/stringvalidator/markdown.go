@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"strings"
+)
+
+// markdownValues returns the given values as a comma separated list of
+// Markdown code spans, suitable for documentation generation.
+func markdownValues(values []string) string {
+	result := make([]string, len(values))
+
+	for i, value := range values {
+		result[i] = "`" + value + "`"
+	}
+
+	return strings.Join(result, ", ")
+}
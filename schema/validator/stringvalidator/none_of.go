@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = noneOfValidator{}
+
+// noneOfValidator validates that a string attribute value is none of the
+// given values.
+type noneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v noneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %q", v.values)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v noneOfValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %s", markdownValues(v.values))
+}
+
+// ValidateString performs the validation.
+func (v noneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	for _, otherValue := range v.values {
+		if value != otherValue {
+			continue
+		}
+
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q", value),
+		))
+
+		break
+	}
+}
+
+// NoneOf returns a validator which ensures that any configured attribute
+// value is none of the given values. Null (unconfigured) and unknown (known
+// after apply) values are skipped.
+func NoneOf(values ...string) validator.String {
+	return noneOfValidator{
+		values: values,
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = oneOfValidator{}
+
+// oneOfValidator validates that a string attribute value is one of the given
+// values.
+type oneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %q", v.values)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", markdownValues(v.values))
+}
+
+// ValidateString performs the validation.
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	for _, otherValue := range v.values {
+		if value == otherValue {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		fmt.Sprintf("%q", value),
+	))
+}
+
+// OneOf returns a validator which ensures that any configured attribute value
+// is exactly one of the given values. Null (unconfigured) and unknown (known
+// after apply) values are skipped.
+func OneOf(values ...string) validator.String {
+	return oneOfValidator{
+		values: values,
+	}
+}
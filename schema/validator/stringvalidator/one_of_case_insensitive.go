@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = oneOfCaseInsensitiveValidator{}
+
+// oneOfCaseInsensitiveValidator validates that a string attribute value is
+// one of the given values, ignoring case.
+type oneOfCaseInsensitiveValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfCaseInsensitiveValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of (case-insensitive): %q", v.values)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfCaseInsensitiveValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be one of (case-insensitive): %s", markdownValues(v.values))
+}
+
+// ValidateString performs the validation.
+func (v oneOfCaseInsensitiveValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	for _, otherValue := range v.values {
+		if strings.EqualFold(value, otherValue) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		fmt.Sprintf("%q", value),
+	))
+}
+
+// OneOfCaseInsensitive returns a validator which ensures that any configured
+// attribute value is one of the given values, ignoring case. Null
+// (unconfigured) and unknown (known after apply) values are skipped.
+func OneOfCaseInsensitive(values ...string) validator.String {
+	return oneOfCaseInsensitiveValidator{
+		values: values,
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = regexMatchesValidator{}
+
+// regexMatchesValidator validates that a string attribute value matches a
+// regular expression.
+type regexMatchesValidator struct {
+	message string
+	regexp  *regexp.Regexp
+}
+
+// Description describes the validation in plain text formatting.
+func (v regexMatchesValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return fmt.Sprintf("value must match regular expression '%s'", v.regexp)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v regexMatchesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v regexMatchesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if !v.regexp.MatchString(value) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// RegexMatches returns a validator which ensures that any configured
+// attribute value matches the given regular expression. The optional message
+// replaces the default description and error text, which is useful to
+// explain the expected format to practitioners. Null (unconfigured) and
+// unknown (known after apply) values are skipped.
+func RegexMatches(regexp *regexp.Regexp, message string) validator.String {
+	return regexMatchesValidator{
+		message: message,
+		regexp:  regexp,
+	}
+}
@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tuplevalidator provides validators for types.Tuple attributes.
+package tuplevalidator
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -269,6 +270,73 @@ func TestConfigPathMatches(t *testing.T) {
 				),
 			},
 		},
+		"AttributeNameExact-ElementPredicate-match": {
+			config: tfsdk.Config{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"type": types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+				Raw: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"type": tftypes.String,
+									},
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"type": tftypes.String,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"type": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"type": tftypes.NewValue(tftypes.String, "other"),
+									},
+								),
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"type": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"type": tftypes.NewValue(tftypes.String, "example"),
+									},
+								),
+							},
+						),
+					},
+				),
+			},
+			expression: path.MatchRoot("test").AtElementWhere("type", types.StringValue("example")),
+			expected: path.Paths{
+				path.Root("test").AtListIndex(1),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
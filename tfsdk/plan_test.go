@@ -485,3 +485,59 @@ func TestPlanSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkPlanGet100(b *testing.B) {
+	benchmarkPlanGet(b, 100)
+}
+
+func BenchmarkPlanGet1000(b *testing.B) {
+	benchmarkPlanGet(b, 1000)
+}
+
+func benchmarkPlanGet(b *testing.B, items int) {
+	ctx := context.Background()
+	_, raw := benchmarkModelValue(b, items)
+	plan := tfsdk.Plan{
+		Raw:    raw,
+		Schema: benchmarkSchema,
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		var model benchmarkModel
+
+		diags := plan.Get(ctx, &model)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected Get diagnostics: %v", diags)
+		}
+	}
+}
+
+func BenchmarkPlanSet100(b *testing.B) {
+	benchmarkPlanSet(b, 100)
+}
+
+func BenchmarkPlanSet1000(b *testing.B) {
+	benchmarkPlanSet(b, 1000)
+}
+
+func benchmarkPlanSet(b *testing.B, items int) {
+	ctx := context.Background()
+	model, raw := benchmarkModelValue(b, items)
+	plan := tfsdk.Plan{
+		Raw:    raw,
+		Schema: benchmarkSchema,
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		diags := plan.Set(ctx, model)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected Set diagnostics: %v", diags)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// RegisterConverter registers functions to convert between the Go type T and
+// the Go type U, for Go types which are not otherwise supported by the
+// framework reflection rules, such as types from other modules. U must be
+// supported by the reflection rules, such as string or types.String.
+//
+// Once registered, T can be used wherever reflection is used, such as with
+// the Get and Set methods of Config, Plan, and State, and with ValueAs and
+// ValueFrom. Registering another converter for T replaces the existing
+// converter. Converters are global to the provider process, so they should
+// be registered once, such as in an init function:
+//
+//	func init() {
+//		tfsdk.RegisterConverter(
+//			func(v semver.Version) (string, error) { return v.String(), nil },
+//			func(s string) (semver.Version, error) { return semver.Parse(s) },
+//		)
+//	}
+//
+// The framework already converts time.Time (RFC 3339), time.Duration,
+// json.RawMessage, and encoding.TextMarshaler and encoding.TextUnmarshaler
+// implementations to and from string attributes.
+func RegisterConverter[T, U any](to func(T) (U, error), from func(U) (T, error)) {
+	reflect.RegisterConverter(to, from)
+}
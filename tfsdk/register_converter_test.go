@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testUpperString is converted to and from lowercase strings with a
+// registered converter.
+type testUpperString struct {
+	value string
+}
+
+func init() {
+	tfsdk.RegisterConverter(
+		func(s testUpperString) (string, error) { return strings.ToLower(s.value), nil },
+		func(s string) (testUpperString, error) { return testUpperString{value: strings.ToUpper(s)}, nil },
+	)
+}
+
+func TestState_standardLibraryTypes(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Address   netip.Addr      `tfsdk:"address"`
+		CreatedAt time.Time       `tfsdk:"created_at"`
+		Name      testUpperString `tfsdk:"name"`
+		Timeout   time.Duration   `tfsdk:"timeout"`
+	}
+
+	schema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"address": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+			"created_at": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+			"timeout": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"address":    tftypes.String,
+			"created_at": tftypes.String,
+			"name":       tftypes.String,
+			"timeout":    tftypes.String,
+		},
+	}
+
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"address":    tftypes.NewValue(tftypes.String, "192.0.2.1"),
+		"created_at": tftypes.NewValue(tftypes.String, "2023-01-02T03:04:05Z"),
+		"name":       tftypes.NewValue(tftypes.String, "example"),
+		"timeout":    tftypes.NewValue(tftypes.String, "1m30s"),
+	})
+
+	state := tfsdk.State{
+		Raw:    raw,
+		Schema: schema,
+	}
+
+	var got model
+
+	diags := state.Get(context.Background(), &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics getting state: %v", diags)
+	}
+
+	expected := model{
+		Address:   netip.MustParseAddr("192.0.2.1"),
+		CreatedAt: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		Name:      testUpperString{value: "EXAMPLE"},
+		Timeout:   90 * time.Second,
+	}
+
+	if diff := cmp.Diff(got, expected, cmpopts.EquateComparable(netip.Addr{}), cmp.AllowUnexported(testUpperString{})); diff != "" {
+		t.Errorf("unexpected model difference: %s", diff)
+	}
+
+	newState := tfsdk.State{
+		Raw:    tftypes.NewValue(objectType, nil),
+		Schema: schema,
+	}
+
+	diags = newState.Set(context.Background(), got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics setting state: %v", diags)
+	}
+
+	if diff := cmp.Diff(newState.Raw, raw); diff != "" {
+		t.Errorf("unexpected state difference: %s", diff)
+	}
+}
@@ -5,10 +5,12 @@ package tfsdk_test
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -392,3 +394,126 @@ func TestStateSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+type benchmarkModelItem struct {
+	Name    types.String `tfsdk:"name"`
+	Count   types.Int64  `tfsdk:"count"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Tags    []string     `tfsdk:"tags"`
+}
+
+type benchmarkModel struct {
+	ID    types.String         `tfsdk:"id"`
+	Items []benchmarkModelItem `tfsdk:"items"`
+}
+
+var benchmarkSchema = testschema.Schema{
+	Attributes: map[string]fwschema.Attribute{
+		"id": testschema.Attribute{
+			Computed: true,
+			Type:     types.StringType,
+		},
+		"items": testschema.Attribute{
+			Optional: true,
+			Type: types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name":    types.StringType,
+						"count":   types.Int64Type,
+						"enabled": types.BoolType,
+						"tags":    types.ListType{ElemType: types.StringType},
+					},
+				},
+			},
+		},
+	},
+}
+
+// benchmarkModelValue returns a model with the given number of items and the
+// Terraform value of the model.
+func benchmarkModelValue(b *testing.B, items int) (benchmarkModel, tftypes.Value) {
+	b.Helper()
+
+	model := benchmarkModel{
+		ID:    types.StringValue("id"),
+		Items: make([]benchmarkModelItem, items),
+	}
+
+	for i := range model.Items {
+		model.Items[i] = benchmarkModelItem{
+			Name:    types.StringValue(fmt.Sprintf("item%d", i)),
+			Count:   types.Int64Value(int64(i)),
+			Enabled: types.BoolValue(i%2 == 0),
+			Tags:    []string{"one", "two"},
+		}
+	}
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(benchmarkSchema.Type().TerraformType(context.Background()), nil),
+		Schema: benchmarkSchema,
+	}
+
+	diags := state.Set(context.Background(), model)
+
+	if diags.HasError() {
+		b.Fatalf("unexpected Set diagnostics: %v", diags)
+	}
+
+	return model, state.Raw
+}
+
+func BenchmarkStateGet100(b *testing.B) {
+	benchmarkStateGet(b, 100)
+}
+
+func BenchmarkStateGet1000(b *testing.B) {
+	benchmarkStateGet(b, 1000)
+}
+
+func benchmarkStateGet(b *testing.B, items int) {
+	ctx := context.Background()
+	_, raw := benchmarkModelValue(b, items)
+	state := tfsdk.State{
+		Raw:    raw,
+		Schema: benchmarkSchema,
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		var model benchmarkModel
+
+		diags := state.Get(ctx, &model)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected Get diagnostics: %v", diags)
+		}
+	}
+}
+
+func BenchmarkStateSet100(b *testing.B) {
+	benchmarkStateSet(b, 100)
+}
+
+func BenchmarkStateSet1000(b *testing.B) {
+	benchmarkStateSet(b, 1000)
+}
+
+func benchmarkStateSet(b *testing.B, items int) {
+	ctx := context.Background()
+	model, raw := benchmarkModelValue(b, items)
+	state := tfsdk.State{
+		Raw:    raw,
+		Schema: benchmarkSchema,
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		diags := state.Set(ctx, model)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected Set diagnostics: %v", diags)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// valueIndex is a hash index of values, used by collection operations to
+// check membership without comparing every pair of values. Values are hashed
+// by their Terraform value, then compared with the Equal method of the values
+// within a hash bucket.
+type valueIndex map[string][]attr.Value
+
+// newValueIndex returns an index of the given values.
+func newValueIndex(ctx context.Context, values []attr.Value) (valueIndex, diag.Diagnostics) {
+	index := make(valueIndex, len(values))
+
+	for _, value := range values {
+		if _, diags := index.add(ctx, value); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return index, nil
+}
+
+// add adds the value to the index, returning false if an equal value was
+// already in the index.
+func (i valueIndex) add(ctx context.Context, value attr.Value) (bool, diag.Diagnostics) {
+	hash, diags := valueHash(ctx, value)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, existing := range i[hash] {
+		if existing.Equal(value) {
+			return false, nil
+		}
+	}
+
+	i[hash] = append(i[hash], value)
+
+	return true, nil
+}
+
+// contains returns true if an equal value is in the index.
+func (i valueIndex) contains(ctx context.Context, value attr.Value) (bool, diag.Diagnostics) {
+	hash, diags := valueHash(ctx, value)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, existing := range i[hash] {
+		if existing.Equal(value) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// valueHash returns a string which is the same for all equal values.
+func valueHash(ctx context.Context, value attr.Value) (string, diag.Diagnostics) {
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return "", diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert into a Terraform value. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					err.Error(),
+			),
+		}
+	}
+
+	var hash strings.Builder
+
+	if err := writeTerraformValueHash(&hash, tfValue); err != nil {
+		return "", diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to hash a Terraform value. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					err.Error(),
+			),
+		}
+	}
+
+	return hash.String(), nil
+}
+
+// writeTerraformValueHash writes a representation of the Terraform value which
+// is independent of number precision, set element order, and map key order.
+// The type is not included, as values of different types are never equal and
+// are compared with the Equal method after hashing.
+func writeTerraformValueHash(b *strings.Builder, value tftypes.Value) error {
+	if value.IsNull() {
+		b.WriteString("null")
+
+		return nil
+	}
+
+	if !value.IsKnown() {
+		b.WriteString("unknown")
+
+		return nil
+	}
+
+	typ := value.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return err
+		}
+
+		b.WriteString(strconv.Quote(s))
+	case typ.Is(tftypes.Number):
+		var f big.Float
+
+		if err := value.As(&f); err != nil {
+			return err
+		}
+
+		// The 'p' format is exact and independent of the precision. Negative
+		// zero is equal to zero.
+		if f.Sign() == 0 {
+			b.WriteString("0")
+
+			break
+		}
+
+		b.WriteString(f.Text('p', 0))
+	case typ.Is(tftypes.Bool):
+		var v bool
+
+		if err := value.As(&v); err != nil {
+			return err
+		}
+
+		b.WriteString(strconv.FormatBool(v))
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Tuple{}), typ.Is(tftypes.Set{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return err
+		}
+
+		hashes := make([]string, 0, len(elements))
+
+		for _, element := range elements {
+			var elementHash strings.Builder
+
+			if err := writeTerraformValueHash(&elementHash, element); err != nil {
+				return err
+			}
+
+			hashes = append(hashes, elementHash.String())
+		}
+
+		if typ.Is(tftypes.Set{}) {
+			sort.Strings(hashes)
+		}
+
+		b.WriteString("[" + strings.Join(hashes, ",") + "]")
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(elements))
+
+		for key := range elements {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		b.WriteString("{")
+
+		for i, key := range keys {
+			if i > 0 {
+				b.WriteString(",")
+			}
+
+			b.WriteString(strconv.Quote(key) + ":")
+
+			if err := writeTerraformValueHash(b, elements[key]); err != nil {
+				return err
+			}
+		}
+
+		b.WriteString("}")
+	default:
+		return fmt.Errorf("unsupported Terraform type: %s", typ)
+	}
+
+	return nil
+}
+
+// validateOperationElements returns error diagnostics for any elements which
+// do not match the element type of a collection.
+func validateOperationElements(ctx context.Context, collection string, operation string, elementType attr.Type, elements []attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for idx, element := range elements {
+		if element == nil || !elementType.Equal(element.Type(ctx)) {
+			var givenType attr.Type
+
+			if element != nil {
+				givenType = element.Type(ctx)
+			}
+
+			diags.AddError(
+				fmt.Sprintf("Invalid %s Element Type", collection),
+				fmt.Sprintf("While %s a %s value, an invalid element was detected. ", operation, collection)+
+					fmt.Sprintf("A %s must use the single, given element type. ", collection)+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("%s Element Type: %s\n", collection, elementType)+
+					fmt.Sprintf("Given Element (%d) Type: %v", idx, givenType),
+			)
+		}
+	}
+
+	return diags
+}
+
+// nullOperationDiagnostic returns an error diagnostic for an operation on a
+// null collection.
+func nullOperationDiagnostic(collection string, operation string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Invalid %s Operation", collection),
+		fmt.Sprintf("While %s a %s value, the %s was null. ", operation, collection, collection)+
+			"Operations are only supported on known or unknown values. "+
+			"This is always an issue with the provider and should be reported to the provider developers.",
+	)
+}
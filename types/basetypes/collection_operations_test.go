@@ -0,0 +1,943 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestValueHash(t *testing.T) {
+	t.Parallel()
+
+	objectType := map[string]attr.Type{"a": StringType{}, "b": NumberType{}}
+
+	testCases := map[string]struct {
+		a        attr.Value
+		b        attr.Value
+		expected bool
+	}{
+		"string-equal": {
+			a:        NewStringValue("test"),
+			b:        NewStringValue("test"),
+			expected: true,
+		},
+		"string-different": {
+			a:        NewStringValue("test"),
+			b:        NewStringValue("other"),
+			expected: false,
+		},
+		"string-null-unknown": {
+			a:        NewStringNull(),
+			b:        NewStringUnknown(),
+			expected: false,
+		},
+		"number-precision": {
+			a:        NewNumberValue(big.NewFloat(0.1)),
+			b:        NewNumberValue(new(big.Float).SetPrec(512).SetFloat64(0.1)),
+			expected: true,
+		},
+		"number-negative-zero": {
+			a:        NewNumberValue(big.NewFloat(0)),
+			b:        NewNumberValue(new(big.Float).Neg(big.NewFloat(0))),
+			expected: true,
+		},
+		"number-beyond-string-precision": {
+			a:        NewNumberValue(big.NewFloat(1.00000000001)),
+			b:        NewNumberValue(big.NewFloat(1.00000000002)),
+			expected: false,
+		},
+		"list-order": {
+			a:        NewListValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			b:        NewListValueMust(StringType{}, []attr.Value{NewStringValue("b"), NewStringValue("a")}),
+			expected: false,
+		},
+		"set-order": {
+			a:        NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			b:        NewSetValueMust(StringType{}, []attr.Value{NewStringValue("b"), NewStringValue("a")}),
+			expected: true,
+		},
+		"map-equal": {
+			a:        NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1"), "b": NewStringValue("2")}),
+			b:        NewMapValueMust(StringType{}, map[string]attr.Value{"b": NewStringValue("2"), "a": NewStringValue("1")}),
+			expected: true,
+		},
+		"object-nested-set-order": {
+			a: NewObjectValueMust(
+				map[string]attr.Type{"set": SetType{ElemType: StringType{}}},
+				map[string]attr.Value{"set": NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")})},
+			),
+			b: NewObjectValueMust(
+				map[string]attr.Type{"set": SetType{ElemType: StringType{}}},
+				map[string]attr.Value{"set": NewSetValueMust(StringType{}, []attr.Value{NewStringValue("b"), NewStringValue("a")})},
+			),
+			expected: true,
+		},
+		"object-different": {
+			a:        NewObjectValueMust(objectType, map[string]attr.Value{"a": NewStringValue("1"), "b": NewNumberValue(big.NewFloat(1))}),
+			b:        NewObjectValueMust(objectType, map[string]attr.Value{"a": NewStringValue("1"), "b": NewNumberValue(big.NewFloat(2))}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a, diags := valueHash(context.Background(), testCase.a)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			b, diags := valueHash(context.Background(), testCase.b)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got := a == b; got != testCase.expected {
+				t.Errorf("expected equal hashes %t, got %t: %s, %s", testCase.expected, got, a, b)
+			}
+		})
+	}
+}
+
+func TestListValueAppend(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		elements      []attr.Value
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			elements: []attr.Value{NewStringValue("b"), NewStringValue("a")},
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b"), NewStringValue("a")}),
+		},
+		"known-no-elements": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewListUnknown(StringType{}),
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewListNull(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Operation",
+					"While appending to a List value, the List was null. "+
+						"Operations are only supported on known or unknown values. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"invalid-element-type": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			elements: []attr.Value{NewStringValue("b"), NewBoolValue(true)},
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While appending to a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"Given Element (1) Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Append(context.Background(), testCase.elements...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueAppend_immutable(t *testing.T) {
+	t.Parallel()
+
+	input := NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")})
+
+	_, diags := input.Append(context.Background(), NewStringValue("b"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(input.Elements()) != 1 {
+		t.Fatal("unexpected Append mutation")
+	}
+}
+
+func TestListValueRemove(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		elements      []attr.Value
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b"), NewStringValue("a"), NewStringValue("c")}),
+			elements: []attr.Value{NewStringValue("a"), NewStringValue("d")},
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("b"), NewStringValue("c")}),
+		},
+		"known-all": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"known-null-element": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringNull(), NewStringValue("a"), NewStringUnknown()}),
+			elements: []attr.Value{NewStringNull()},
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringUnknown()}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewListUnknown(StringType{}),
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewListNull(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Operation",
+					"While removing from a List value, the List was null. "+
+						"Operations are only supported on known or unknown values. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"invalid-element-type": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			elements: []attr.Value{NewBoolValue(true)},
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While removing from a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"Given Element (0) Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Remove(context.Background(), testCase.elements...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueFilter(t *testing.T) {
+	t.Parallel()
+
+	notA := func(v attr.Value) bool {
+		return !v.Equal(NewStringValue("a"))
+	}
+
+	testCases := map[string]struct {
+		input         ListValue
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("c"), NewStringValue("a"), NewStringValue("b")}),
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("c"), NewStringValue("b")}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: NewListUnknown(StringType{}),
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: NewListNull(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Operation",
+					"While filtering a List value, the List was null. "+
+						"Operations are only supported on known or unknown values. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Filter(context.Background(), notA)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		element       attr.Value
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"known-found": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			element:  NewStringValue("b"),
+			expected: true,
+		},
+		"known-not-found": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			element:  NewStringValue("c"),
+			expected: false,
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			element:  NewStringValue("a"),
+			expected: false,
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			element:  NewStringValue("a"),
+			expected: false,
+		},
+		"invalid-element-type": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			element:  NewBoolValue(true),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While checking a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"Given Element (0) Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Contains(context.Background(), testCase.element)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueAppend(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         SetValue
+		elements      []attr.Value
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			elements: []attr.Value{NewStringValue("b"), NewStringValue("a"), NewStringValue("b")},
+			expected: NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewSetUnknown(StringType{}),
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewSetNull(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Operation",
+					"While appending to a Set value, the Set was null. "+
+						"Operations are only supported on known or unknown values. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"invalid-element-type": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			elements: []attr.Value{NewBoolValue(true)},
+			expected: NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While appending to a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Given Element (0) Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Append(context.Background(), testCase.elements...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueRemove(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         SetValue
+		elements      []attr.Value
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b"), NewStringValue("c")}),
+			elements: []attr.Value{NewStringValue("b"), NewStringValue("d")},
+			expected: NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("c")}),
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewSetUnknown(StringType{}),
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			elements: []attr.Value{NewStringValue("a")},
+			expected: NewSetNull(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Operation",
+					"While removing from a Set value, the Set was null. "+
+						"Operations are only supported on known or unknown values. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Remove(context.Background(), testCase.elements...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueFilter(t *testing.T) {
+	t.Parallel()
+
+	notA := func(v attr.Value) bool {
+		return !v.Equal(NewStringValue("a"))
+	}
+
+	testCases := map[string]struct {
+		input         SetValue
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{NewStringValue("b")}),
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: NewSetNull(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Operation",
+					"While filtering a Set value, the Set was null. "+
+						"Operations are only supported on known or unknown values. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Filter(context.Background(), notA)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         SetValue
+		element       attr.Value
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"known-found": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			element:  NewStringValue("b"),
+			expected: true,
+		},
+		"known-not-found": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			element:  NewStringValue("c"),
+			expected: false,
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			element:  NewStringValue("a"),
+			expected: false,
+		},
+		"invalid-element-type": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			element:  NewBoolValue(true),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While checking a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Given Element (0) Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Contains(context.Background(), testCase.element)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueOperations(t *testing.T) {
+	t.Parallel()
+
+	ab := NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")})
+	bc := NewSetValueMust(StringType{}, []attr.Value{NewStringValue("b"), NewStringValue("c")})
+	nullDiags := func(operation string) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Set Operation",
+				"While "+operation+" a Set value, the Set was null. "+
+					"Operations are only supported on known or unknown values. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		input                     SetValue
+		other                     SetValue
+		expectedUnion             SetValue
+		expectedIntersection      SetValue
+		expectedDifference        SetValue
+		expectedUnionDiags        diag.Diagnostics
+		expectedIntersectionDiags diag.Diagnostics
+		expectedDifferenceDiags   diag.Diagnostics
+	}{
+		"known": {
+			input:                ab,
+			other:                bc,
+			expectedUnion:        NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b"), NewStringValue("c")}),
+			expectedIntersection: NewSetValueMust(StringType{}, []attr.Value{NewStringValue("b")}),
+			expectedDifference:   NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+		},
+		"known-empty": {
+			input:                ab,
+			other:                NewSetValueMust(StringType{}, []attr.Value{}),
+			expectedUnion:        ab,
+			expectedIntersection: NewSetValueMust(StringType{}, []attr.Value{}),
+			expectedDifference:   ab,
+		},
+		"known-nested-set-order": {
+			input: NewSetValueMust(SetType{ElemType: StringType{}}, []attr.Value{
+				NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			}),
+			other: NewSetValueMust(SetType{ElemType: StringType{}}, []attr.Value{
+				NewSetValueMust(StringType{}, []attr.Value{NewStringValue("b"), NewStringValue("a")}),
+			}),
+			expectedUnion: NewSetValueMust(SetType{ElemType: StringType{}}, []attr.Value{
+				NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			}),
+			expectedIntersection: NewSetValueMust(SetType{ElemType: StringType{}}, []attr.Value{
+				NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a"), NewStringValue("b")}),
+			}),
+			expectedDifference: NewSetValueMust(SetType{ElemType: StringType{}}, []attr.Value{}),
+		},
+		"unknown": {
+			input:                ab,
+			other:                NewSetUnknown(StringType{}),
+			expectedUnion:        NewSetUnknown(StringType{}),
+			expectedIntersection: NewSetUnknown(StringType{}),
+			expectedDifference:   NewSetUnknown(StringType{}),
+		},
+		"null": {
+			input:                     NewSetNull(StringType{}),
+			other:                     ab,
+			expectedUnion:             NewSetUnknown(StringType{}),
+			expectedIntersection:      NewSetUnknown(StringType{}),
+			expectedDifference:        NewSetUnknown(StringType{}),
+			expectedUnionDiags:        nullDiags("combining"),
+			expectedIntersectionDiags: nullDiags("intersecting"),
+			expectedDifferenceDiags:   nullDiags("subtracting"),
+		},
+		"invalid-element-type": {
+			input:                ab,
+			other:                NewSetValueMust(BoolType{}, []attr.Value{NewBoolValue(true)}),
+			expectedUnion:        NewSetUnknown(StringType{}),
+			expectedIntersection: NewSetUnknown(StringType{}),
+			expectedDifference:   NewSetUnknown(StringType{}),
+			expectedUnionDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While combining Set values, the Sets had different element types. "+
+						"Set operations require the same element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Other Set Element Type: basetypes.BoolType",
+				),
+			},
+			expectedIntersectionDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While intersecting Set values, the Sets had different element types. "+
+						"Set operations require the same element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Other Set Element Type: basetypes.BoolType",
+				),
+			},
+			expectedDifferenceDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While subtracting Set values, the Sets had different element types. "+
+						"Set operations require the same element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Other Set Element Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			union, diags := testCase.input.Union(ctx, testCase.other)
+
+			if !union.Equal(testCase.expectedUnion) {
+				t.Errorf("unexpected Union result: %s", union)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedUnionDiags); diff != "" {
+				t.Errorf("unexpected Union diagnostics difference: %s", diff)
+			}
+
+			intersection, diags := testCase.input.Intersection(ctx, testCase.other)
+
+			if !intersection.Equal(testCase.expectedIntersection) {
+				t.Errorf("unexpected Intersection result: %s", intersection)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedIntersectionDiags); diff != "" {
+				t.Errorf("unexpected Intersection diagnostics difference: %s", diff)
+			}
+
+			difference, diags := testCase.input.Difference(ctx, testCase.other)
+
+			if !difference.Equal(testCase.expectedDifference) {
+				t.Errorf("unexpected Difference result: %s", difference)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDifferenceDiags); diff != "" {
+				t.Errorf("unexpected Difference diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueOperations_large(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	evens := make([]attr.Value, 0, 5000)
+	all := make([]attr.Value, 0, 10000)
+
+	for i := 0; i < 10000; i++ {
+		if i%2 == 0 {
+			evens = append(evens, NewStringValue(strconv.Itoa(i)))
+		}
+
+		all = append(all, NewStringValue(strconv.Itoa(i)))
+	}
+
+	evenSet := NewSetValueMust(StringType{}, evens)
+	allSet := NewSetValueMust(StringType{}, all)
+
+	difference, diags := allSet.Difference(ctx, evenSet)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := len(difference.Elements()); got != 5000 {
+		t.Errorf("expected 5000 Difference elements, got %d", got)
+	}
+
+	union, diags := evenSet.Union(ctx, allSet)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !union.Equal(allSet) {
+		t.Error("expected Union to equal all elements")
+	}
+}
+
+func TestMapValueMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         MapValue
+		others        []MapValue
+		expected      MapValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1"), "b": NewStringValue("2")}),
+			others: []MapValue{
+				NewMapValueMust(StringType{}, map[string]attr.Value{"b": NewStringValue("3")}),
+				NewMapValueMust(StringType{}, map[string]attr.Value{"b": NewStringValue("4"), "c": NewStringValue("5")}),
+			},
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1"), "b": NewStringValue("4"), "c": NewStringValue("5")}),
+		},
+		"known-no-others": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1")}),
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1")}),
+		},
+		"unknown": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1")}),
+			others: []MapValue{
+				NewMapUnknown(StringType{}),
+			},
+			expected: NewMapUnknown(StringType{}),
+		},
+		"null": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1")}),
+			others: []MapValue{
+				NewMapNull(StringType{}),
+			},
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Operation",
+					"While merging a Map value, the Map was null. "+
+						"Operations are only supported on known or unknown values. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"invalid-element-type": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1")}),
+			others: []MapValue{
+				NewMapValueMust(BoolType{}, map[string]attr.Value{"b": NewBoolValue(true)}),
+			},
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("1")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Element Type",
+					"While merging Map values, the Maps had different element types. "+
+						"Map operations require the same element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Map Element Type: basetypes.StringType\n"+
+						"Other Map Element Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Merge(context.Background(), testCase.others...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		expected []string
+	}{
+		"known": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"c": NewStringValue("1"), "a": NewStringValue("2"), "b": NewStringValue("3")}),
+			expected: []string{"a", "b", "c"},
+		},
+		"known-empty": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			expected: []string{},
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: []string{},
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Keys()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -314,3 +314,109 @@ func (l ListValue) String() string {
 func (l ListValue) ToListValue(context.Context) (ListValue, diag.Diagnostics) {
 	return l, nil
 }
+
+// Append returns a copy of the List with the given elements added to the end.
+// Appending to an unknown List returns an unknown List. Error diagnostics are
+// returned if the List is null or an element does not match the element type.
+func (l ListValue) Append(ctx context.Context, elements ...attr.Value) (ListValue, diag.Diagnostics) {
+	diags := validateOperationElements(ctx, "List", "appending to", l.ElementType(ctx), elements)
+
+	if diags.HasError() {
+		return l, diags
+	}
+
+	switch l.state {
+	case attr.ValueStateNull:
+		return l, diag.Diagnostics{nullOperationDiagnostic("List", "appending to")}
+	case attr.ValueStateUnknown:
+		return l, nil
+	}
+
+	result := make([]attr.Value, 0, len(l.elements)+len(elements))
+	result = append(result, l.elements...)
+	result = append(result, elements...)
+
+	return NewListValue(l.ElementType(ctx), result)
+}
+
+// Remove returns a copy of the List without any elements equal to the given
+// elements. Removing from an unknown List returns an unknown List. Error
+// diagnostics are returned if the List is null or an element does not match
+// the element type.
+func (l ListValue) Remove(ctx context.Context, elements ...attr.Value) (ListValue, diag.Diagnostics) {
+	diags := validateOperationElements(ctx, "List", "removing from", l.ElementType(ctx), elements)
+
+	if diags.HasError() {
+		return l, diags
+	}
+
+	switch l.state {
+	case attr.ValueStateNull:
+		return l, diag.Diagnostics{nullOperationDiagnostic("List", "removing from")}
+	case attr.ValueStateUnknown:
+		return l, nil
+	}
+
+	index, diags := newValueIndex(ctx, elements)
+
+	if diags.HasError() {
+		return l, diags
+	}
+
+	result := make([]attr.Value, 0, len(l.elements))
+
+	for _, element := range l.elements {
+		found, diags := index.contains(ctx, element)
+
+		if diags.HasError() {
+			return l, diags
+		}
+
+		if !found {
+			result = append(result, element)
+		}
+	}
+
+	return NewListValue(l.ElementType(ctx), result)
+}
+
+// Filter returns a copy of the List with only the elements for which the given
+// function returns true, in the same order. Filtering an unknown List returns
+// an unknown List. Error diagnostics are returned if the List is null.
+func (l ListValue) Filter(ctx context.Context, fn func(attr.Value) bool) (ListValue, diag.Diagnostics) {
+	switch l.state {
+	case attr.ValueStateNull:
+		return l, diag.Diagnostics{nullOperationDiagnostic("List", "filtering")}
+	case attr.ValueStateUnknown:
+		return l, nil
+	}
+
+	result := make([]attr.Value, 0, len(l.elements))
+
+	for _, element := range l.elements {
+		if fn(element) {
+			result = append(result, element)
+		}
+	}
+
+	return NewListValue(l.ElementType(ctx), result)
+}
+
+// Contains returns true if the List has an element equal to the given element.
+// Null and unknown Lists do not contain any elements. Error diagnostics are
+// returned if the element does not match the element type.
+func (l ListValue) Contains(ctx context.Context, element attr.Value) (bool, diag.Diagnostics) {
+	diags := validateOperationElements(ctx, "List", "checking", l.ElementType(ctx), []attr.Value{element})
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, e := range l.elements {
+		if e.Equal(element) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -328,3 +328,59 @@ func (m MapValue) String() string {
 func (m MapValue) ToMapValue(context.Context) (MapValue, diag.Diagnostics) {
 	return m, nil
 }
+
+// Merge returns a copy of the Map with the elements of the given Maps added.
+// Elements of later Maps replace elements with the same key. If any Map is
+// unknown, an unknown Map is returned. Error diagnostics are returned if any
+// Map is null or the element types differ.
+func (m MapValue) Merge(ctx context.Context, others ...MapValue) (MapValue, diag.Diagnostics) {
+	unknown := m.IsUnknown()
+
+	for _, mapValue := range append([]MapValue{m}, others...) {
+		if mapValue.IsNull() {
+			return m, diag.Diagnostics{nullOperationDiagnostic("Map", "merging")}
+		}
+
+		if !m.ElementType(ctx).Equal(mapValue.ElementType(ctx)) {
+			return m, diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Element Type",
+					"While merging Map values, the Maps had different element types. "+
+						"Map operations require the same element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						fmt.Sprintf("Map Element Type: %s\n", m.ElementType(ctx))+
+						fmt.Sprintf("Other Map Element Type: %s", mapValue.ElementType(ctx)),
+				),
+			}
+		}
+
+		unknown = unknown || mapValue.IsUnknown()
+	}
+
+	if unknown {
+		return NewMapUnknown(m.ElementType(ctx)), nil
+	}
+
+	result := make(map[string]attr.Value, len(m.elements))
+
+	for _, mapValue := range append([]MapValue{m}, others...) {
+		for key, element := range mapValue.elements {
+			result[key] = element
+		}
+	}
+
+	return NewMapValue(m.ElementType(ctx), result)
+}
+
+// Keys returns the sorted keys of the Map. Null and unknown Maps have no keys.
+func (m MapValue) Keys() []string {
+	keys := make([]string, 0, len(m.elements))
+
+	for key := range m.elements {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
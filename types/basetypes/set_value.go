@@ -322,3 +322,190 @@ func (s SetValue) String() string {
 func (s SetValue) ToSetValue(context.Context) (SetValue, diag.Diagnostics) {
 	return s, nil
 }
+
+// Append returns a copy of the Set with the given elements added, except
+// those equal to an existing element. Appending to an unknown Set returns an
+// unknown Set. Error diagnostics are returned if the Set is null or an element
+// does not match the element type.
+func (s SetValue) Append(ctx context.Context, elements ...attr.Value) (SetValue, diag.Diagnostics) {
+	diags := validateOperationElements(ctx, "Set", "appending to", s.ElementType(ctx), elements)
+
+	if diags.HasError() {
+		return s, diags
+	}
+
+	switch s.state {
+	case attr.ValueStateNull:
+		return s, diag.Diagnostics{nullOperationDiagnostic("Set", "appending to")}
+	case attr.ValueStateUnknown:
+		return s, nil
+	}
+
+	return s.union(ctx, elements)
+}
+
+// Remove returns a copy of the Set without any elements equal to the given
+// elements. Removing from an unknown Set returns an unknown Set. Error
+// diagnostics are returned if the Set is null or an element does not match the
+// element type.
+func (s SetValue) Remove(ctx context.Context, elements ...attr.Value) (SetValue, diag.Diagnostics) {
+	diags := validateOperationElements(ctx, "Set", "removing from", s.ElementType(ctx), elements)
+
+	if diags.HasError() {
+		return s, diags
+	}
+
+	switch s.state {
+	case attr.ValueStateNull:
+		return s, diag.Diagnostics{nullOperationDiagnostic("Set", "removing from")}
+	case attr.ValueStateUnknown:
+		return s, nil
+	}
+
+	return s.filterIndex(ctx, elements, false)
+}
+
+// Filter returns a copy of the Set with only the elements for which the given
+// function returns true. Filtering an unknown Set returns an unknown Set. Error
+// diagnostics are returned if the Set is null.
+func (s SetValue) Filter(ctx context.Context, fn func(attr.Value) bool) (SetValue, diag.Diagnostics) {
+	switch s.state {
+	case attr.ValueStateNull:
+		return s, diag.Diagnostics{nullOperationDiagnostic("Set", "filtering")}
+	case attr.ValueStateUnknown:
+		return s, nil
+	}
+
+	result := make([]attr.Value, 0, len(s.elements))
+
+	for _, element := range s.elements {
+		if fn(element) {
+			result = append(result, element)
+		}
+	}
+
+	return NewSetValue(s.ElementType(ctx), result)
+}
+
+// Contains returns true if the Set has an element equal to the given element.
+// Null and unknown Sets do not contain any elements. Error diagnostics are
+// returned if the element does not match the element type.
+func (s SetValue) Contains(ctx context.Context, element attr.Value) (bool, diag.Diagnostics) {
+	diags := validateOperationElements(ctx, "Set", "checking", s.ElementType(ctx), []attr.Value{element})
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return s.contains(element), nil
+}
+
+// Union returns a new Set with the elements of both Sets. If either Set is
+// unknown, an unknown Set is returned. Error diagnostics are returned if
+// either Set is null or the element types differ.
+func (s SetValue) Union(ctx context.Context, other SetValue) (SetValue, diag.Diagnostics) {
+	if diags := s.validateSetOperation(ctx, "combining", other); diags.HasError() || s.IsUnknown() || other.IsUnknown() {
+		return NewSetUnknown(s.ElementType(ctx)), diags
+	}
+
+	return s.union(ctx, other.elements)
+}
+
+// Intersection returns a new Set with only the elements in both Sets. If
+// either Set is unknown, an unknown Set is returned. Error diagnostics are
+// returned if either Set is null or the element types differ.
+func (s SetValue) Intersection(ctx context.Context, other SetValue) (SetValue, diag.Diagnostics) {
+	if diags := s.validateSetOperation(ctx, "intersecting", other); diags.HasError() || s.IsUnknown() || other.IsUnknown() {
+		return NewSetUnknown(s.ElementType(ctx)), diags
+	}
+
+	return s.filterIndex(ctx, other.elements, true)
+}
+
+// Difference returns a new Set with only the elements of this Set which are
+// not in the other Set. If either Set is unknown, an unknown Set is returned.
+// Error diagnostics are returned if either Set is null or the element types
+// differ.
+func (s SetValue) Difference(ctx context.Context, other SetValue) (SetValue, diag.Diagnostics) {
+	if diags := s.validateSetOperation(ctx, "subtracting", other); diags.HasError() || s.IsUnknown() || other.IsUnknown() {
+		return NewSetUnknown(s.ElementType(ctx)), diags
+	}
+
+	return s.filterIndex(ctx, other.elements, false)
+}
+
+// validateSetOperation returns error diagnostics if either Set is null or the
+// element types differ.
+func (s SetValue) validateSetOperation(ctx context.Context, operation string, other SetValue) diag.Diagnostics {
+	if s.IsNull() || other.IsNull() {
+		return diag.Diagnostics{nullOperationDiagnostic("Set", operation)}
+	}
+
+	if !s.ElementType(ctx).Equal(other.ElementType(ctx)) {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Set Element Type",
+				fmt.Sprintf("While %s Set values, the Sets had different element types. ", operation)+
+					"Set operations require the same element type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Set Element Type: %s\n", s.ElementType(ctx))+
+					fmt.Sprintf("Other Set Element Type: %s", other.ElementType(ctx)),
+			),
+		}
+	}
+
+	return nil
+}
+
+// union returns a new Set with the elements of this Set and any given
+// elements which are not equal to an existing element.
+func (s SetValue) union(ctx context.Context, elements []attr.Value) (SetValue, diag.Diagnostics) {
+	index, diags := newValueIndex(ctx, s.elements)
+
+	if diags.HasError() {
+		return s, diags
+	}
+
+	result := make([]attr.Value, 0, len(s.elements)+len(elements))
+	result = append(result, s.elements...)
+
+	for _, element := range elements {
+		added, diags := index.add(ctx, element)
+
+		if diags.HasError() {
+			return s, diags
+		}
+
+		if added {
+			result = append(result, element)
+		}
+	}
+
+	return NewSetValue(s.ElementType(ctx), result)
+}
+
+// filterIndex returns a new Set with the elements of this Set which are, or
+// are not, equal to any of the given elements.
+func (s SetValue) filterIndex(ctx context.Context, elements []attr.Value, keep bool) (SetValue, diag.Diagnostics) {
+	index, diags := newValueIndex(ctx, elements)
+
+	if diags.HasError() {
+		return s, diags
+	}
+
+	result := make([]attr.Value, 0, len(s.elements))
+
+	for _, element := range s.elements {
+		found, diags := index.contains(ctx, element)
+
+		if diags.HasError() {
+			return s, diags
+		}
+
+		if found == keep {
+			result = append(result, element)
+		}
+	}
+
+	return NewSetValue(s.ElementType(ctx), result)
+}
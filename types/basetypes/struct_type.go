@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	attrValueReflectType      = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	bigFloatReflectType       = reflect.TypeOf((*big.Float)(nil))
+	bigIntReflectType         = reflect.TypeOf((*big.Int)(nil))
+	nullableReflectType       = reflect.TypeOf((*refl.Nullable)(nil)).Elem()
+	unknownableReflectType    = reflect.TypeOf((*refl.Unknownable)(nil)).Elem()
+	valueCreatorReflectType   = reflect.TypeOf((*tftypes.ValueCreator)(nil)).Elem()
+	valueConverterReflectType = reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()
+)
+
+// ObjectTypeFromStruct returns an ObjectType with attribute types inferred from
+// the struct type T, following the same "tfsdk" struct tag rules as
+// NewObjectValueFrom and ObjectValue.As. This removes the need to maintain a
+// separate map[string]attr.Type which matches the struct.
+//
+// Attribute types are inferred from field types as follows:
+//
+//   - attr.Value implementations use the type of their zero value, which must
+//     include all element and attribute type information. For example, a
+//     types.List field cannot be inferred, as its zero value has no element
+//     type, while a types.String field can.
+//   - bool is a BoolType.
+//   - float32 is a Float32Type and float64 is a Float64Type.
+//   - int32 is an Int32Type, uint and uint64 are a NumberType, and all other
+//     integers are an Int64Type.
+//   - *big.Float and *big.Int are a NumberType.
+//   - string is a StringType.
+//   - Slices are a ListType, arrays are a TupleType, and maps with string keys
+//     are a MapType, of the inferred element type.
+//   - Structs are an ObjectType of the inferred attribute types. Structs must
+//     have at least one field with a "tfsdk" struct tag.
+//   - Pointers are the inferred type of the type they point to.
+//   - time.Time, time.Duration, json.RawMessage, and encoding.TextMarshaler or
+//     encoding.TextUnmarshaler implementations are a StringType.
+//   - Go types with a converter registered via tfsdk.RegisterConverter are the
+//     inferred type of the converter intermediate Go type.
+//
+// An error diagnostic is returned if an attribute type cannot be inferred.
+func ObjectTypeFromStruct[T any](ctx context.Context) (ObjectType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+
+	for structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+
+	if structType.Kind() != reflect.Struct {
+		diags.AddError(
+			"Object Type Inference Error",
+			"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot infer object attribute types from %s, is not a struct.", structType),
+		)
+
+		return ObjectType{}, diags
+	}
+
+	typ, typDiags := typeFromReflectType(ctx, structType, path.Empty(), map[reflect.Type]bool{})
+
+	diags.Append(typDiags...)
+
+	if diags.HasError() {
+		return ObjectType{}, diags
+	}
+
+	objectType, ok := typ.(ObjectType)
+
+	if !ok {
+		// This should never happen as structs always return an ObjectType.
+		diags.AddError(
+			"Object Type Inference Error",
+			"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected ObjectType for %s, got: %T", structType, typ),
+		)
+
+		return ObjectType{}, diags
+	}
+
+	return objectType, diags
+}
+
+// ListTypeFromStruct returns a ListType with an element type inferred from the
+// struct type T. Refer to ObjectTypeFromStruct for the inference rules.
+func ListTypeFromStruct[T any](ctx context.Context) (ListType, diag.Diagnostics) {
+	objectType, diags := ObjectTypeFromStruct[T](ctx)
+
+	if diags.HasError() {
+		return ListType{}, diags
+	}
+
+	return ListType{ElemType: objectType}, diags
+}
+
+// MapTypeFromStruct returns a MapType with an element type inferred from the
+// struct type T. Refer to ObjectTypeFromStruct for the inference rules.
+func MapTypeFromStruct[T any](ctx context.Context) (MapType, diag.Diagnostics) {
+	objectType, diags := ObjectTypeFromStruct[T](ctx)
+
+	if diags.HasError() {
+		return MapType{}, diags
+	}
+
+	return MapType{ElemType: objectType}, diags
+}
+
+// SetTypeFromStruct returns a SetType with an element type inferred from the
+// struct type T. Refer to ObjectTypeFromStruct for the inference rules.
+func SetTypeFromStruct[T any](ctx context.Context) (SetType, diag.Diagnostics) {
+	objectType, diags := ObjectTypeFromStruct[T](ctx)
+
+	if diags.HasError() {
+		return SetType{}, diags
+	}
+
+	return SetType{ElemType: objectType}, diags
+}
+
+// typeFromReflectType returns the attr.Type inferred from the Go type `typ`.
+// The `inProgress` map holds struct types which are currently being inferred,
+// to prevent infinite recursion with self-referencing structs.
+func typeFromReflectType(ctx context.Context, typ reflect.Type, path path.Path, inProgress map[reflect.Type]bool) (attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// attr.Value implementations must be checked first, as most are structs.
+	if typ.Kind() != reflect.Interface && typ.Implements(attrValueReflectType) {
+		zero := reflect.Zero(typ)
+
+		if typ.Kind() == reflect.Pointer {
+			zero = reflect.New(typ.Elem())
+		}
+
+		attrType := zero.Interface().(attr.Value).Type(ctx) //nolint:forcetypeassert // Implements is checked above
+
+		if isMissingTypeInfo(attrType) {
+			diags.Append(typeInferenceErrorDiag(
+				pathDetail(path, fmt.Sprintf("the zero value of %s does not include element or attribute type information. "+
+					"Use a type whose zero value includes type information or declare the attribute types explicitly.", typ)),
+			))
+
+			return nil, diags
+		}
+
+		return attrType, diags
+	}
+
+	// Registered converters use the attribute type of their intermediate Go
+	// type.
+	if intermediateType, ok := refl.ConverterIntermediateType(typ); ok {
+		return typeFromReflectType(ctx, intermediateType, path, inProgress)
+	}
+
+	// Text types, such as time.Time, are converted to and from strings.
+	if refl.IsTextType(typ) {
+		return StringType{}, diags
+	}
+
+	switch typ {
+	case bigFloatReflectType, bigIntReflectType:
+		return NumberType{}, diags
+	}
+
+	for _, iface := range []reflect.Type{nullableReflectType, unknownableReflectType, valueCreatorReflectType, valueConverterReflectType} {
+		if typ.Implements(iface) || reflect.PointerTo(typ).Implements(iface) {
+			diags.Append(typeInferenceErrorDiag(
+				pathDetail(path, fmt.Sprintf("%s implements %s, so its attribute type cannot be inferred.", typ, iface)),
+			))
+
+			return nil, diags
+		}
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return BoolType{}, diags
+	case reflect.Float32:
+		return Float32Type{}, diags
+	case reflect.Float64:
+		return Float64Type{}, diags
+	case reflect.Int32:
+		return Int32Type{}, diags
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return Int64Type{}, diags
+	case reflect.Uint, reflect.Uint64:
+		return NumberType{}, diags
+	case reflect.String:
+		return StringType{}, diags
+	case reflect.Pointer:
+		return typeFromReflectType(ctx, typ.Elem(), path, inProgress)
+	case reflect.Slice:
+		elemType, elemDiags := typeFromReflectType(ctx, typ.Elem(), path, inProgress)
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return ListType{ElemType: elemType}, diags
+	case reflect.Array:
+		elemType, elemDiags := typeFromReflectType(ctx, typ.Elem(), path, inProgress)
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elemTypes := make([]attr.Type, typ.Len())
+
+		for i := range elemTypes {
+			elemTypes[i] = elemType
+		}
+
+		return TupleType{ElemTypes: elemTypes}, diags
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			diags.Append(typeInferenceErrorDiag(
+				pathDetail(path, fmt.Sprintf("%s must use string keys.", typ)),
+			))
+
+			return nil, diags
+		}
+
+		elemType, elemDiags := typeFromReflectType(ctx, typ.Elem(), path, inProgress)
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return MapType{ElemType: elemType}, diags
+	case reflect.Struct:
+		if inProgress[typ] {
+			diags.Append(typeInferenceErrorDiag(
+				pathDetail(path, fmt.Sprintf("%s is recursive, which cannot be represented as an object type.", typ)),
+			))
+
+			return nil, diags
+		}
+
+		inProgress[typ] = true
+		defer delete(inProgress, typ)
+
+		fields, err := refl.StructTags(ctx, typ, path)
+
+		if err != nil {
+			// The error already includes the path, which is empty for the
+			// top level struct.
+			diags.Append(typeInferenceErrorDiag(strings.TrimPrefix(err.Error(), ": ")))
+
+			return nil, diags
+		}
+
+		if len(fields) == 0 {
+			diags.Append(typeInferenceErrorDiag(
+				pathDetail(path, fmt.Sprintf("%s has no fields with tfsdk struct tags.", typ)),
+			))
+
+			return nil, diags
+		}
+
+		attrTypes := make(map[string]attr.Type, len(fields))
+
+		for name, index := range fields {
+			attrType, attrDiags := typeFromReflectType(ctx, typ.FieldByIndex(index).Type, path.AtName(name), inProgress)
+
+			diags.Append(attrDiags...)
+
+			attrTypes[name] = attrType
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return ObjectType{AttrTypes: attrTypes}, diags
+	default:
+		diags.Append(typeInferenceErrorDiag(
+			pathDetail(path, fmt.Sprintf("%s (%s) has no equivalent attribute type.", typ, typ.Kind())),
+		))
+
+		return nil, diags
+	}
+}
+
+// isMissingTypeInfo returns true if the given type, or any of its element or
+// attribute types, is missing type information.
+func isMissingTypeInfo(typ attr.Type) bool {
+	if typ == nil {
+		return true
+	}
+
+	if _, ok := typ.(missingType); ok {
+		return true
+	}
+
+	switch t := typ.(type) {
+	case attr.TypeWithElementType:
+		return isMissingTypeInfo(t.ElementType())
+	case attr.TypeWithAttributeTypes:
+		attrTypes := t.AttributeTypes()
+
+		if len(attrTypes) == 0 {
+			return true
+		}
+
+		for _, attrType := range attrTypes {
+			if isMissingTypeInfo(attrType) {
+				return true
+			}
+		}
+	case attr.TypeWithElementTypes:
+		elemTypes := t.ElementTypes()
+
+		if len(elemTypes) == 0 {
+			return true
+		}
+
+		for _, elemType := range elemTypes {
+			if isMissingTypeInfo(elemType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// pathDetail prefixes the given detail with the path, if not empty.
+func pathDetail(p path.Path, detail string) string {
+	if len(p.Steps()) == 0 {
+		return detail
+	}
+
+	return p.String() + ": " + detail
+}
+
+// typeInferenceErrorDiag returns an error diagnostic for when an attribute
+// type cannot be inferred from a Go type.
+func typeInferenceErrorDiag(detail string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Object Type Inference Error",
+		"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"Cannot infer attribute type: "+detail,
+	)
+}
@@ -0,0 +1,497 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+type structTypeTestNested struct {
+	Value string `tfsdk:"value"`
+}
+
+type structTypeTestUntagged struct {
+	Ignored string `tfsdk:"-"`
+}
+
+// structTypeTestConverted has a converter registered to int64.
+type structTypeTestConverted struct {
+	value int64
+}
+
+func init() {
+	refl.RegisterConverter(
+		func(v structTypeTestConverted) (int64, error) { return v.value, nil },
+		func(v int64) (structTypeTestConverted, error) { return structTypeTestConverted{value: v}, nil },
+	)
+}
+
+type structTypeTestRecursive struct {
+	Children []structTypeTestRecursive `tfsdk:"children"`
+}
+
+func TestObjectTypeFromStruct(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeFunc      func(context.Context) (ObjectType, diag.Diagnostics)
+		expected      ObjectType
+		expectedDiags diag.Diagnostics
+	}{
+		"go-types": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Bool     bool       `tfsdk:"bool"`
+				Float32  float32    `tfsdk:"float32"`
+				Float64  float64    `tfsdk:"float64"`
+				Int      int        `tfsdk:"int"`
+				Int32    int32      `tfsdk:"int32"`
+				Uint64   uint64     `tfsdk:"uint64"`
+				BigFloat *big.Float `tfsdk:"big_float"`
+				BigInt   *big.Int   `tfsdk:"big_int"`
+				String   string     `tfsdk:"string"`
+				Pointer  *string    `tfsdk:"pointer"`
+				Ignored  string     `tfsdk:"-"`
+				private  string
+			}],
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"bool":      BoolType{},
+					"float32":   Float32Type{},
+					"float64":   Float64Type{},
+					"int":       Int64Type{},
+					"int32":     Int32Type{},
+					"uint64":    NumberType{},
+					"big_float": NumberType{},
+					"big_int":   NumberType{},
+					"string":    StringType{},
+					"pointer":   StringType{},
+				},
+			},
+		},
+		"go-collections": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				List   []string                           `tfsdk:"list"`
+				Map    map[string]int64                   `tfsdk:"map"`
+				Tuple  [2]bool                            `tfsdk:"tuple"`
+				Object structTypeTestNested               `tfsdk:"object"`
+				Nested []map[string]*structTypeTestNested `tfsdk:"nested"`
+			}],
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"list": ListType{ElemType: StringType{}},
+					"map":  MapType{ElemType: Int64Type{}},
+					"tuple": TupleType{
+						ElemTypes: []attr.Type{BoolType{}, BoolType{}},
+					},
+					"object": ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"value": StringType{},
+						},
+					},
+					"nested": ListType{
+						ElemType: MapType{
+							ElemType: ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"value": StringType{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"attr-values": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				String  StringValue  `tfsdk:"string"`
+				Int64   Int64Value   `tfsdk:"int64"`
+				Pointer *BoolValue   `tfsdk:"pointer"`
+				Slice   []Int32Value `tfsdk:"slice"`
+			}],
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"string":  StringType{},
+					"int64":   Int64Type{},
+					"pointer": BoolType{},
+					"slice":   ListType{ElemType: Int32Type{}},
+				},
+			},
+		},
+		"embedded-struct": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				structTypeTestNested
+				Count int64 `tfsdk:"count,omitempty"`
+			}],
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"count": Int64Type{},
+					"value": StringType{},
+				},
+			},
+		},
+		"pointer-struct": {
+			typeFunc: ObjectTypeFromStruct[*structTypeTestNested],
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"value": StringType{},
+				},
+			},
+		},
+		"text-types": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Time        time.Time       `tfsdk:"time"`
+				TimePointer *time.Time      `tfsdk:"time_pointer"`
+				Duration    time.Duration   `tfsdk:"duration"`
+				JSON        json.RawMessage `tfsdk:"json"`
+				Addr        netip.Addr      `tfsdk:"addr"`
+			}],
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"time":         StringType{},
+					"time_pointer": StringType{},
+					"duration":     StringType{},
+					"json":         StringType{},
+					"addr":         StringType{},
+				},
+			},
+		},
+		"converter": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Converted structTypeTestConverted `tfsdk:"converted"`
+			}],
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"converted": Int64Type{},
+				},
+			},
+		},
+		"empty-struct": {
+			typeFunc: ObjectTypeFromStruct[struct{}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: struct {} has no fields with tfsdk struct tags.",
+				),
+			},
+		},
+		"nested-struct-without-tags": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Nested structTypeTestUntagged `tfsdk:"nested"`
+			}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: nested: basetypes.structTypeTestUntagged has no fields with tfsdk struct tags.",
+				),
+			},
+		},
+		"not-a-struct": {
+			typeFunc: ObjectTypeFromStruct[string],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer object attribute types from string, is not a struct.",
+				),
+			},
+		},
+		"missing-tag": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Value string
+			}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Cannot infer attribute type: need a struct tag for "tfsdk" on Value`,
+				),
+			},
+		},
+		"attr-value-missing-element-type": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				List ListValue `tfsdk:"list"`
+			}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: list: the zero value of basetypes.ListValue does not include element or attribute type information. "+
+						"Use a type whose zero value includes type information or declare the attribute types explicitly.",
+				),
+			},
+		},
+		"attr-value-missing-attribute-types": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Object ObjectValue `tfsdk:"object"`
+			}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: object: the zero value of basetypes.ObjectValue does not include element or attribute type information. "+
+						"Use a type whose zero value includes type information or declare the attribute types explicitly.",
+				),
+			},
+		},
+		"attr-value-interface": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Value attr.Value `tfsdk:"value"`
+			}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: value: attr.Value (interface) has no equivalent attribute type.",
+				),
+			},
+		},
+		"map-non-string-keys": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Map map[int]string `tfsdk:"map"`
+			}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: map: map[int]string must use string keys.",
+				),
+			},
+		},
+		"nested-missing-element-type": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Nested []struct {
+					Set SetValue `tfsdk:"set"`
+				} `tfsdk:"nested"`
+			}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: nested.set: the zero value of basetypes.SetValue does not include element or attribute type information. "+
+						"Use a type whose zero value includes type information or declare the attribute types explicitly.",
+				),
+			},
+		},
+		"recursive": {
+			typeFunc: ObjectTypeFromStruct[structTypeTestRecursive],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: children: basetypes.structTypeTestRecursive is recursive, which cannot be represented as an object type.",
+				),
+			},
+		},
+		"unsupported-kind": {
+			typeFunc: ObjectTypeFromStruct[struct {
+				Func func() `tfsdk:"func"`
+			}],
+			expected: ObjectType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Inference Error",
+					"An unexpected error was encountered trying to infer object attribute types from a Go type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer attribute type: func: func() (func) has no equivalent attribute type.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typeFunc(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCollectionTypeFromStruct(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"value": StringType{},
+		},
+	}
+
+	testCases := map[string]struct {
+		typeFunc      func() (attr.Type, diag.Diagnostics)
+		expected      attr.Type
+		expectedDiags bool
+	}{
+		"list": {
+			typeFunc: func() (attr.Type, diag.Diagnostics) { return ListTypeFromStruct[structTypeTestNested](ctx) },
+			expected: ListType{ElemType: objectType},
+		},
+		"list-error": {
+			typeFunc:      func() (attr.Type, diag.Diagnostics) { return ListTypeFromStruct[string](ctx) },
+			expectedDiags: true,
+		},
+		"map": {
+			typeFunc: func() (attr.Type, diag.Diagnostics) { return MapTypeFromStruct[structTypeTestNested](ctx) },
+			expected: MapType{ElemType: objectType},
+		},
+		"map-error": {
+			typeFunc:      func() (attr.Type, diag.Diagnostics) { return MapTypeFromStruct[string](ctx) },
+			expectedDiags: true,
+		},
+		"set": {
+			typeFunc: func() (attr.Type, diag.Diagnostics) { return SetTypeFromStruct[structTypeTestNested](ctx) },
+			expected: SetType{ElemType: objectType},
+		},
+		"set-error": {
+			typeFunc:      func() (attr.Type, diag.Diagnostics) { return SetTypeFromStruct[string](ctx) },
+			expectedDiags: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typeFunc()
+
+			if diags.HasError() != testCase.expectedDiags {
+				t.Fatalf("expected error diagnostics %t, got: %v", testCase.expectedDiags, diags)
+			}
+
+			if testCase.expectedDiags {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectTypeFromStruct_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Name  string                 `tfsdk:"name"`
+		Items []structTypeTestNested `tfsdk:"items"`
+	}
+
+	ctx := context.Background()
+	in := model{
+		Name:  "test",
+		Items: []structTypeTestNested{{Value: "one"}},
+	}
+
+	objectType, diags := ObjectTypeFromStruct[model](ctx)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics inferring type: %v", diags)
+	}
+
+	value, diags := NewObjectValueFrom(ctx, objectType.AttrTypes, in)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics creating value: %v", diags)
+	}
+
+	var got model
+
+	diags = value.As(ctx, &got, ObjectAsOptions{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics converting value: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, in); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestObjectTypeFromStruct_roundTripTime(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Name      string    `tfsdk:"name"`
+		CreatedAt time.Time `tfsdk:"created_at"`
+	}
+
+	ctx := context.Background()
+	in := model{
+		Name:      "test",
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	objectType, diags := ObjectTypeFromStruct[model](ctx)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics inferring type: %v", diags)
+	}
+
+	value, diags := NewObjectValueFrom(ctx, objectType.AttrTypes, in)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics creating value: %v", diags)
+	}
+
+	if diff := cmp.Diff(value.Attributes()["created_at"], NewStringValue("2024-01-02T03:04:05Z")); diff != "" {
+		t.Errorf("unexpected created_at difference: %s", diff)
+	}
+
+	var got model
+
+	diags = value.As(ctx, &got, ObjectAsOptions{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics converting value: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, in); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -9,17 +9,27 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 var (
 	_ attr.Type                 = TupleType{}
 	_ attr.TypeWithElementTypes = TupleType{}
+	_ TupleTypable              = TupleType{}
 )
 
-// TupleType implements a tuple type definition. This type intentionally includes less functionality
-// than other types in the type system as it has limited real world application and therefore
-// is not exposed to provider developers.
+// TupleTypable extends attr.Type for tuple types.
+// Implement this interface to create a custom TupleType type.
+type TupleTypable interface {
+	attr.Type
+
+	// ValueFromTuple should convert the Tuple to a TupleValuable type.
+	ValueFromTuple(context.Context, TupleValue) (TupleValuable, diag.Diagnostics)
+}
+
+// TupleType implements a tuple type definition, which is an ordered,
+// fixed-length list of elements where each element has its own type.
 type TupleType struct {
 	// ElemTypes is an ordered list of element types for the tuple.
 	ElemTypes []attr.Type
@@ -130,6 +140,11 @@ func (t TupleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 	return NewTupleValueMust(t.ElementTypes(), elems), nil
 }
 
+// ValueFromTuple returns a TupleValuable type given a TupleValue.
+func (t TupleType) ValueFromTuple(_ context.Context, tuple TupleValue) (TupleValuable, diag.Diagnostics) {
+	return tuple, nil
+}
+
 // ValueType returns the Value type.
 func (t TupleType) ValueType(_ context.Context) attr.Value {
 	return TupleValue{
@@ -13,7 +13,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ attr.Value = TupleValue{}
+var (
+	_ attr.Value    = TupleValue{}
+	_ TupleValuable = TupleValue{}
+)
+
+// TupleValuable extends attr.Value for tuple value types.
+// Implement this interface to create a custom Tuple value type.
+type TupleValuable interface {
+	attr.Value
+
+	// ToTupleValue should convert the value type to a Tuple.
+	ToTupleValue(ctx context.Context) (TupleValue, diag.Diagnostics)
+}
 
 // NewTupleNull creates a Tuple with a null value.
 func NewTupleNull(elementTypes []attr.Type) TupleValue {
@@ -107,9 +119,7 @@ func NewTupleValueMust(elementTypes []attr.Type, elements []attr.Value) TupleVal
 	return tuple
 }
 
-// TupleValue represents an ordered list of attr.Value, with an attr.Type for each element. This type intentionally
-// includes less functionality than other types in the type system as it has limited real world application and therefore
-// is not exposed to provider developers.
+// TupleValue represents an ordered list of attr.Value, with an attr.Type for each element.
 type TupleValue struct {
 	// elements is the ordered list of known element values for the tuple.
 	elements []attr.Value
@@ -252,3 +262,8 @@ func (v TupleValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 		panic(fmt.Sprintf("unhandled Tuple state in ToTerraformValue: %s", v.state))
 	}
 }
+
+// ToTupleValue returns the Tuple.
+func (v TupleValue) ToTupleValue(context.Context) (TupleValue, diag.Diagnostics) {
+	return v, nil
+}
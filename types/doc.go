@@ -8,4 +8,9 @@
 // use cases. The actual schema-ready type and value type implementations are
 // under the basetypes package. Embed those basetypes implementations to create
 // custom types.
+//
+// The generic ListOf, SetOf, MapOf, and ObjectOf value types, and their
+// associated ListOfType, SetOfType, MapOfType, and ObjectOfType types, derive
+// element and attribute type information from their type parameter, so data
+// model structs can carry full type information.
 package types
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// elementTypeOf returns the attr.Type of the zero value of T. The generic
+// collection types rely on the zero value of their element value type
+// reporting full type information, which is true for all framework-defined
+// primitive value types and the generic collection and object types in this
+// package.
+func elementTypeOf[T attr.Value](ctx context.Context) attr.Type {
+	var zero T
+
+	// T is an interface type, such as attr.Value, so there is no concrete
+	// type information available.
+	if any(zero) == nil {
+		return nil
+	}
+
+	return zero.Type(ctx)
+}
+
+// elementValueOf converts the given value into the value type T. Values which
+// are not already T, such as a basetypes.ObjectValue when T is an ObjectOf,
+// are round-tripped through their Terraform value using the element type.
+func elementValueOf[T attr.Value](ctx context.Context, elementType attr.Type, value attr.Value) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var zero T
+
+	if typed, ok := value.(T); ok {
+		return typed, diags
+	}
+
+	if elementType == nil {
+		diags.Append(missingElementTypeDiag[T]())
+
+		return zero, diags
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Element Conversion Error",
+			"An unexpected error was encountered trying to convert an element value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Unable to convert %T to Terraform value: %s", value, err),
+		)
+
+		return zero, diags
+	}
+
+	attrValue, err := elementType.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Element Conversion Error",
+			"An unexpected error was encountered trying to convert an element value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Unable to convert %T to %T: %s", value, zero, err),
+		)
+
+		return zero, diags
+	}
+
+	typed, ok := attrValue.(T)
+
+	if !ok {
+		diags.AddError(
+			"Element Conversion Error",
+			"An unexpected error was encountered trying to convert an element value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Element type %s returned %T, expected %T", elementType, attrValue, zero),
+		)
+
+		return zero, diags
+	}
+
+	return typed, diags
+}
+
+// diagsString returns the given diagnostics as a string, for use in the panic
+// message of the generic Must creation functions.
+func diagsString(diags diag.Diagnostics) string {
+	diagsStrings := make([]string, 0, len(diags))
+
+	for _, diagnostic := range diags {
+		diagsStrings = append(diagsStrings, fmt.Sprintf(
+			"%s | %s | %s",
+			diagnostic.Severity(),
+			diagnostic.Summary(),
+			diagnostic.Detail()))
+	}
+
+	return strings.Join(diagsStrings, "\n")
+}
+
+// missingElementTypeDiag returns an error diagnostic for when the element type
+// of a generic collection cannot be determined from T.
+func missingElementTypeDiag[T attr.Value]() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Missing Element Type",
+		"An unexpected error was encountered trying to create a collection value. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("The element type cannot be determined from %s. Use a concrete value type as the type parameter.", reflect.TypeOf((*T)(nil)).Elem()),
+	)
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package jsontypes contains custom string types for JSON (RFC 7159) data.
+//
+// Use the Normalized type for JSON strings where formatting, such as
+// whitespace and object key order, is inconsequential. Use the Exact type
+// for JSON strings which must be preserved byte-for-byte.
+//
+// Both types validate that values are valid JSON. Set the CustomType field of
+// a schema string attribute to NormalizedType{} or ExactType{} to use them.
+package jsontypes
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = ExactType{}
+	_ xattr.TypeWithValidate  = ExactType{}
+)
+
+// ExactType is an attribute type that represents a valid JSON string (RFC
+// 7159). No semantic equality logic is defined for ExactType, so it follows
+// Terraform's data-consistency rules for strings, which must match
+// byte-for-byte. Consider using NormalizedType to allow inconsequential
+// differences between JSON strings, such as whitespace or key order.
+type ExactType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ExactType) String() string {
+	return "jsontypes.ExactType"
+}
+
+// ValueType returns the Value type.
+func (t ExactType) ValueType(_ context.Context) attr.Value {
+	return Exact{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ExactType) Equal(o attr.Type) bool {
+	other, ok := o.(ExactType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate implements type validation. This type requires the value provided
+// to be a String value that is valid JSON format (RFC 7159).
+func (t ExactType) Validate(_ context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	return validateJSON(in, valuePath, "JSON Exact")
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ExactType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Exact{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t ExactType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+func TestExactTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			in: tftypes.Value{},
+		},
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"valid-object": {
+			in: tftypes.NewValue(tftypes.String, `{"hello": "world", "nums": [1, 2.5]}`),
+		},
+		"valid-scalar": {
+			in: tftypes.NewValue(tftypes.String, `true`),
+		},
+		"invalid": {
+			in: tftypes.NewValue(tftypes.String, `{"hello": }`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSON String Value",
+					"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+						"Given Value: {\"hello\": }\n",
+				),
+			},
+		},
+		"wrong-value-type": {
+			in: tftypes.NewValue(tftypes.Number, 123),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"JSON Exact Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"123\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := jsontypes.ExactType{}.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(testCase.expectedDiags, diags); diff != "" {
+				t.Errorf("unexpected diagnostics (+got, -expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExactTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"true": {
+			in:          tftypes.NewValue(tftypes.String, `{"a":1}`),
+			expectation: jsontypes.NewExactValue(`{"a":1}`),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: jsontypes.NewExactUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.String, nil),
+			expectation: jsontypes.NewExactNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := jsontypes.ExactType{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(testCase.expectation, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExactTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"exact": {
+			other:    jsontypes.ExactType{},
+			expected: true,
+		},
+		"normalized": {
+			other:    jsontypes.NormalizedType{},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := jsontypes.ExactType{}.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+func TestExactEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Value
+		expected bool
+	}{
+		"same": {
+			other:    jsontypes.NewExactValue(`{"a": 1}`),
+			expected: true,
+		},
+		"formatting": {
+			other:    jsontypes.NewExactValue(`{"a":1}`),
+			expected: false,
+		},
+		"normalized": {
+			other:    jsontypes.NewNormalizedValue(`{"a": 1}`),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := jsontypes.NewExactValue(`{"a": 1}`).Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExactUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.Exact
+		expected      map[string]any
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			json: jsontypes.NewExactValue(`{"hello": "world"}`),
+			expected: map[string]any{
+				"hello": "world",
+			},
+		},
+		"null": {
+			json: jsontypes.NewExactNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Exact JSON Unmarshal Error", "JSON string value is null"),
+			},
+		},
+		"unknown": {
+			json: jsontypes.NewExactUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Exact JSON Unmarshal Error", "JSON string value is unknown"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got map[string]any
+
+			diags := testCase.json.Unmarshal(&got)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected result (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
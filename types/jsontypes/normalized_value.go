@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = Normalized{}
+)
+
+// Normalized represents a valid JSON string (RFC 7159). Semantic equality
+// logic is defined for Normalized such that inconsequential differences
+// between JSON strings are ignored, such as whitespace, object key order, and
+// number formatting.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+// Type returns a NormalizedType.
+func (v Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals checks if two Normalized objects have equivalent
+// values, even if there are differences in formatting, such as whitespace,
+// object key order, or number formatting.
+func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorDecoded, err := decode(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	newDecoded, err := decode(newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return jsonEqual(priorDecoded, newDecoded), diags
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the Normalized StringValue
+// and target input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage:
+// https://pkg.go.dev/encoding/json#Unmarshal
+func (v Normalized) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("Normalized JSON Unmarshal Error", "JSON string value is null")
+
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("Normalized JSON Unmarshal Error", "JSON string value is unknown")
+
+		return diags
+	}
+
+	return unmarshal(v.ValueString(), target)
+}
+
+// NewNormalizedNull creates a Normalized with a null value. Determine whether
+// the value is null via IsNull method.
+func NewNormalizedNull() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewNormalizedUnknown creates a Normalized with an unknown value. Determine
+// whether the value is unknown via IsUnknown method.
+func NewNormalizedUnknown() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewNormalizedValue creates a Normalized with a known value. Access the value
+// via ValueString method.
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewNormalizedPointerValue creates a Normalized with a null value if nil or a
+// known value. Access the value via ValueStringPointer method.
+func NewNormalizedPointerValue(value *string) Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// decode unmarshals the given JSON string, preserving numbers as json.Number
+// so no precision is lost before comparison.
+func decode(value string) (any, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(value))
+	decoder.UseNumber()
+
+	var result any
+
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// jsonEqual returns true if the decoded JSON values are equivalent. Object
+// key order is already discarded by decoding and numbers are compared by
+// value rather than by their string representation.
+func jsonEqual(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for key, aElem := range a {
+			bElem, ok := b[key]
+
+			if !ok || !jsonEqual(aElem, bElem) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		b, ok := b.([]any)
+
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !jsonEqual(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	case json.Number:
+		b, ok := b.(json.Number)
+
+		if !ok {
+			return false
+		}
+
+		aFloat, _, aErr := big.ParseFloat(a.String(), 10, 512, big.ToNearestEven)
+		bFloat, _, bErr := big.ParseFloat(b.String(), 10, 512, big.ToNearestEven)
+
+		if aErr != nil || bErr != nil {
+			return a == b
+		}
+
+		return aFloat.Cmp(bFloat) == 0
+	default:
+		return a == b
+	}
+}
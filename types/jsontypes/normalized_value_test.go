@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+func TestNormalizedStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.Normalized
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"hello": "world", "nums": [1, 2]}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"hello": "world", "nums": [1, 2]}`),
+			expectedMatch: true,
+		},
+		"whitespace": {
+			currentJson: jsontypes.NewNormalizedValue(`{"hello":"world","nums":[1,2]}`),
+			givenJson: jsontypes.NewNormalizedValue(`{
+				"hello": "world",
+				"nums": [ 1, 2 ]
+			}`),
+			expectedMatch: true,
+		},
+		"key-order": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"a": {"c": 3, "b": 2}, "d": 4}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"d": 4, "a": {"b": 2, "c": 3}}`),
+			expectedMatch: true,
+		},
+		"number-format": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"n": 1.0, "e": 1e3}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"n": 1, "e": 1000}`),
+			expectedMatch: true,
+		},
+		"large-number-precision": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"n": 9007199254740993}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"n": 9007199254740992}`),
+			expectedMatch: false,
+		},
+		"array-order": {
+			currentJson:   jsontypes.NewNormalizedValue(`[1, 2]`),
+			givenJson:     jsontypes.NewNormalizedValue(`[2, 1]`),
+			expectedMatch: false,
+		},
+		"different-value": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"hello": "world"}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"hello": "there"}`),
+			expectedMatch: false,
+		},
+		"different-key": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"hello": "world"}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"hallo": "world"}`),
+			expectedMatch: false,
+		},
+		"different-type": {
+			currentJson:   jsontypes.NewNormalizedValue(`{"n": 1}`),
+			givenJson:     jsontypes.NewNormalizedValue(`{"n": "1"}`),
+			expectedMatch: false,
+		},
+		"wrong-value-type": {
+			currentJson:   jsontypes.NewNormalizedValue(`{}`),
+			givenJson:     basetypes.NewStringValue(`{}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.Normalized\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"invalid-json": {
+			currentJson:   jsontypes.NewNormalizedValue(`{`),
+			givenJson:     jsontypes.NewNormalizedValue(`{}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Hello string `json:"hello"`
+		Nums  []int  `json:"nums"`
+	}
+
+	testCases := map[string]struct {
+		json          jsontypes.Normalized
+		expected      testStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			json: jsontypes.NewNormalizedValue(`{"hello": "world", "nums": [1, 2]}`),
+			expected: testStruct{
+				Hello: "world",
+				Nums:  []int{1, 2},
+			},
+		},
+		"null": {
+			json: jsontypes.NewNormalizedNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Normalized JSON Unmarshal Error", "JSON string value is null"),
+			},
+		},
+		"unknown": {
+			json: jsontypes.NewNormalizedUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Normalized JSON Unmarshal Error", "JSON string value is unknown"),
+			},
+		},
+		"incompatible-target": {
+			json: jsontypes.NewNormalizedValue(`{"hello": 1}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Unmarshal Error",
+					"An unexpected error occurred while unmarshalling a JSON string. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: json: cannot unmarshal number into Go struct field testStruct.hello of type string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testStruct
+
+			diags := testCase.json.Unmarshal(&got)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected result (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedPointerValue(t *testing.T) {
+	t.Parallel()
+
+	value := `{}`
+
+	if got := jsontypes.NewNormalizedPointerValue(&value); !got.Equal(jsontypes.NewNormalizedValue(value)) {
+		t.Errorf("expected known value, got %s", got)
+	}
+
+	if got := jsontypes.NewNormalizedPointerValue(nil); !got.Equal(jsontypes.NewNormalizedNull()) {
+		t.Errorf("expected null value, got %s", got)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// validateJSON returns an error diagnostic if the given Terraform value is a
+// known string which is not valid JSON. The typeName is used in the summary of
+// unexpected error diagnostics.
+func validateJSON(in tftypes.Value, valuePath path.Path, typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.String) {
+		diags.AddAttributeError(
+			valuePath,
+			typeName+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			typeName+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+
+		return diags
+	}
+
+	if !json.Valid([]byte(value)) {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Given Value: "+value+"\n",
+		)
+	}
+
+	return diags
+}
+
+// unmarshal decodes the given JSON string into the target, returning error
+// diagnostics for invalid JSON or incompatible targets.
+func unmarshal(value string, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := json.Unmarshal([]byte(value), target); err != nil {
+		diags.AddError(
+			"JSON Unmarshal Error",
+			"An unexpected error occurred while unmarshalling a JSON string. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
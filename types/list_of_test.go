@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestListOfTypeElementType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.TypeWithElementType
+		expected attr.Type
+	}{
+		"string": {
+			typ:      types.ListOfType[types.String]{},
+			expected: types.StringType,
+		},
+		"list-of-int64": {
+			typ:      types.ListOfType[types.ListOf[types.Int64]]{},
+			expected: types.ListOfType[types.Int64]{},
+		},
+		"object-of": {
+			typ:      types.ListOfType[types.ObjectOf[testModel]]{},
+			expected: types.ObjectOfType[testModel]{},
+		},
+		"interface": {
+			typ:      types.ListOfType[attr.Value]{},
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ElementType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListOfTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			typ:      types.ListOfType[types.String]{},
+			other:    types.ListOfType[types.String]{},
+			expected: true,
+		},
+		"different-element-type": {
+			typ:      types.ListOfType[types.String]{},
+			other:    types.ListOfType[types.Int64]{},
+			expected: false,
+		},
+		"list-type": {
+			typ:      types.ListOfType[types.String]{},
+			other:    types.ListType{ElemType: types.StringType},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestListOfTypeString(t *testing.T) {
+	t.Parallel()
+
+	got := types.ListOfType[types.String]{}.String()
+	expected := "types.ListOfType[basetypes.StringType]"
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestListOfTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	got := types.ListOfType[types.ObjectOf[testModel]]{}.TerraformType(context.Background())
+	expected := tftypes.List{ElementType: testModelTerraformType}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestListOfTypeValueFromList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            basetypes.ListValue
+		expected      basetypes.ListValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			in:       types.ListNull(testModelObjectType),
+			expected: types.ListOfNull[types.ObjectOf[testModel]](),
+		},
+		"unknown": {
+			in:       types.ListUnknown(testModelObjectType),
+			expected: types.ListOfUnknown[types.ObjectOf[testModel]](),
+		},
+		"known-converted": {
+			in: types.ListValueMust(
+				testModelObjectType,
+				[]attr.Value{
+					types.ObjectValueMust(
+						testModelObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name": types.StringValue("one"),
+							"tags": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+						},
+					),
+				},
+			),
+			expected: types.ListOfValueMust([]types.ObjectOf[testModel]{
+				types.ObjectOfValueMust(context.Background(), testModel{
+					Name: types.StringValue("one"),
+					Tags: types.ListOfValueMust([]types.String{types.StringValue("a")}),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.ListOfType[types.ObjectOf[testModel]]{}.ValueFromList(context.Background(), testCase.in)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestListOfTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"known": {
+			in: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+			expected: types.ListOfValueMust([]types.String{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+		},
+		"null": {
+			in:       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			expected: types.ListOfNull[types.String](),
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			expected: types.ListOfUnknown[types.String](),
+		},
+		"wrong-type": {
+			in:          tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+			expectedErr: "can't use tftypes.List[tftypes.Number]<null> as value of List with ElementType basetypes.StringType, can only use tftypes.String values",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := types.ListOfType[types.String]{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListOfValue(t *testing.T) {
+	t.Parallel()
+
+	got, diags := types.ListOfValue([]types.String{types.StringValue("one")})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")})
+
+	listValue, diags := got.ToListValue(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !listValue.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, listValue)
+	}
+}
+
+func TestListOfElements(t *testing.T) {
+	t.Parallel()
+
+	// the embedded ListValue Elements method is not shadowed
+	var value interface{ Elements() []attr.Value } = types.ListOfValueMust([]types.String{types.StringValue("one")})
+
+	if diff := cmp.Diff(value.Elements(), []attr.Value{types.StringValue("one")}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestListOfTypedElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         types.ListOf[types.String]
+		expected      []types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			value: types.ListOfValueMust([]types.String{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+			expected: []types.String{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			},
+		},
+		"mismatched-element": {
+			value: types.ListOf[types.String]{
+				ListValue: basetypes.NewListValueMust(
+					types.Int64Type,
+					[]attr.Value{
+						types.Int64Value(1),
+					},
+				),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Element Conversion Error",
+					"An unexpected error was encountered trying to convert an element value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unable to convert basetypes.Int64Value to basetypes.StringValue: "+
+						"can't unmarshal tftypes.Number into *string, expected string",
+				),
+			},
+		},
+		"null": {
+			value:    types.ListOfNull[types.String](),
+			expected: []types.String{},
+		},
+		"zero": {
+			value:    types.ListOf[types.String]{},
+			expected: []types.String{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.TypedElements(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListOfEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.ListOf[types.String]
+		other    attr.Value
+		expected bool
+	}{
+		"equal": {
+			value:    types.ListOfValueMust([]types.String{types.StringValue("one")}),
+			other:    types.ListOfValueMust([]types.String{types.StringValue("one")}),
+			expected: true,
+		},
+		"different-elements": {
+			value:    types.ListOfValueMust([]types.String{types.StringValue("one")}),
+			other:    types.ListOfValueMust([]types.String{types.StringValue("two")}),
+			expected: false,
+		},
+		"zero-null": {
+			value:    types.ListOf[types.String]{},
+			other:    types.ListOfNull[types.String](),
+			expected: true,
+		},
+		"list": {
+			value:    types.ListOfValueMust([]types.String{types.StringValue("one")}),
+			other:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestListOfToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.ListOf[types.String]
+		expected tftypes.Value
+	}{
+		"known": {
+			value: types.ListOfValueMust([]types.String{types.StringValue("one")}),
+			expected: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+		},
+		"zero": {
+			value:    types.ListOf[types.String]{},
+			expected: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
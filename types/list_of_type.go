@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.ListTypable    = ListOfType[String]{}
+	_ attr.TypeWithElementType = ListOfType[String]{}
+	_ xattr.TypeWithValidate   = ListOfType[String]{}
+)
+
+// ListOfType is an attribute type that represents a list whose elements are
+// all of the value type T, such as ListOfType[types.String]. The element type
+// is derived from the zero value of T, so the zero value of ListOfType is
+// ready to use in a schema attribute CustomType field:
+//
+//	schema.ListAttribute{
+//		CustomType: types.ListOfType[types.String]{},
+//		Required:   true,
+//	}
+//
+// T must be a concrete value type whose zero value reports full type
+// information, such as String, Int64, or another generic collection or object
+// type in this package. Use ListAttribute with ElementType for other element
+// types.
+type ListOfType[T attr.Value] struct{}
+
+// listType returns the basetypes.ListType equivalent of the type.
+func (t ListOfType[T]) listType() basetypes.ListType {
+	return basetypes.ListType{ElemType: t.ElementType()}
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// list.
+func (t ListOfType[T]) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return t.listType().ApplyTerraform5AttributePathStep(step)
+}
+
+// ElementType returns the attr.Type elements will be created from, which is
+// the type of the zero value of T.
+func (t ListOfType[T]) ElementType() attr.Type {
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	return elementTypeOf[T](context.Background())
+}
+
+// Equal returns true if the given type is also a ListOfType with the same
+// element value type.
+func (t ListOfType[T]) Equal(o attr.Type) bool {
+	_, ok := o.(ListOfType[T])
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t ListOfType[T]) String() string {
+	return "types.ListOfType[" + t.listType().ElementType().String() + "]"
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// type.
+func (t ListOfType[T]) TerraformType(ctx context.Context) tftypes.Type {
+	return t.listType().TerraformType(ctx)
+}
+
+// Validate validates all elements of the list that are of type
+// xattr.TypeWithValidate.
+func (t ListOfType[T]) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return t.listType().Validate(ctx, in, path)
+}
+
+// ValueFromList returns a ListValuable type given a basetypes.ListValue. Any
+// element values which are not T are converted into T.
+func (t ListOfType[T]) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return ListOfNull[T](), diags
+	}
+
+	if in.IsUnknown() {
+		return ListOfUnknown[T](), diags
+	}
+
+	elementType := t.ElementType()
+
+	if elementType == nil {
+		diags.Append(missingElementTypeDiag[T]())
+
+		return ListOf[T]{}, diags
+	}
+
+	inElements := in.Elements()
+	elements := make([]attr.Value, 0, len(inElements))
+
+	for _, inElement := range inElements {
+		element, elementDiags := elementValueOf[T](ctx, elementType, inElement)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return ListOfUnknown[T](), diags
+		}
+
+		elements = append(elements, element)
+	}
+
+	listValue, listDiags := basetypes.NewListValue(elementType, elements)
+
+	diags.Append(listDiags...)
+
+	if diags.HasError() {
+		return ListOfUnknown[T](), diags
+	}
+
+	return ListOf[T]{ListValue: listValue}, diags
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t ListOfType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.listType().ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := t.ValueFromList(ctx, listValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+// ValueType returns the Value type.
+func (t ListOfType[T]) ValueType(_ context.Context) attr.Value {
+	return ListOf[T]{}
+}
+
+// WithElementType returns a basetypes.ListType with the given element type.
+// The element type of a ListOfType is fixed by T, so the result is no longer a
+// ListOfType.
+func (t ListOfType[T]) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	return basetypes.ListType{ElemType: typ}
+}
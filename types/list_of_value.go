@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.ListValuable = ListOf[String]{}
+
+// ListOf is a List value whose elements are all of the value type T, such as
+// ListOf[types.String]. Use it in place of List in data model structs to
+// access elements without reflection or type assertions. The schema attribute
+// must set its CustomType field to the matching ListOfType.
+//
+// The zero value of ListOf is a null list.
+type ListOf[T attr.Value] struct {
+	basetypes.ListValue
+}
+
+// ListOfNull creates a ListOf with a null value. Determine whether the value
+// is null via the ListOf type IsNull method.
+func ListOfNull[T attr.Value]() ListOf[T] {
+	return ListOf[T]{
+		ListValue: basetypes.NewListNull(ListOfType[T]{}.ElementType()),
+	}
+}
+
+// ListOfUnknown creates a ListOf with an unknown value. Determine whether the
+// value is unknown via the ListOf type IsUnknown method.
+func ListOfUnknown[T attr.Value]() ListOf[T] {
+	return ListOf[T]{
+		ListValue: basetypes.NewListUnknown(ListOfType[T]{}.ElementType()),
+	}
+}
+
+// ListOfValue creates a ListOf with a known value. Access the value via the
+// ListOf type Elements method.
+func ListOfValue[T attr.Value](elements []T) (ListOf[T], diag.Diagnostics) {
+	elementType := ListOfType[T]{}.ElementType()
+
+	if elementType == nil {
+		return ListOf[T]{}, diag.Diagnostics{missingElementTypeDiag[T]()}
+	}
+
+	attrElements := make([]attr.Value, 0, len(elements))
+
+	for _, element := range elements {
+		attrElements = append(attrElements, element)
+	}
+
+	listValue, diags := basetypes.NewListValue(elementType, attrElements)
+
+	if diags.HasError() {
+		return ListOfUnknown[T](), diags
+	}
+
+	return ListOf[T]{ListValue: listValue}, diags
+}
+
+// ListOfValueMust creates a ListOf with a known value, converting any
+// diagnostics into a panic at runtime. Access the value via the ListOf type
+// Elements method.
+//
+// This creation function is only recommended to create ListOf values which
+// will not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func ListOfValueMust[T attr.Value](elements []T) ListOf[T] {
+	list, diags := ListOfValue(elements)
+
+	if diags.HasError() {
+		panic("ListOfValueMust received error(s): " + diagsString(diags))
+	}
+
+	return list
+}
+
+// TypedElements returns a copy of the collection of elements for the ListOf as
+// the value type T, while the embedded Elements method returns them as
+// attr.Value. Elements which are not of the value type T, such as when the
+// embedded List value was created directly, are converted through the element
+// type. An error diagnostic is returned if an element cannot be converted.
+func (v ListOf[T]) TypedElements(ctx context.Context) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elementType := ListOfType[T]{}.ElementType()
+	elements := v.ListValue.Elements()
+
+	result := make([]T, 0, len(elements))
+
+	for _, element := range elements {
+		typed, elementDiags := elementValueOf[T](ctx, elementType, element)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = append(result, typed)
+	}
+
+	return result, diags
+}
+
+// Equal returns true if the given attr.Value is also a ListOf with the same
+// element value type and the underlying List values are equal.
+func (v ListOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(ListOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.listValue().Equal(other.listValue())
+}
+
+// ToListValue returns the underlying List value.
+func (v ListOf[T]) ToListValue(_ context.Context) (basetypes.ListValue, diag.Diagnostics) {
+	return v.listValue(), nil
+}
+
+// ToTerraformValue returns the data contained in the ListOf as a
+// tftypes.Value.
+func (v ListOf[T]) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return v.listValue().ToTerraformValue(ctx)
+}
+
+// Type returns a ListOfType with the same element value type.
+func (v ListOf[T]) Type(_ context.Context) attr.Type {
+	return ListOfType[T]{}
+}
+
+// listValue returns the underlying List value, with the element type set when
+// the ListOf is its zero value.
+func (v ListOf[T]) listValue() basetypes.ListValue {
+	if v.ListValue.ElementType(context.Background()) == nil {
+		return basetypes.NewListNull(ListOfType[T]{}.ElementType())
+	}
+
+	return v.ListValue
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestMapOfTypeElementType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.TypeWithElementType
+		expected attr.Type
+	}{
+		"string": {
+			typ:      types.MapOfType[types.String]{},
+			expected: types.StringType,
+		},
+		"object-of": {
+			typ:      types.MapOfType[types.ObjectOf[testModel]]{},
+			expected: types.ObjectOfType[testModel]{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ElementType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapOfTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			typ:      types.MapOfType[types.String]{},
+			other:    types.MapOfType[types.String]{},
+			expected: true,
+		},
+		"different-element-type": {
+			typ:      types.MapOfType[types.String]{},
+			other:    types.MapOfType[types.Int64]{},
+			expected: false,
+		},
+		"map-type": {
+			typ:      types.MapOfType[types.String]{},
+			other:    types.MapType{ElemType: types.StringType},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestMapOfTypeValueFromMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            basetypes.MapValue
+		expected      basetypes.MapValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			in:       types.MapNull(testModelObjectType),
+			expected: types.MapOfNull[types.ObjectOf[testModel]](),
+		},
+		"unknown": {
+			in:       types.MapUnknown(testModelObjectType),
+			expected: types.MapOfUnknown[types.ObjectOf[testModel]](),
+		},
+		"known-converted": {
+			in: types.MapValueMust(
+				testModelObjectType,
+				map[string]attr.Value{
+					"key": types.ObjectValueMust(
+						testModelObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name": types.StringValue("one"),
+							"tags": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+						},
+					),
+				},
+			),
+			expected: types.MapOfValueMust(map[string]types.ObjectOf[testModel]{
+				"key": types.ObjectOfValueMust(context.Background(), testModel{
+					Name: types.StringValue("one"),
+					Tags: types.ListOfValueMust([]types.String{types.StringValue("a")}),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.MapOfType[types.ObjectOf[testModel]]{}.ValueFromMap(context.Background(), testCase.in)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestMapOfTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       tftypes.Value
+		expected attr.Value
+	}{
+		"known": {
+			in: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"one": tftypes.NewValue(tftypes.String, "1"),
+			}),
+			expected: types.MapOfValueMust(map[string]types.String{
+				"one": types.StringValue("1"),
+			}),
+		},
+		"null": {
+			in:       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			expected: types.MapOfNull[types.String](),
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+			expected: types.MapOfUnknown[types.String](),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := types.MapOfType[types.String]{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapOfElements(t *testing.T) {
+	t.Parallel()
+
+	// the embedded MapValue Elements method is not shadowed
+	var value interface{ Elements() map[string]attr.Value } = types.MapOfValueMust(map[string]types.String{"key": types.StringValue("one")})
+
+	if diff := cmp.Diff(value.Elements(), map[string]attr.Value{"key": types.StringValue("one")}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestMapOfTypedElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         types.MapOf[types.String]
+		expected      map[string]types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			value: types.MapOfValueMust(map[string]types.String{
+				"one": types.StringValue("1"),
+				"two": types.StringValue("2"),
+			}),
+			expected: map[string]types.String{
+				"one": types.StringValue("1"),
+				"two": types.StringValue("2"),
+			},
+		},
+		"mismatched-element": {
+			value: types.MapOf[types.String]{
+				MapValue: basetypes.NewMapValueMust(
+					types.Int64Type,
+					map[string]attr.Value{
+						"one": types.Int64Value(1),
+					},
+				),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Element Conversion Error",
+					"An unexpected error was encountered trying to convert an element value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unable to convert basetypes.Int64Value to basetypes.StringValue: "+
+						"can't unmarshal tftypes.Number into *string, expected string",
+				),
+			},
+		},
+		"null": {
+			value:    types.MapOfNull[types.String](),
+			expected: map[string]types.String{},
+		},
+		"zero": {
+			value:    types.MapOf[types.String]{},
+			expected: map[string]types.String{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.TypedElements(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapOfValue_invalidElement(t *testing.T) {
+	t.Parallel()
+
+	// An interface type parameter has no zero value to determine the element
+	// type from.
+	_, diags := types.MapOfValue(map[string]attr.Value{
+		"one": types.StringValue("1"),
+	})
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Missing Element Type",
+			"An unexpected error was encountered trying to create a collection value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"The element type cannot be determined from attr.Value. Use a concrete value type as the type parameter.",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.MapTypable     = MapOfType[String]{}
+	_ attr.TypeWithElementType = MapOfType[String]{}
+	_ xattr.TypeWithValidate   = MapOfType[String]{}
+)
+
+// MapOfType is an attribute type that represents a map whose elements are
+// all of the value type T, such as MapOfType[types.String]. The element type
+// is derived from the zero value of T, so the zero value of MapOfType is
+// ready to use in a schema attribute CustomType field:
+//
+//	schema.MapAttribute{
+//		CustomType: types.MapOfType[types.String]{},
+//		Required:   true,
+//	}
+//
+// T must be a concrete value type whose zero value reports full type
+// information, such as String, Int64, or another generic collection or object
+// type in this package. Use MapAttribute with ElementType for other element
+// types.
+type MapOfType[T attr.Value] struct{}
+
+// mapType returns the basetypes.MapType equivalent of the type.
+func (t MapOfType[T]) mapType() basetypes.MapType {
+	return basetypes.MapType{ElemType: t.ElementType()}
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// map.
+func (t MapOfType[T]) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return t.mapType().ApplyTerraform5AttributePathStep(step)
+}
+
+// ElementType returns the attr.Type elements will be created from, which is
+// the type of the zero value of T.
+func (t MapOfType[T]) ElementType() attr.Type {
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	return elementTypeOf[T](context.Background())
+}
+
+// Equal returns true if the given type is also a MapOfType with the same
+// element value type.
+func (t MapOfType[T]) Equal(o attr.Type) bool {
+	_, ok := o.(MapOfType[T])
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t MapOfType[T]) String() string {
+	return "types.MapOfType[" + t.mapType().ElementType().String() + "]"
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// type.
+func (t MapOfType[T]) TerraformType(ctx context.Context) tftypes.Type {
+	return t.mapType().TerraformType(ctx)
+}
+
+// Validate validates all elements of the map that are of type
+// xattr.TypeWithValidate.
+func (t MapOfType[T]) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return t.mapType().Validate(ctx, in, path)
+}
+
+// ValueFromMap returns a MapValuable type given a basetypes.MapValue. Any
+// element values which are not T are converted into T.
+func (t MapOfType[T]) ValueFromMap(ctx context.Context, in basetypes.MapValue) (basetypes.MapValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return MapOfNull[T](), diags
+	}
+
+	if in.IsUnknown() {
+		return MapOfUnknown[T](), diags
+	}
+
+	elementType := t.ElementType()
+
+	if elementType == nil {
+		diags.Append(missingElementTypeDiag[T]())
+
+		return MapOf[T]{}, diags
+	}
+
+	inElements := in.Elements()
+	elements := make(map[string]attr.Value, len(inElements))
+
+	for key, inElement := range inElements {
+		element, elementDiags := elementValueOf[T](ctx, elementType, inElement)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return MapOfUnknown[T](), diags
+		}
+
+		elements[key] = element
+	}
+
+	mapValue, mapDiags := basetypes.NewMapValue(elementType, elements)
+
+	diags.Append(mapDiags...)
+
+	if diags.HasError() {
+		return MapOfUnknown[T](), diags
+	}
+
+	return MapOf[T]{MapValue: mapValue}, diags
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t MapOfType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.mapType().ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mapValue, ok := attrValue.(basetypes.MapValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	mapValuable, diags := t.ValueFromMap(ctx, mapValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting MapValue to MapValuable: %v", diags)
+	}
+
+	return mapValuable, nil
+}
+
+// ValueType returns the Value type.
+func (t MapOfType[T]) ValueType(_ context.Context) attr.Value {
+	return MapOf[T]{}
+}
+
+// WithElementType returns a basetypes.MapType with the given element type.
+// The element type of a MapOfType is fixed by T, so the result is no longer a
+// MapOfType.
+func (t MapOfType[T]) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	return basetypes.MapType{ElemType: typ}
+}
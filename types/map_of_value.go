@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.MapValuable = MapOf[String]{}
+
+// MapOf is a Map value whose elements are all of the value type T, such as
+// MapOf[types.String]. Use it in place of Map in data model structs to
+// access elements without reflection or type assertions. The schema attribute
+// must set its CustomType field to the matching MapOfType.
+//
+// The zero value of MapOf is a null map.
+type MapOf[T attr.Value] struct {
+	basetypes.MapValue
+}
+
+// MapOfNull creates a MapOf with a null value. Determine whether the value
+// is null via the MapOf type IsNull method.
+func MapOfNull[T attr.Value]() MapOf[T] {
+	return MapOf[T]{
+		MapValue: basetypes.NewMapNull(MapOfType[T]{}.ElementType()),
+	}
+}
+
+// MapOfUnknown creates a MapOf with an unknown value. Determine whether the
+// value is unknown via the MapOf type IsUnknown method.
+func MapOfUnknown[T attr.Value]() MapOf[T] {
+	return MapOf[T]{
+		MapValue: basetypes.NewMapUnknown(MapOfType[T]{}.ElementType()),
+	}
+}
+
+// MapOfValue creates a MapOf with a known value. Access the value via the
+// MapOf type Elements method.
+func MapOfValue[T attr.Value](elements map[string]T) (MapOf[T], diag.Diagnostics) {
+	elementType := MapOfType[T]{}.ElementType()
+
+	if elementType == nil {
+		return MapOf[T]{}, diag.Diagnostics{missingElementTypeDiag[T]()}
+	}
+
+	attrElements := make(map[string]attr.Value, len(elements))
+
+	for key, element := range elements {
+		attrElements[key] = element
+	}
+
+	mapValue, diags := basetypes.NewMapValue(elementType, attrElements)
+
+	if diags.HasError() {
+		return MapOfUnknown[T](), diags
+	}
+
+	return MapOf[T]{MapValue: mapValue}, diags
+}
+
+// MapOfValueMust creates a MapOf with a known value, converting any
+// diagnostics into a panic at runtime. Access the value via the MapOf type
+// Elements method.
+//
+// This creation function is only recommended to create MapOf values which
+// will not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func MapOfValueMust[T attr.Value](elements map[string]T) MapOf[T] {
+	m, diags := MapOfValue(elements)
+
+	if diags.HasError() {
+		panic("MapOfValueMust received error(s): " + diagsString(diags))
+	}
+
+	return m
+}
+
+// TypedElements returns a copy of the mapping of elements for the MapOf as
+// the value type T, while the embedded Elements method returns them as
+// attr.Value. Elements which are not of the value type T, such as when the
+// embedded Map value was created directly, are converted through the element
+// type. An error diagnostic is returned if an element cannot be converted.
+func (v MapOf[T]) TypedElements(ctx context.Context) (map[string]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elementType := MapOfType[T]{}.ElementType()
+	elements := v.MapValue.Elements()
+
+	result := make(map[string]T, len(elements))
+
+	for key, element := range elements {
+		typed, elementDiags := elementValueOf[T](ctx, elementType, element)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result[key] = typed
+	}
+
+	return result, diags
+}
+
+// Equal returns true if the given attr.Value is also a MapOf with the same
+// element value type and the underlying Map values are equal.
+func (v MapOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(MapOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.mapValue().Equal(other.mapValue())
+}
+
+// ToMapValue returns the underlying Map value.
+func (v MapOf[T]) ToMapValue(_ context.Context) (basetypes.MapValue, diag.Diagnostics) {
+	return v.mapValue(), nil
+}
+
+// ToTerraformValue returns the data contained in the MapOf as a
+// tftypes.Value.
+func (v MapOf[T]) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return v.mapValue().ToTerraformValue(ctx)
+}
+
+// Type returns a MapOfType with the same element value type.
+func (v MapOf[T]) Type(_ context.Context) attr.Type {
+	return MapOfType[T]{}
+}
+
+// mapValue returns the underlying Map value, with the element type set when
+// the MapOf is its zero value.
+func (v MapOf[T]) mapValue() basetypes.MapValue {
+	if v.MapValue.ElementType(context.Background()) == nil {
+		return basetypes.NewMapNull(MapOfType[T]{}.ElementType())
+	}
+
+	return v.MapValue
+}
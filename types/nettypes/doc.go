@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package nettypes contains custom string types for networking data, such as
+// IPv4 and IPv6 addresses and prefixes in CIDR notation.
+//
+// Each type validates its values and defines semantic equality logic based on
+// the parsed netip.Addr or netip.Prefix, so different textual representations
+// of the same value, such as "::1" and "0:0:0:0:0:0:0:1", are ignored. Set the
+// CustomType field of a schema string attribute to IPv4AddressType{},
+// IPv6AddressType{}, IPAddressType{}, or IPPrefixType{} to use them.
+package nettypes
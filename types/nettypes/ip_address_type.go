@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = IPAddressType{}
+	_ xattr.TypeWithValidate  = IPAddressType{}
+)
+
+// IPAddressType is an attribute type that represents a valid IPv4 or IPv6
+// address string, such as "192.0.2.1" or "2001:db8::1". Semantic equality logic
+// is defined for IPAddressType such that addresses are compared by their parsed
+// netip.Addr value, so different textual representations of the same address
+// are considered equal, such as "::1" and "0:0:0:0:0:0:0:1".
+type IPAddressType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IPAddressType) String() string {
+	return "nettypes.IPAddressType"
+}
+
+// ValueType returns the Value type.
+func (t IPAddressType) ValueType(_ context.Context) attr.Value {
+	return IPAddress{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate implements type validation. This type requires the value provided to
+// be a String value that is a valid IPv4 or IPv6 address string, such as
+// "192.0.2.1" or "2001:db8::1".
+func (t IPAddressType) Validate(_ context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	return validateString(in, valuePath, "IPAddress", "IP Address", func(value string) error {
+		_, err := netip.ParseAddr(value)
+
+		return err
+	})
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPAddress{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t IPAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = IPAddress{}
+)
+
+// IPAddress represents a valid IPv4 or IPv6 address string, such as "192.0.2.1"
+// or "2001:db8::1". Semantic equality logic is defined for IPAddress such that
+// addresses are compared by their parsed netip.Addr value, so different textual
+// representations of the same address are considered equal, such as "::1" and
+// "0:0:0:0:0:0:0:1".
+type IPAddress struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPAddressType.
+func (v IPAddress) Type(_ context.Context) attr.Type {
+	return IPAddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given string represents the same
+// address as the current value, even if there are differences in
+// formatting.
+func (v IPAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddress)
+
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+
+		return false, diags
+	}
+
+	priorAddr, err := netip.ParseAddr(v.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	newAddr, err := netip.ParseAddr(newValue.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	return priorAddr == newAddr, diags
+}
+
+// ValueAddr parses the known string value into a netip.Addr. A null or unknown
+// value will produce an error diagnostic.
+func (v IPAddress) ValueAddr() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("IPAddress ValueAddr Error", "IP Address string value is null")
+
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("IPAddress ValueAddr Error", "IP Address string value is unknown")
+
+		return netip.Addr{}, diags
+	}
+
+	addr, err := netip.ParseAddr(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPAddress ValueAddr Error",
+			"An unexpected error occurred while parsing the IP Address string value. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// NewIPAddressNull creates an IPAddress with a null value. Determine whether
+// the value is null via IsNull method.
+func NewIPAddressNull() IPAddress {
+	return IPAddress{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPAddressUnknown creates an IPAddress with an unknown value. Determine
+// whether the value is unknown via IsUnknown method.
+func NewIPAddressUnknown() IPAddress {
+	return IPAddress{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPAddressValue creates an IPAddress with a known value. The value is not
+// validated, use NewIPAddressValueFromAddr to ensure a valid value. Access the
+// value via ValueString or ValueAddr methods.
+func NewIPAddressValue(value string) IPAddress {
+	return IPAddress{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPAddressPointerValue creates an IPAddress with a null value if nil or a
+// known value. Access the value via ValueStringPointer or ValueAddr methods.
+func NewIPAddressPointerValue(value *string) IPAddress {
+	return IPAddress{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// NewIPAddressValueFromAddr creates an IPAddress with a known value formatted
+// from the given netip.Addr. Access the value via ValueString or ValueAddr
+// methods.
+func NewIPAddressValueFromAddr(value netip.Addr) IPAddress {
+	return NewIPAddressValue(value.String())
+}
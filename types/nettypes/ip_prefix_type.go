@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = IPPrefixType{}
+	_ xattr.TypeWithValidate  = IPPrefixType{}
+)
+
+// IPPrefixType is an attribute type that represents a valid IPv4 or IPv6 prefix
+// string in CIDR notation, such as "10.0.0.0/8" or "2001:db8::/32". Semantic
+// equality logic is defined for IPPrefixType such that prefixes are compared by
+// their parsed netip.Prefix value, so different textual representations of the
+// same prefix are considered equal, such as "2001:db8::/32" and
+// "2001:0db8:0:0::/32".
+type IPPrefixType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IPPrefixType) String() string {
+	return "nettypes.IPPrefixType"
+}
+
+// ValueType returns the Value type.
+func (t IPPrefixType) ValueType(_ context.Context) attr.Value {
+	return IPPrefix{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPPrefixType) Equal(o attr.Type) bool {
+	other, ok := o.(IPPrefixType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate implements type validation. This type requires the value provided to
+// be a String value that is a valid IPv4 or IPv6 prefix string in CIDR
+// notation, such as "10.0.0.0/8" or "2001:db8::/32".
+func (t IPPrefixType) Validate(_ context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	return validateString(in, valuePath, "IPPrefix", "IP Prefix", func(value string) error {
+		_, err := netip.ParsePrefix(value)
+
+		return err
+	})
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPPrefixType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPPrefix{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t IPPrefixType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestIPPrefixTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			in: tftypes.Value{},
+		},
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"valid-0": {
+			in: tftypes.NewValue(tftypes.String, "10.0.0.0/8"),
+		},
+		"valid-1": {
+			in: tftypes.NewValue(tftypes.String, "2001:db8::/32"),
+		},
+		"invalid-0": {
+			in: tftypes.NewValue(tftypes.String, "10.0.0.0"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IP Prefix String Value",
+					"A string value was provided that is not valid IP Prefix string format.\n\n"+
+						"Given Value: 10.0.0.0\n"+
+						"Error: netip.ParsePrefix(\"10.0.0.0\"): no '/'",
+				),
+			},
+		},
+		"invalid-1": {
+			in: tftypes.NewValue(tftypes.String, "10.0.0.0/33"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IP Prefix String Value",
+					"A string value was provided that is not valid IP Prefix string format.\n\n"+
+						"Given Value: 10.0.0.0/33\n"+
+						"Error: netip.ParsePrefix(\"10.0.0.0/33\"): prefix length out of range",
+				),
+			},
+		},
+		"wrong-value-type": {
+			in: tftypes.NewValue(tftypes.Number, 123),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"IPPrefix Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"123\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := nettypes.IPPrefixType{}.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(testCase.expectedDiags, diags); diff != "" {
+				t.Errorf("unexpected diagnostics (+got, -expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPPrefixTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"value": {
+			in:          tftypes.NewValue(tftypes.String, "10.0.0.0/8"),
+			expectation: nettypes.NewIPPrefixValue("10.0.0.0/8"),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: nettypes.NewIPPrefixUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.String, nil),
+			expectation: nettypes.NewIPPrefixNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := nettypes.IPPrefixType{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(testCase.expectation, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = IPPrefix{}
+)
+
+// IPPrefix represents a valid IPv4 or IPv6 prefix string in CIDR notation, such
+// as "10.0.0.0/8" or "2001:db8::/32". Semantic equality logic is defined for
+// IPPrefix such that prefixes are compared by their parsed netip.Prefix value,
+// so different textual representations of the same prefix are considered equal,
+// such as "2001:db8::/32" and "2001:0db8:0:0::/32".
+type IPPrefix struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPPrefixType.
+func (v IPPrefix) Type(_ context.Context) attr.Type {
+	return IPPrefixType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPPrefix) Equal(o attr.Value) bool {
+	other, ok := o.(IPPrefix)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given string represents the same
+// prefix as the current value, even if there are differences in
+// formatting.
+func (v IPPrefix) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPPrefix)
+
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+
+		return false, diags
+	}
+
+	priorPrefix, err := netip.ParsePrefix(v.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	newPrefix, err := netip.ParsePrefix(newValue.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	return priorPrefix == newPrefix, diags
+}
+
+// ValuePrefix parses the known string value into a netip.Prefix. A null or
+// unknown value will produce an error diagnostic.
+func (v IPPrefix) ValuePrefix() (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("IPPrefix ValuePrefix Error", "IP Prefix string value is null")
+
+		return netip.Prefix{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("IPPrefix ValuePrefix Error", "IP Prefix string value is unknown")
+
+		return netip.Prefix{}, diags
+	}
+
+	prefix, err := netip.ParsePrefix(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPPrefix ValuePrefix Error",
+			"An unexpected error occurred while parsing the IP Prefix string value. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Prefix{}, diags
+	}
+
+	return prefix, diags
+}
+
+// NewIPPrefixNull creates an IPPrefix with a null value. Determine whether the
+// value is null via IsNull method.
+func NewIPPrefixNull() IPPrefix {
+	return IPPrefix{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPPrefixUnknown creates an IPPrefix with an unknown value. Determine
+// whether the value is unknown via IsUnknown method.
+func NewIPPrefixUnknown() IPPrefix {
+	return IPPrefix{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPPrefixValue creates an IPPrefix with a known value. The value is not
+// validated, use NewIPPrefixValueFromPrefix to ensure a valid value. Access the
+// value via ValueString or ValuePrefix methods.
+func NewIPPrefixValue(value string) IPPrefix {
+	return IPPrefix{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPPrefixPointerValue creates an IPPrefix with a null value if nil or a
+// known value. Access the value via ValueStringPointer or ValuePrefix methods.
+func NewIPPrefixPointerValue(value *string) IPPrefix {
+	return IPPrefix{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// NewIPPrefixValueFromPrefix creates an IPPrefix with a known value formatted
+// from the given netip.Prefix. Access the value via ValueString or ValuePrefix
+// methods.
+func NewIPPrefixValueFromPrefix(value netip.Prefix) IPPrefix {
+	return NewIPPrefixValue(value.String())
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestIPPrefixStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  nettypes.IPPrefix
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"equal-0": {
+			currentValue:  nettypes.NewIPPrefixValue("2001:db8::/32"),
+			givenValue:    nettypes.NewIPPrefixValue("2001:0db8:0:0::/32"),
+			expectedMatch: true,
+		},
+		"not-equal-1": {
+			currentValue:  nettypes.NewIPPrefixValue("10.0.0.0/8"),
+			givenValue:    nettypes.NewIPPrefixValue("10.0.0.0/16"),
+			expectedMatch: false,
+		},
+		"wrong-value-type": {
+			currentValue:  nettypes.NewIPPrefixValue("10.0.0.0/8"),
+			givenValue:    basetypes.NewStringValue("10.0.0.0/8"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: nettypes.IPPrefix\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"invalid": {
+			currentValue:  nettypes.NewIPPrefixValue("10.0.0.0/8"),
+			givenValue:    nettypes.NewIPPrefixValue("invalid"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: netip.ParsePrefix(\"invalid\"): no '/'",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPPrefixValuePrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         nettypes.IPPrefix
+		expected      netip.Prefix
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    nettypes.NewIPPrefixValue("10.0.0.0/8"),
+			expected: netip.MustParsePrefix("10.0.0.0/8"),
+		},
+		"null": {
+			value: nettypes.NewIPPrefixNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("IPPrefix ValuePrefix Error", "IP Prefix string value is null"),
+			},
+		},
+		"unknown": {
+			value: nettypes.NewIPPrefixUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("IPPrefix ValuePrefix Error", "IP Prefix string value is unknown"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValuePrefix()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewIPPrefixValueFromPrefix(t *testing.T) {
+	t.Parallel()
+
+	got := nettypes.NewIPPrefixValueFromPrefix(netip.MustParsePrefix("10.0.0.0/8"))
+
+	if diff := cmp.Diff(got, nettypes.NewIPPrefixValue(netip.MustParsePrefix("10.0.0.0/8").String())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestIPv4AddressTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			in: tftypes.Value{},
+		},
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"valid-0": {
+			in: tftypes.NewValue(tftypes.String, "192.0.2.1"),
+		},
+		"valid-1": {
+			in: tftypes.NewValue(tftypes.String, "0.0.0.0"),
+		},
+		"invalid-0": {
+			in: tftypes.NewValue(tftypes.String, "2001:db8::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Address String Value",
+					"A string value was provided that is not valid IPv4 Address string format.\n\n"+
+						"Given Value: 2001:db8::1\n"+
+						"Error: 2001:db8::1 is not an IPv4 address",
+				),
+			},
+		},
+		"invalid-1": {
+			in: tftypes.NewValue(tftypes.String, "192.0.2.256"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Address String Value",
+					"A string value was provided that is not valid IPv4 Address string format.\n\n"+
+						"Given Value: 192.0.2.256\n"+
+						"Error: ParseAddr(\"192.0.2.256\"): IPv4 field has value >255",
+				),
+			},
+		},
+		"invalid-2": {
+			in: tftypes.NewValue(tftypes.String, "192.0.2.0/24"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Address String Value",
+					"A string value was provided that is not valid IPv4 Address string format.\n\n"+
+						"Given Value: 192.0.2.0/24\n"+
+						"Error: ParseAddr(\"192.0.2.0/24\"): unexpected character (at \"/24\")",
+				),
+			},
+		},
+		"wrong-value-type": {
+			in: tftypes.NewValue(tftypes.Number, 123),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"IPv4Address Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"123\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := nettypes.IPv4AddressType{}.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(testCase.expectedDiags, diags); diff != "" {
+				t.Errorf("unexpected diagnostics (+got, -expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4AddressTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"value": {
+			in:          tftypes.NewValue(tftypes.String, "192.0.2.1"),
+			expectation: nettypes.NewIPv4AddressValue("192.0.2.1"),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: nettypes.NewIPv4AddressUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.String, nil),
+			expectation: nettypes.NewIPv4AddressNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := nettypes.IPv4AddressType{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(testCase.expectation, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
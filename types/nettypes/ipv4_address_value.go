@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = IPv4Address{}
+)
+
+// IPv4Address represents a valid IPv4 address string, such as "192.0.2.1".
+// Semantic equality logic is defined for IPv4Address such that
+// addresses are compared by their parsed netip.Addr value.
+type IPv4Address struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPv4AddressType.
+func (v IPv4Address) Type(_ context.Context) attr.Type {
+	return IPv4AddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv4Address) Equal(o attr.Value) bool {
+	other, ok := o.(IPv4Address)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given string represents the same
+// address as the current value, even if there are differences in
+// formatting.
+func (v IPv4Address) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv4Address)
+
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+
+		return false, diags
+	}
+
+	priorAddr, err := netip.ParseAddr(v.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	newAddr, err := netip.ParseAddr(newValue.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	return priorAddr == newAddr, diags
+}
+
+// ValueAddr parses the known string value into a netip.Addr. A null or unknown
+// value will produce an error diagnostic.
+func (v IPv4Address) ValueAddr() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("IPv4Address ValueAddr Error", "IPv4 Address string value is null")
+
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("IPv4Address ValueAddr Error", "IPv4 Address string value is unknown")
+
+		return netip.Addr{}, diags
+	}
+
+	addr, err := netip.ParseAddr(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPv4Address ValueAddr Error",
+			"An unexpected error occurred while parsing the IPv4 Address string value. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// NewIPv4AddressNull creates an IPv4Address with a null value. Determine
+// whether the value is null via IsNull method.
+func NewIPv4AddressNull() IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv4AddressUnknown creates an IPv4Address with an unknown value. Determine
+// whether the value is unknown via IsUnknown method.
+func NewIPv4AddressUnknown() IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv4AddressValue creates an IPv4Address with a known value. The value is
+// not validated, use NewIPv4AddressValueFromAddr to ensure a valid value.
+// Access the value via ValueString or ValueAddr methods.
+func NewIPv4AddressValue(value string) IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv4AddressPointerValue creates an IPv4Address with a null value if nil or
+// a known value. Access the value via ValueStringPointer or ValueAddr methods.
+func NewIPv4AddressPointerValue(value *string) IPv4Address {
+	return IPv4Address{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// NewIPv4AddressValueFromAddr creates an IPv4Address with a known value
+// formatted from the given netip.Addr. Access the value via ValueString or
+// ValueAddr methods.
+func NewIPv4AddressValueFromAddr(value netip.Addr) IPv4Address {
+	return NewIPv4AddressValue(value.String())
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestIPv4AddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  nettypes.IPv4Address
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"equal-0": {
+			currentValue:  nettypes.NewIPv4AddressValue("192.0.2.1"),
+			givenValue:    nettypes.NewIPv4AddressValue("192.0.2.1"),
+			expectedMatch: true,
+		},
+		"not-equal-1": {
+			currentValue:  nettypes.NewIPv4AddressValue("192.0.2.1"),
+			givenValue:    nettypes.NewIPv4AddressValue("192.0.2.2"),
+			expectedMatch: false,
+		},
+		"wrong-value-type": {
+			currentValue:  nettypes.NewIPv4AddressValue("192.0.2.1"),
+			givenValue:    basetypes.NewStringValue("192.0.2.1"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: nettypes.IPv4Address\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"invalid": {
+			currentValue:  nettypes.NewIPv4AddressValue("192.0.2.1"),
+			givenValue:    nettypes.NewIPv4AddressValue("invalid"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: ParseAddr(\"invalid\"): unable to parse IP",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4AddressValueAddr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         nettypes.IPv4Address
+		expected      netip.Addr
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    nettypes.NewIPv4AddressValue("192.0.2.1"),
+			expected: netip.MustParseAddr("192.0.2.1"),
+		},
+		"null": {
+			value: nettypes.NewIPv4AddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("IPv4Address ValueAddr Error", "IPv4 Address string value is null"),
+			},
+		},
+		"unknown": {
+			value: nettypes.NewIPv4AddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("IPv4Address ValueAddr Error", "IPv4 Address string value is unknown"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueAddr()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewIPv4AddressValueFromAddr(t *testing.T) {
+	t.Parallel()
+
+	got := nettypes.NewIPv4AddressValueFromAddr(netip.MustParseAddr("192.0.2.1"))
+
+	if diff := cmp.Diff(got, nettypes.NewIPv4AddressValue(netip.MustParseAddr("192.0.2.1").String())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
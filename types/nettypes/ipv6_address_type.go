@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = IPv6AddressType{}
+	_ xattr.TypeWithValidate  = IPv6AddressType{}
+)
+
+// IPv6AddressType is an attribute type that represents a valid IPv6 address
+// string, such as "2001:db8::1". Semantic equality logic is defined for
+// IPv6AddressType such that addresses are compared by their parsed netip.Addr
+// value, so different textual representations of the same address are
+// considered equal, such as "::1" and "0:0:0:0:0:0:0:1".
+type IPv6AddressType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IPv6AddressType) String() string {
+	return "nettypes.IPv6AddressType"
+}
+
+// ValueType returns the Value type.
+func (t IPv6AddressType) ValueType(_ context.Context) attr.Value {
+	return IPv6Address{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPv6AddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPv6AddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate implements type validation. This type requires the value provided to
+// be a String value that is a valid IPv6 address string, such as "2001:db8::1".
+func (t IPv6AddressType) Validate(_ context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	return validateString(in, valuePath, "IPv6Address", "IPv6 Address", func(value string) error {
+		addr, err := netip.ParseAddr(value)
+
+		if err != nil {
+			return err
+		}
+
+		if !addr.Is6() {
+			return fmt.Errorf("%s is not an IPv6 address", value)
+		}
+
+		return nil
+	})
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPv6AddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPv6Address{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t IPv6AddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = IPv6Address{}
+)
+
+// IPv6Address represents a valid IPv6 address string, such as "2001:db8::1".
+// Semantic equality logic is defined for IPv6Address such that addresses are
+// compared by their parsed netip.Addr value, so different textual
+// representations of the same address are considered equal, such as "::1" and
+// "0:0:0:0:0:0:0:1".
+type IPv6Address struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPv6AddressType.
+func (v IPv6Address) Type(_ context.Context) attr.Type {
+	return IPv6AddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv6Address) Equal(o attr.Value) bool {
+	other, ok := o.(IPv6Address)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given string represents the same
+// address as the current value, even if there are differences in
+// formatting.
+func (v IPv6Address) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv6Address)
+
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+
+		return false, diags
+	}
+
+	priorAddr, err := netip.ParseAddr(v.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	newAddr, err := netip.ParseAddr(newValue.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	return priorAddr == newAddr, diags
+}
+
+// ValueAddr parses the known string value into a netip.Addr. A null or unknown
+// value will produce an error diagnostic.
+func (v IPv6Address) ValueAddr() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("IPv6Address ValueAddr Error", "IPv6 Address string value is null")
+
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("IPv6Address ValueAddr Error", "IPv6 Address string value is unknown")
+
+		return netip.Addr{}, diags
+	}
+
+	addr, err := netip.ParseAddr(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"IPv6Address ValueAddr Error",
+			"An unexpected error occurred while parsing the IPv6 Address string value. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// NewIPv6AddressNull creates an IPv6Address with a null value. Determine
+// whether the value is null via IsNull method.
+func NewIPv6AddressNull() IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv6AddressUnknown creates an IPv6Address with an unknown value. Determine
+// whether the value is unknown via IsUnknown method.
+func NewIPv6AddressUnknown() IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv6AddressValue creates an IPv6Address with a known value. The value is
+// not validated, use NewIPv6AddressValueFromAddr to ensure a valid value.
+// Access the value via ValueString or ValueAddr methods.
+func NewIPv6AddressValue(value string) IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv6AddressPointerValue creates an IPv6Address with a null value if nil or
+// a known value. Access the value via ValueStringPointer or ValueAddr methods.
+func NewIPv6AddressPointerValue(value *string) IPv6Address {
+	return IPv6Address{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// NewIPv6AddressValueFromAddr creates an IPv6Address with a known value
+// formatted from the given netip.Addr. Access the value via ValueString or
+// ValueAddr methods.
+func NewIPv6AddressValueFromAddr(value netip.Addr) IPv6Address {
+	return NewIPv6AddressValue(value.String())
+}
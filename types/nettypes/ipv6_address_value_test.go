@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestIPv6AddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  nettypes.IPv6Address
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"equal-0": {
+			currentValue:  nettypes.NewIPv6AddressValue("::1"),
+			givenValue:    nettypes.NewIPv6AddressValue("0:0:0:0:0:0:0:1"),
+			expectedMatch: true,
+		},
+		"equal-1": {
+			currentValue:  nettypes.NewIPv6AddressValue("2001:db8::1"),
+			givenValue:    nettypes.NewIPv6AddressValue("2001:DB8:0:0:0:0:0:1"),
+			expectedMatch: true,
+		},
+		"not-equal-2": {
+			currentValue:  nettypes.NewIPv6AddressValue("2001:db8::1"),
+			givenValue:    nettypes.NewIPv6AddressValue("2001:db8::2"),
+			expectedMatch: false,
+		},
+		"wrong-value-type": {
+			currentValue:  nettypes.NewIPv6AddressValue("2001:db8::1"),
+			givenValue:    basetypes.NewStringValue("2001:db8::1"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: nettypes.IPv6Address\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"invalid": {
+			currentValue:  nettypes.NewIPv6AddressValue("2001:db8::1"),
+			givenValue:    nettypes.NewIPv6AddressValue("invalid"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: ParseAddr(\"invalid\"): unable to parse IP",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6AddressValueAddr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         nettypes.IPv6Address
+		expected      netip.Addr
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    nettypes.NewIPv6AddressValue("2001:DB8::1"),
+			expected: netip.MustParseAddr("2001:db8::1"),
+		},
+		"null": {
+			value: nettypes.NewIPv6AddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("IPv6Address ValueAddr Error", "IPv6 Address string value is null"),
+			},
+		},
+		"unknown": {
+			value: nettypes.NewIPv6AddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("IPv6Address ValueAddr Error", "IPv6 Address string value is unknown"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueAddr()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewIPv6AddressValueFromAddr(t *testing.T) {
+	t.Parallel()
+
+	got := nettypes.NewIPv6AddressValueFromAddr(netip.MustParseAddr("2001:db8::1"))
+
+	if diff := cmp.Diff(got, nettypes.NewIPv6AddressValue(netip.MustParseAddr("2001:db8::1").String())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nettypes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// validateString returns error diagnostics if the given Terraform value is a
+// known string which the parse function cannot parse. The typeName is used in
+// the summary of unexpected error diagnostics and the formatName in the
+// summary and detail of invalid value diagnostics.
+func validateString(in tftypes.Value, valuePath path.Path, typeName string, formatName string, parse func(string) error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.String) {
+		diags.AddAttributeError(
+			valuePath,
+			typeName+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			typeName+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+
+		return diags
+	}
+
+	if err := parse(value); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid "+formatName+" String Value",
+			"A string value was provided that is not valid "+formatName+" string format.\n\n"+
+				"Given Value: "+value+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// semanticEqualityTypeError returns the error diagnostic for a semantic
+// equality check between incompatible value types.
+func semanticEqualityTypeError(expected any, got any) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Semantic Equality Check Error",
+		"An unexpected value type was received while performing semantic equality checks. "+
+			"Please report this to the provider developers.\n\n"+
+			"Expected Value Type: "+fmt.Sprintf("%T", expected)+"\n"+
+			"Got Value Type: "+fmt.Sprintf("%T", got),
+	)
+}
+
+// semanticEqualityParseError returns the error diagnostic for a semantic
+// equality check where a value could not be parsed.
+func semanticEqualityParseError(err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Semantic Equality Check Error",
+		"An unexpected error occurred while performing semantic equality checks. "+
+			"Please report this to the provider developers.\n\n"+
+			"Error: "+err.Error(),
+	)
+}
@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type testModel struct {
+	Name    types.String               `tfsdk:"name"`
+	Tags    types.ListOf[types.String] `tfsdk:"tags"`
+	Ignored string                     `tfsdk:"-"`
+}
+
+type testInvalidModel struct {
+	Name string `tfsdk:"name"`
+}
+
+var (
+	testModelObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+			"tags": types.ListType{ElemType: types.StringType},
+		},
+	}
+
+	testModelTerraformType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+)
+
+func TestObjectOfTypeAttributeTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.TypeWithAttributeTypes
+		expected map[string]attr.Type
+	}{
+		"model": {
+			typ: types.ObjectOfType[testModel]{},
+			expected: map[string]attr.Type{
+				"name": types.StringType,
+				"tags": types.ListOfType[types.String]{},
+			},
+		},
+		"invalid-model": {
+			typ:      types.ObjectOfType[testInvalidModel]{},
+			expected: map[string]attr.Type{},
+		},
+		"not-a-struct": {
+			typ:      types.ObjectOfType[string]{},
+			expected: map[string]attr.Type{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.AttributeTypes()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectOfTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			typ:      types.ObjectOfType[testModel]{},
+			other:    types.ObjectOfType[testModel]{},
+			expected: true,
+		},
+		"different-struct": {
+			typ:      types.ObjectOfType[testModel]{},
+			other:    types.ObjectOfType[testInvalidModel]{},
+			expected: false,
+		},
+		"object-type": {
+			typ:      types.ObjectOfType[testModel]{},
+			other:    testModelObjectType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestObjectOfTypeString(t *testing.T) {
+	t.Parallel()
+
+	got := types.ObjectOfType[testModel]{}.String()
+	expected := "types.ObjectOfType[types_test.testModel]"
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestObjectOfTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	got := types.ObjectOfType[testModel]{}.TerraformType(context.Background())
+
+	if diff := cmp.Diff(got, testModelTerraformType); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestObjectOfTypeValueFromObject(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            basetypes.ObjectValue
+		expected      basetypes.ObjectValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			in:       types.ObjectNull(testModelObjectType.AttrTypes),
+			expected: types.ObjectOfNull[testModel](),
+		},
+		"unknown": {
+			in:       types.ObjectUnknown(testModelObjectType.AttrTypes),
+			expected: types.ObjectOfUnknown[testModel](),
+		},
+		"known-converted": {
+			in: types.ObjectValueMust(
+				testModelObjectType.AttrTypes,
+				map[string]attr.Value{
+					"name": types.StringValue("one"),
+					"tags": types.ListNull(types.StringType),
+				},
+			),
+			expected: types.ObjectOfValueMust(context.Background(), testModel{
+				Name: types.StringValue("one"),
+				Tags: types.ListOfNull[types.String](),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.ObjectOfType[testModel]{}.ValueFromObject(context.Background(), testCase.in)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestObjectOfTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         attr.Type
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr bool
+	}{
+		"known": {
+			typ: types.ObjectOfType[testModel]{},
+			in: tftypes.NewValue(testModelTerraformType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "one"),
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+				}),
+			}),
+			expected: types.ObjectOfValueMust(context.Background(), testModel{
+				Name: types.StringValue("one"),
+				Tags: types.ListOfValueMust([]types.String{types.StringValue("a")}),
+			}),
+		},
+		"null": {
+			typ:      types.ObjectOfType[testModel]{},
+			in:       tftypes.NewValue(testModelTerraformType, nil),
+			expected: types.ObjectOfNull[testModel](),
+		},
+		"invalid-model": {
+			typ: types.ObjectOfType[testInvalidModel]{},
+			in: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, nil),
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if !testCase.expectedErr {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr {
+				t.Fatal("expected error, got none")
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectOfValue_invalidModel(t *testing.T) {
+	t.Parallel()
+
+	_, diags := types.ObjectOfValue(context.Background(), testInvalidModel{Name: "one"})
+
+	if !diags.HasError() {
+		t.Fatal("expected error diagnostics, got none")
+	}
+}
+
+func TestObjectOfModel(t *testing.T) {
+	t.Parallel()
+
+	expected := testModel{
+		Name: types.StringValue("one"),
+		Tags: types.ListOfValueMust([]types.String{types.StringValue("a"), types.StringValue("b")}),
+	}
+
+	value := types.ObjectOfValueMust(context.Background(), expected)
+
+	got, diags := value.Model(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if _, diags := types.ObjectOfNull[testModel]().Model(context.Background()); !diags.HasError() {
+		t.Error("expected error diagnostics for null value, got none")
+	}
+}
+
+func TestObjectOfToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	got, err := types.ObjectOf[testModel]{}.ToTerraformValue(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(testModelTerraformType, nil)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestObjectOf_resourceSchema(t *testing.T) {
+	t.Parallel()
+
+	type resourceModel struct {
+		Names types.ListOf[types.String]             `tfsdk:"names"`
+		Items types.MapOf[types.ObjectOf[testModel]] `tfsdk:"items"`
+	}
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				CustomType: types.ListOfType[types.String]{},
+				Optional:   true,
+			},
+			"items": schema.MapNestedAttribute{
+				CustomType: types.MapOfType[types.ObjectOf[testModel]]{},
+				NestedObject: schema.NestedAttributeObject{
+					CustomType: types.ObjectOfType[testModel]{},
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"tags": schema.ListAttribute{
+							CustomType: types.ListOfType[types.String]{},
+							Optional:   true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	raw := tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"names": tftypes.List{ElementType: tftypes.String},
+				"items": tftypes.Map{ElementType: testModelTerraformType},
+			},
+		},
+		map[string]tftypes.Value{
+			"names": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+			"items": tftypes.NewValue(tftypes.Map{ElementType: testModelTerraformType}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(testModelTerraformType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "item"),
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				}),
+			}),
+		},
+	)
+
+	state := tfsdk.State{
+		Raw:    raw,
+		Schema: resourceSchema,
+	}
+
+	var model resourceModel
+
+	diags := state.Get(context.Background(), &model)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics getting state: %v", diags)
+	}
+
+	names, diags := model.Names.TypedElements(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics getting names: %v", diags)
+	}
+
+	if diff := cmp.Diff(names, []types.String{types.StringValue("one")}); diff != "" {
+		t.Errorf("unexpected names difference: %s", diff)
+	}
+
+	items, diags := model.Items.TypedElements(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics getting items: %v", diags)
+	}
+
+	item, diags := items["key"].Model(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics getting item: %v", diags)
+	}
+
+	expectedItem := testModel{
+		Name: types.StringValue("item"),
+		Tags: types.ListOfNull[types.String](),
+	}
+
+	if diff := cmp.Diff(item, expectedItem); diff != "" {
+		t.Errorf("unexpected item difference: %s", diff)
+	}
+
+	newState := tfsdk.State{
+		Raw:    tftypes.NewValue(raw.Type(), nil),
+		Schema: resourceSchema,
+	}
+
+	diags = newState.Set(context.Background(), model)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics setting state: %v", diags)
+	}
+
+	if diff := cmp.Diff(newState.Raw, raw); diff != "" {
+		t.Errorf("unexpected state difference: %s", diff)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.ObjectTypable     = ObjectOfType[struct{}]{}
+	_ attr.TypeWithAttributeTypes = ObjectOfType[struct{}]{}
+)
+
+// ObjectOfType is an attribute type that represents an object whose
+// attributes are described by the struct type T, such as ObjectOfType[Model].
+// The attribute types are derived from the "tfsdk" struct tags and field types
+// of T, so the zero value of ObjectOfType is ready to use in a schema
+// attribute CustomType field:
+//
+//	type Model struct {
+//		Name types.String `tfsdk:"name"`
+//	}
+//
+//	schema.ObjectAttribute{
+//		CustomType: types.ObjectOfType[Model]{},
+//		Required:   true,
+//	}
+//
+// Every tagged field of T must be a concrete value type whose zero value
+// reports full type information, such as String, Int64, or a generic
+// collection or object type in this package.
+type ObjectOfType[T any] struct{}
+
+// objectType returns the basetypes.ObjectType equivalent of the type.
+func (t ObjectOfType[T]) objectType() basetypes.ObjectType {
+	return basetypes.ObjectType{AttrTypes: t.AttributeTypes()}
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// object.
+func (t ObjectOfType[T]) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return t.objectType().ApplyTerraform5AttributePathStep(step)
+}
+
+// AttributeTypes returns the attribute types derived from T. Fields which
+// cannot be converted into an attribute type are omitted, which causes
+// ValueFromTerraform to return an error.
+func (t ObjectOfType[T]) AttributeTypes() map[string]attr.Type {
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	attrTypes, _ := attributeTypesOf[T](context.Background())
+
+	return attrTypes
+}
+
+// Equal returns true if the given type is also an ObjectOfType with the same
+// struct type.
+func (t ObjectOfType[T]) Equal(o attr.Type) bool {
+	_, ok := o.(ObjectOfType[T])
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t ObjectOfType[T]) String() string {
+	var zero T
+
+	return fmt.Sprintf("types.ObjectOfType[%T]", zero)
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// type.
+func (t ObjectOfType[T]) TerraformType(ctx context.Context) tftypes.Type {
+	return t.objectType().TerraformType(ctx)
+}
+
+// ValueFromObject returns an ObjectValuable type given a
+// basetypes.ObjectValue. Objects with attribute value types that differ from
+// those derived from T are converted.
+func (t ObjectOfType[T]) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrTypes, attrTypesDiags := attributeTypesOf[T](ctx)
+
+	diags.Append(attrTypesDiags...)
+
+	if diags.HasError() {
+		return ObjectOfUnknown[T](), diags
+	}
+
+	if in.IsNull() {
+		return ObjectOfNull[T](), diags
+	}
+
+	if in.IsUnknown() {
+		return ObjectOfUnknown[T](), diags
+	}
+
+	if in.Type(ctx).Equal(basetypes.ObjectType{AttrTypes: attrTypes}) {
+		return ObjectOf[T]{ObjectValue: in}, diags
+	}
+
+	value, valueDiags := elementValueOf[ObjectOf[T]](ctx, t, in)
+
+	diags.Append(valueDiags...)
+
+	if diags.HasError() {
+		return ObjectOfUnknown[T](), diags
+	}
+
+	return value, diags
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t ObjectOfType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	_, diags := attributeTypesOf[T](ctx)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to determine attribute types of %s: %v", t, diags)
+	}
+
+	attrValue, err := t.objectType().ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	objectValue, ok := attrValue.(basetypes.ObjectValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return ObjectOf[T]{ObjectValue: objectValue}, nil
+}
+
+// ValueType returns the Value type.
+func (t ObjectOfType[T]) ValueType(_ context.Context) attr.Value {
+	return ObjectOf[T]{}
+}
+
+// WithAttributeTypes returns a basetypes.ObjectType with the given attribute
+// types. The attribute types of an ObjectOfType are fixed by T, so the result
+// is no longer an ObjectOfType.
+func (t ObjectOfType[T]) WithAttributeTypes(typs map[string]attr.Type) attr.TypeWithAttributeTypes {
+	return basetypes.ObjectType{AttrTypes: typs}
+}
+
+// attributeTypesOf returns the attribute types of the struct type T, using
+// the "tfsdk" struct tags for attribute names and the type of the zero value
+// of each field for attribute types.
+func attributeTypesOf[T any](ctx context.Context) (map[string]attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	attrTypes := make(map[string]attr.Type)
+
+	if structType.Kind() != reflect.Struct {
+		diags.AddError(
+			"Invalid Object Type",
+			"An unexpected error was encountered trying to determine object attribute types. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("%s must be a struct type.", structType),
+		)
+
+		return attrTypes, diags
+	}
+
+	fields, err := refl.StructTags(ctx, structType, path.Empty())
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Object Type",
+			"An unexpected error was encountered trying to determine object attribute types. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Unable to read struct tags of %s: %s", structType, err),
+		)
+
+		return attrTypes, diags
+	}
+
+	attrValueType := reflect.TypeOf((*attr.Value)(nil)).Elem()
+
+	for name, index := range fields {
+		field := structType.FieldByIndex(index)
+
+		if field.Type.Kind() == reflect.Interface || field.Type.Kind() == reflect.Pointer || !field.Type.Implements(attrValueType) {
+			diags.AddError(
+				"Invalid Object Type",
+				"An unexpected error was encountered trying to determine object attribute types. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("%s field %s of type %s must be a concrete attr.Value type.", structType, field.Name, field.Type),
+			)
+
+			continue
+		}
+
+		attrTypes[name] = reflect.Zero(field.Type).Interface().(attr.Value).Type(ctx)
+	}
+
+	return attrTypes, diags
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.ObjectValuable = ObjectOf[struct{}]{}
+
+// ObjectOf is an Object value whose attributes are described by the struct
+// type T, such as ObjectOf[Model]. Use it in place of Object in data model
+// structs, or as the element value type of ListOf, SetOf, and MapOf, to carry
+// full type information in the data model. The schema attribute must set its
+// CustomType field to the matching ObjectOfType.
+//
+// The zero value of ObjectOf is a null object.
+type ObjectOf[T any] struct {
+	basetypes.ObjectValue
+}
+
+// ObjectOfNull creates an ObjectOf with a null value. Determine whether the
+// value is null via the ObjectOf type IsNull method.
+func ObjectOfNull[T any]() ObjectOf[T] {
+	return ObjectOf[T]{
+		ObjectValue: basetypes.NewObjectNull(ObjectOfType[T]{}.AttributeTypes()),
+	}
+}
+
+// ObjectOfUnknown creates an ObjectOf with an unknown value. Determine whether
+// the value is unknown via the ObjectOf type IsUnknown method.
+func ObjectOfUnknown[T any]() ObjectOf[T] {
+	return ObjectOf[T]{
+		ObjectValue: basetypes.NewObjectUnknown(ObjectOfType[T]{}.AttributeTypes()),
+	}
+}
+
+// ObjectOfValue creates an ObjectOf with a known value from the given struct,
+// using reflection rules. Access the value via the ObjectOf type Model method.
+func ObjectOfValue[T any](ctx context.Context, model T) (ObjectOf[T], diag.Diagnostics) {
+	attrTypes, diags := attributeTypesOf[T](ctx)
+
+	if diags.HasError() {
+		return ObjectOfUnknown[T](), diags
+	}
+
+	objectValue, objectDiags := basetypes.NewObjectValueFrom(ctx, attrTypes, model)
+
+	diags.Append(objectDiags...)
+
+	if diags.HasError() {
+		return ObjectOfUnknown[T](), diags
+	}
+
+	return ObjectOf[T]{ObjectValue: objectValue}, diags
+}
+
+// ObjectOfValueMust creates an ObjectOf with a known value from the given
+// struct, converting any diagnostics into a panic at runtime. Access the value
+// via the ObjectOf type Model method.
+//
+// This creation function is only recommended to create ObjectOf values which
+// will not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func ObjectOfValueMust[T any](ctx context.Context, model T) ObjectOf[T] {
+	object, diags := ObjectOfValue(ctx, model)
+
+	if diags.HasError() {
+		panic("ObjectOfValueMust received error(s): " + diagsString(diags))
+	}
+
+	return object
+}
+
+// Equal returns true if the given attr.Value is also an ObjectOf with the same
+// struct type and the underlying Object values are equal.
+func (v ObjectOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(ObjectOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.objectValue().Equal(other.objectValue())
+}
+
+// Model returns the object attributes as the struct type T. An error
+// diagnostic is returned if the ObjectOf is null or unknown.
+func (v ObjectOf[T]) Model(ctx context.Context) (T, diag.Diagnostics) {
+	var model T
+
+	diags := v.objectValue().As(ctx, &model, basetypes.ObjectAsOptions{})
+
+	return model, diags
+}
+
+// ToObjectValue returns the underlying Object value.
+func (v ObjectOf[T]) ToObjectValue(_ context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	return v.objectValue(), nil
+}
+
+// ToTerraformValue returns the data contained in the ObjectOf as a
+// tftypes.Value.
+func (v ObjectOf[T]) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return v.objectValue().ToTerraformValue(ctx)
+}
+
+// Type returns an ObjectOfType with the same struct type.
+func (v ObjectOf[T]) Type(_ context.Context) attr.Type {
+	return ObjectOfType[T]{}
+}
+
+// objectValue returns the underlying Object value, with the attribute types
+// set when the ObjectOf is its zero value.
+func (v ObjectOf[T]) objectValue() basetypes.ObjectValue {
+	if v.ObjectValue.IsNull() && len(v.ObjectValue.AttributeTypes(context.Background())) == 0 {
+		return ObjectOfNull[T]().ObjectValue
+	}
+
+	return v.ObjectValue
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestSetOfTypeElementType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.TypeWithElementType
+		expected attr.Type
+	}{
+		"string": {
+			typ:      types.SetOfType[types.String]{},
+			expected: types.StringType,
+		},
+		"set-of-int64": {
+			typ:      types.SetOfType[types.SetOf[types.Int64]]{},
+			expected: types.SetOfType[types.Int64]{},
+		},
+		"object-of": {
+			typ:      types.SetOfType[types.ObjectOf[testModel]]{},
+			expected: types.ObjectOfType[testModel]{},
+		},
+		"interface": {
+			typ:      types.SetOfType[attr.Value]{},
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ElementType()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetOfTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			typ:      types.SetOfType[types.String]{},
+			other:    types.SetOfType[types.String]{},
+			expected: true,
+		},
+		"different-element-type": {
+			typ:      types.SetOfType[types.String]{},
+			other:    types.SetOfType[types.Int64]{},
+			expected: false,
+		},
+		"set-type": {
+			typ:      types.SetOfType[types.String]{},
+			other:    types.SetType{ElemType: types.StringType},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetOfTypeString(t *testing.T) {
+	t.Parallel()
+
+	got := types.SetOfType[types.String]{}.String()
+	expected := "types.SetOfType[basetypes.StringType]"
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSetOfTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	got := types.SetOfType[types.ObjectOf[testModel]]{}.TerraformType(context.Background())
+	expected := tftypes.Set{ElementType: testModelTerraformType}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSetOfTypeValueFromSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            basetypes.SetValue
+		expected      basetypes.SetValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			in:       types.SetNull(testModelObjectType),
+			expected: types.SetOfNull[types.ObjectOf[testModel]](),
+		},
+		"unknown": {
+			in:       types.SetUnknown(testModelObjectType),
+			expected: types.SetOfUnknown[types.ObjectOf[testModel]](),
+		},
+		"known-converted": {
+			in: types.SetValueMust(
+				testModelObjectType,
+				[]attr.Value{
+					types.ObjectValueMust(
+						testModelObjectType.AttrTypes,
+						map[string]attr.Value{
+							"name": types.StringValue("one"),
+							"tags": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+						},
+					),
+				},
+			),
+			expected: types.SetOfValueMust([]types.ObjectOf[testModel]{
+				types.ObjectOfValueMust(context.Background(), testModel{
+					Name: types.StringValue("one"),
+					Tags: types.ListOfValueMust([]types.String{types.StringValue("a")}),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := types.SetOfType[types.ObjectOf[testModel]]{}.ValueFromSet(context.Background(), testCase.in)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetOfTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"known": {
+			in: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+			expected: types.SetOfValueMust([]types.String{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+		},
+		"null": {
+			in:       tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			expected: types.SetOfNull[types.String](),
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+			expected: types.SetOfUnknown[types.String](),
+		},
+		"wrong-type": {
+			in:          tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, nil),
+			expectedErr: "can't use tftypes.Set[tftypes.Number]<null> as value of Set with ElementType basetypes.StringType, can only use tftypes.String values",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := types.SetOfType[types.String]{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetOfValue(t *testing.T) {
+	t.Parallel()
+
+	got, diags := types.SetOfValue([]types.String{types.StringValue("one")})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")})
+
+	setValue, diags := got.ToSetValue(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !setValue.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, setValue)
+	}
+}
+
+func TestSetOfElements(t *testing.T) {
+	t.Parallel()
+
+	// the embedded SetValue Elements method is not shadowed
+	var value interface{ Elements() []attr.Value } = types.SetOfValueMust([]types.String{types.StringValue("one")})
+
+	if diff := cmp.Diff(value.Elements(), []attr.Value{types.StringValue("one")}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSetOfTypedElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         types.SetOf[types.String]
+		expected      []types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			value: types.SetOfValueMust([]types.String{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+			expected: []types.String{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			},
+		},
+		"mismatched-element": {
+			value: types.SetOf[types.String]{
+				SetValue: basetypes.NewSetValueMust(
+					types.Int64Type,
+					[]attr.Value{
+						types.Int64Value(1),
+					},
+				),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Element Conversion Error",
+					"An unexpected error was encountered trying to convert an element value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unable to convert basetypes.Int64Value to basetypes.StringValue: "+
+						"can't unmarshal tftypes.Number into *string, expected string",
+				),
+			},
+		},
+		"null": {
+			value:    types.SetOfNull[types.String](),
+			expected: []types.String{},
+		},
+		"zero": {
+			value:    types.SetOf[types.String]{},
+			expected: []types.String{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.TypedElements(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetOfEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.SetOf[types.String]
+		other    attr.Value
+		expected bool
+	}{
+		"equal": {
+			value:    types.SetOfValueMust([]types.String{types.StringValue("one")}),
+			other:    types.SetOfValueMust([]types.String{types.StringValue("one")}),
+			expected: true,
+		},
+		"different-elements": {
+			value:    types.SetOfValueMust([]types.String{types.StringValue("one")}),
+			other:    types.SetOfValueMust([]types.String{types.StringValue("two")}),
+			expected: false,
+		},
+		"zero-null": {
+			value:    types.SetOf[types.String]{},
+			other:    types.SetOfNull[types.String](),
+			expected: true,
+		},
+		"set": {
+			value:    types.SetOfValueMust([]types.String{types.StringValue("one")}),
+			other:    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("one")}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetOfToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    types.SetOf[types.String]
+		expected tftypes.Value
+	}{
+		"known": {
+			value: types.SetOfValueMust([]types.String{types.StringValue("one")}),
+			expected: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+		},
+		"zero": {
+			value:    types.SetOf[types.String]{},
+			expected: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.SetTypable     = SetOfType[String]{}
+	_ attr.TypeWithElementType = SetOfType[String]{}
+	_ xattr.TypeWithValidate   = SetOfType[String]{}
+)
+
+// SetOfType is an attribute type that represents a set whose elements are
+// all of the value type T, such as SetOfType[types.String]. The element type
+// is derived from the zero value of T, so the zero value of SetOfType is
+// ready to use in a schema attribute CustomType field:
+//
+//	schema.SetAttribute{
+//		CustomType: types.SetOfType[types.String]{},
+//		Required:   true,
+//	}
+//
+// T must be a concrete value type whose zero value reports full type
+// information, such as String, Int64, or another generic collection or object
+// type in this package. Use SetAttribute with ElementType for other element
+// types.
+type SetOfType[T attr.Value] struct{}
+
+// setType returns the basetypes.SetType equivalent of the type.
+func (t SetOfType[T]) setType() basetypes.SetType {
+	return basetypes.SetType{ElemType: t.ElementType()}
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// set.
+func (t SetOfType[T]) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return t.setType().ApplyTerraform5AttributePathStep(step)
+}
+
+// ElementType returns the attr.Type elements will be created from, which is
+// the type of the zero value of T.
+func (t SetOfType[T]) ElementType() attr.Type {
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	return elementTypeOf[T](context.Background())
+}
+
+// Equal returns true if the given type is also a SetOfType with the same
+// element value type.
+func (t SetOfType[T]) Equal(o attr.Type) bool {
+	_, ok := o.(SetOfType[T])
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t SetOfType[T]) String() string {
+	return "types.SetOfType[" + t.setType().ElementType().String() + "]"
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// type.
+func (t SetOfType[T]) TerraformType(ctx context.Context) tftypes.Type {
+	return t.setType().TerraformType(ctx)
+}
+
+// Validate validates all elements of the set that are of type
+// xattr.TypeWithValidate.
+func (t SetOfType[T]) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return t.setType().Validate(ctx, in, path)
+}
+
+// ValueFromSet returns a SetValuable type given a basetypes.SetValue. Any
+// element values which are not T are converted into T.
+func (t SetOfType[T]) ValueFromSet(ctx context.Context, in basetypes.SetValue) (basetypes.SetValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return SetOfNull[T](), diags
+	}
+
+	if in.IsUnknown() {
+		return SetOfUnknown[T](), diags
+	}
+
+	elementType := t.ElementType()
+
+	if elementType == nil {
+		diags.Append(missingElementTypeDiag[T]())
+
+		return SetOf[T]{}, diags
+	}
+
+	inElements := in.Elements()
+	elements := make([]attr.Value, 0, len(inElements))
+
+	for _, inElement := range inElements {
+		element, elementDiags := elementValueOf[T](ctx, elementType, inElement)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return SetOfUnknown[T](), diags
+		}
+
+		elements = append(elements, element)
+	}
+
+	setValue, setDiags := basetypes.NewSetValue(elementType, elements)
+
+	diags.Append(setDiags...)
+
+	if diags.HasError() {
+		return SetOfUnknown[T](), diags
+	}
+
+	return SetOf[T]{SetValue: setValue}, diags
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t SetOfType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.setType().ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	setValue, ok := attrValue.(basetypes.SetValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	setValuable, diags := t.ValueFromSet(ctx, setValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting SetValue to SetValuable: %v", diags)
+	}
+
+	return setValuable, nil
+}
+
+// ValueType returns the Value type.
+func (t SetOfType[T]) ValueType(_ context.Context) attr.Value {
+	return SetOf[T]{}
+}
+
+// WithElementType returns a basetypes.SetType with the given element type.
+// The element type of a SetOfType is fixed by T, so the result is no longer a
+// SetOfType.
+func (t SetOfType[T]) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	return basetypes.SetType{ElemType: typ}
+}
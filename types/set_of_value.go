@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.SetValuable = SetOf[String]{}
+
+// SetOf is a Set value whose elements are all of the value type T, such as
+// SetOf[types.String]. Use it in place of Set in data model structs to
+// access elements without reflection or type assertions. The schema attribute
+// must set its CustomType field to the matching SetOfType.
+//
+// The zero value of SetOf is a null set.
+type SetOf[T attr.Value] struct {
+	basetypes.SetValue
+}
+
+// SetOfNull creates a SetOf with a null value. Determine whether the value
+// is null via the SetOf type IsNull method.
+func SetOfNull[T attr.Value]() SetOf[T] {
+	return SetOf[T]{
+		SetValue: basetypes.NewSetNull(SetOfType[T]{}.ElementType()),
+	}
+}
+
+// SetOfUnknown creates a SetOf with an unknown value. Determine whether the
+// value is unknown via the SetOf type IsUnknown method.
+func SetOfUnknown[T attr.Value]() SetOf[T] {
+	return SetOf[T]{
+		SetValue: basetypes.NewSetUnknown(SetOfType[T]{}.ElementType()),
+	}
+}
+
+// SetOfValue creates a SetOf with a known value. Access the value via the
+// SetOf type Elements method.
+func SetOfValue[T attr.Value](elements []T) (SetOf[T], diag.Diagnostics) {
+	elementType := SetOfType[T]{}.ElementType()
+
+	if elementType == nil {
+		return SetOf[T]{}, diag.Diagnostics{missingElementTypeDiag[T]()}
+	}
+
+	attrElements := make([]attr.Value, 0, len(elements))
+
+	for _, element := range elements {
+		attrElements = append(attrElements, element)
+	}
+
+	setValue, diags := basetypes.NewSetValue(elementType, attrElements)
+
+	if diags.HasError() {
+		return SetOfUnknown[T](), diags
+	}
+
+	return SetOf[T]{SetValue: setValue}, diags
+}
+
+// SetOfValueMust creates a SetOf with a known value, converting any
+// diagnostics into a panic at runtime. Access the value via the SetOf type
+// Elements method.
+//
+// This creation function is only recommended to create SetOf values which
+// will not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func SetOfValueMust[T attr.Value](elements []T) SetOf[T] {
+	set, diags := SetOfValue(elements)
+
+	if diags.HasError() {
+		panic("SetOfValueMust received error(s): " + diagsString(diags))
+	}
+
+	return set
+}
+
+// TypedElements returns a copy of the collection of elements for the SetOf as
+// the value type T, while the embedded Elements method returns them as
+// attr.Value. Elements which are not of the value type T, such as when the
+// embedded Set value was created directly, are converted through the element
+// type. An error diagnostic is returned if an element cannot be converted.
+func (v SetOf[T]) TypedElements(ctx context.Context) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elementType := SetOfType[T]{}.ElementType()
+	elements := v.SetValue.Elements()
+
+	result := make([]T, 0, len(elements))
+
+	for _, element := range elements {
+		typed, elementDiags := elementValueOf[T](ctx, elementType, element)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = append(result, typed)
+	}
+
+	return result, diags
+}
+
+// Equal returns true if the given attr.Value is also a SetOf with the same
+// element value type and the underlying Set values are equal.
+func (v SetOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(SetOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.setValue().Equal(other.setValue())
+}
+
+// ToSetValue returns the underlying Set value.
+func (v SetOf[T]) ToSetValue(_ context.Context) (basetypes.SetValue, diag.Diagnostics) {
+	return v.setValue(), nil
+}
+
+// ToTerraformValue returns the data contained in the SetOf as a
+// tftypes.Value.
+func (v SetOf[T]) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return v.setValue().ToTerraformValue(ctx)
+}
+
+// Type returns a SetOfType with the same element value type.
+func (v SetOf[T]) Type(_ context.Context) attr.Type {
+	return SetOfType[T]{}
+}
+
+// setValue returns the underlying Set value, with the element type set when
+// the SetOf is its zero value.
+func (v SetOf[T]) setValue() basetypes.SetValue {
+	if v.SetValue.ElementType(context.Background()) == nil {
+		return basetypes.NewSetNull(SetOfType[T]{}.ElementType())
+	}
+
+	return v.SetValue
+}
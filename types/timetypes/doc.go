@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timetypes contains custom string types for time data, such as RFC
+// 3339 timestamps and Go durations.
+//
+// Each type validates its values and defines semantic equality logic based on
+// the parsed time.Time or time.Duration, so inconsequential differences, such
+// as "Z" versus "+00:00" or "1h" versus "60m", are ignored. Set the
+// CustomType field of a schema string attribute, or a function string
+// parameter or return, to RFC3339Type{} or GoDurationType{} to use them.
+package timetypes
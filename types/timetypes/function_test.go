@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestFunctionParameterAndReturn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	parameter := function.StringParameter{
+		CustomType: timetypes.RFC3339Type{},
+	}
+
+	if diff := cmp.Diff(parameter.GetType(), timetypes.RFC3339Type{}); diff != "" {
+		t.Errorf("unexpected parameter type difference: %s", diff)
+	}
+
+	arguments := function.NewArgumentsData([]attr.Value{
+		timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+		timetypes.NewGoDurationValue("90m"),
+	})
+
+	var timestamp timetypes.RFC3339
+	var duration timetypes.GoDuration
+
+	if funcErr := arguments.Get(ctx, &timestamp, &duration); funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+
+	start, diags := timestamp.ValueTime()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	offset, diags := duration.ValueDuration()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	result, funcErr := function.StringReturn{
+		CustomType: timetypes.RFC3339Type{},
+	}.NewResultData(ctx)
+
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+
+	if funcErr := result.Set(ctx, timetypes.NewRFC3339ValueFromTime(start.Add(offset))); funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+
+	expected := timetypes.NewRFC3339ValueFromTime(time.Date(2024, 1, 1, 1, 30, 0, 0, time.UTC))
+
+	if diff := cmp.Diff(result.Value(), expected); diff != "" {
+		t.Errorf("unexpected result difference: %s", diff)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = GoDurationType{}
+	_ xattr.TypeWithValidate  = GoDurationType{}
+)
+
+// GoDurationType is an attribute type that represents a valid Go duration
+// string, such as "1h30m", as defined by time.ParseDuration. Semantic
+// equality logic is defined for GoDurationType such that durations of the
+// same length are considered equal, such as "1h" and "60m".
+type GoDurationType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t GoDurationType) String() string {
+	return "timetypes.GoDurationType"
+}
+
+// ValueType returns the Value type.
+func (t GoDurationType) ValueType(_ context.Context) attr.Value {
+	return GoDuration{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t GoDurationType) Equal(o attr.Type) bool {
+	other, ok := o.(GoDurationType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate implements type validation. This type requires the value provided
+// to be a String value that is a valid Go duration.
+func (t GoDurationType) Validate(_ context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	return validateString(in, valuePath, "GoDuration", "Go Duration", func(value string) error {
+		_, err := time.ParseDuration(value)
+
+		return err
+	})
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t GoDurationType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return GoDuration{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t GoDurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
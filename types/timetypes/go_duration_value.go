@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = GoDuration{}
+)
+
+// GoDuration represents a valid Go duration string, such as "1h30m", as
+// defined by time.ParseDuration. Semantic equality logic is defined for
+// GoDuration such that durations of the same length are considered equal.
+type GoDuration struct {
+	basetypes.StringValue
+}
+
+// Type returns a GoDurationType.
+func (v GoDuration) Type(_ context.Context) attr.Type {
+	return GoDurationType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v GoDuration) Equal(o attr.Value) bool {
+	other, ok := o.(GoDuration)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given Go duration string has the
+// same length as the current value, even if there are differences in
+// formatting, such as "1h" and "60m".
+func (v GoDuration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(GoDuration)
+
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+
+		return false, diags
+	}
+
+	priorDuration, err := time.ParseDuration(v.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	newDuration, err := time.ParseDuration(newValue.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	return priorDuration == newDuration, diags
+}
+
+// ValueDuration parses the known Go duration string into a time.Duration. A
+// null or unknown value will produce an error diagnostic.
+func (v GoDuration) ValueDuration() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("GoDuration ValueDuration Error", "Go duration string value is null")
+
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("GoDuration ValueDuration Error", "Go duration string value is unknown")
+
+		return 0, diags
+	}
+
+	d, err := time.ParseDuration(v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"GoDuration ValueDuration Error",
+			"An unexpected error occurred while parsing the Go duration string value. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	return d, diags
+}
+
+// NewGoDurationNull creates a GoDuration with a null value. Determine whether
+// the value is null via IsNull method.
+func NewGoDurationNull() GoDuration {
+	return GoDuration{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewGoDurationUnknown creates a GoDuration with an unknown value. Determine
+// whether the value is unknown via IsUnknown method.
+func NewGoDurationUnknown() GoDuration {
+	return GoDuration{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewGoDurationValue creates a GoDuration with a known value. The value is
+// not validated, use NewGoDurationValueFromDuration to ensure a valid value.
+// Access the value via ValueString or ValueDuration methods.
+func NewGoDurationValue(value string) GoDuration {
+	return GoDuration{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewGoDurationPointerValue creates a GoDuration with a null value if nil or
+// a known value. Access the value via ValueStringPointer or ValueDuration
+// methods.
+func NewGoDurationPointerValue(value *string) GoDuration {
+	return GoDuration{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// NewGoDurationValueFromDuration creates a GoDuration with a known value
+// formatted from the given time.Duration. Access the value via ValueString or
+// ValueDuration methods.
+func NewGoDurationValueFromDuration(value time.Duration) GoDuration {
+	return NewGoDurationValue(value.String())
+}
+
+// NewGoDurationPointerValueFromDuration creates a GoDuration with a null value if
+// nil or a known value formatted from the given time.Duration.
+func NewGoDurationPointerValueFromDuration(value *time.Duration) GoDuration {
+	if value == nil {
+		return NewGoDurationNull()
+	}
+
+	return NewGoDurationValueFromDuration(*value)
+}
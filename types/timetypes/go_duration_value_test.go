@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestGoDurationStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  timetypes.GoDuration
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			currentValue:  timetypes.NewGoDurationValue("1h"),
+			givenValue:    timetypes.NewGoDurationValue("1h"),
+			expectedMatch: true,
+		},
+		"different-units": {
+			currentValue:  timetypes.NewGoDurationValue("1h"),
+			givenValue:    timetypes.NewGoDurationValue("60m"),
+			expectedMatch: true,
+		},
+		"canonical": {
+			currentValue:  timetypes.NewGoDurationValue("90s"),
+			givenValue:    timetypes.NewGoDurationValue("1m30s"),
+			expectedMatch: true,
+		},
+		"different-length": {
+			currentValue:  timetypes.NewGoDurationValue("1h"),
+			givenValue:    timetypes.NewGoDurationValue("61m"),
+			expectedMatch: false,
+		},
+		"wrong-value-type": {
+			currentValue:  timetypes.NewGoDurationValue("1h"),
+			givenValue:    basetypes.NewStringValue("1h"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.GoDuration\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"invalid": {
+			currentValue:  timetypes.NewGoDurationValue("1h"),
+			givenValue:    timetypes.NewGoDurationValue("1d"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: time: unknown unit \"d\" in duration \"1d\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationValueDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.GoDuration
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    timetypes.NewGoDurationValue("1h30m"),
+			expected: 90 * time.Minute,
+		},
+		"null": {
+			value: timetypes.NewGoDurationNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("GoDuration ValueDuration Error", "Go duration string value is null"),
+			},
+		},
+		"unknown": {
+			value: timetypes.NewGoDurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("GoDuration ValueDuration Error", "Go duration string value is unknown"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueDuration()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewGoDurationValueFromDuration(t *testing.T) {
+	t.Parallel()
+
+	value := 90 * time.Second
+
+	if got := timetypes.NewGoDurationValueFromDuration(value); got.ValueString() != "1m30s" {
+		t.Errorf("expected %q, got %q", "1m30s", got.ValueString())
+	}
+
+	if got := timetypes.NewGoDurationPointerValueFromDuration(nil); !got.IsNull() {
+		t.Errorf("expected null value, got %s", got)
+	}
+
+	if got := timetypes.NewGoDurationPointerValueFromDuration(&value); !got.Equal(timetypes.NewGoDurationValue("1m30s")) {
+		t.Errorf("expected known value, got %s", got)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = RFC3339Type{}
+	_ xattr.TypeWithValidate  = RFC3339Type{}
+)
+
+// RFC3339Type is an attribute type that represents a valid RFC 3339 timestamp
+// string, such as "2006-01-02T15:04:05Z". Semantic equality logic is defined
+// for RFC3339Type such that timestamps representing the same instant are
+// considered equal, such as "2024-01-01T00:00:00Z" and
+// "2024-01-01T00:00:00+00:00".
+type RFC3339Type struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t RFC3339Type) String() string {
+	return "timetypes.RFC3339Type"
+}
+
+// ValueType returns the Value type.
+func (t RFC3339Type) ValueType(_ context.Context) attr.Value {
+	return RFC3339{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t RFC3339Type) Equal(o attr.Type) bool {
+	other, ok := o.(RFC3339Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate implements type validation. This type requires the value provided
+// to be a String value that is a valid RFC 3339 timestamp.
+func (t RFC3339Type) Validate(_ context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	return validateString(in, valuePath, "RFC3339", "RFC 3339", func(value string) error {
+		_, err := time.Parse(time.RFC3339, value)
+
+		return err
+	})
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t RFC3339Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RFC3339{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+func (t RFC3339Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = RFC3339{}
+)
+
+// RFC3339 represents a valid RFC 3339 timestamp string, such as
+// "2006-01-02T15:04:05Z". Semantic equality logic is defined for RFC3339
+// such that timestamps representing the same instant are considered equal.
+type RFC3339 struct {
+	basetypes.StringValue
+}
+
+// Type returns an RFC3339Type.
+func (v RFC3339) Type(_ context.Context) attr.Type {
+	return RFC3339Type{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v RFC3339) Equal(o attr.Value) bool {
+	other, ok := o.(RFC3339)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given RFC 3339 timestamp string
+// represents the same instant as the current value, even if there are
+// differences in formatting, such as the time zone offset.
+func (v RFC3339) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RFC3339)
+
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+
+		return false, diags
+	}
+
+	priorTime, err := time.Parse(time.RFC3339, v.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	newTime, err := time.Parse(time.RFC3339, newValue.ValueString())
+
+	if err != nil {
+		diags.Append(semanticEqualityParseError(err))
+
+		return false, diags
+	}
+
+	return priorTime.Equal(newTime), diags
+}
+
+// ValueTime parses the known RFC 3339 timestamp string into a time.Time. A
+// null or unknown value will produce an error diagnostic.
+func (v RFC3339) ValueTime() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.AddError("RFC3339 ValueTime Error", "RFC3339 string value is null")
+
+		return time.Time{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddError("RFC3339 ValueTime Error", "RFC3339 string value is unknown")
+
+		return time.Time{}, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"RFC3339 ValueTime Error",
+			"An unexpected error occurred while parsing the RFC 3339 string value. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return time.Time{}, diags
+	}
+
+	return t, diags
+}
+
+// NewRFC3339Null creates an RFC3339 with a null value. Determine whether the
+// value is null via IsNull method.
+func NewRFC3339Null() RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewRFC3339Unknown creates an RFC3339 with an unknown value. Determine
+// whether the value is unknown via IsUnknown method.
+func NewRFC3339Unknown() RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewRFC3339Value creates an RFC3339 with a known value. The value is not
+// validated, use NewRFC3339ValueFromTime to ensure a valid value. Access the
+// value via ValueString or ValueTime methods.
+func NewRFC3339Value(value string) RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewRFC3339PointerValue creates an RFC3339 with a null value if nil or a
+// known value. Access the value via ValueStringPointer or ValueTime methods.
+func NewRFC3339PointerValue(value *string) RFC3339 {
+	return RFC3339{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// NewRFC3339ValueFromTime creates an RFC3339 with a known value formatted from
+// the given time.Time. Access the value via ValueString or ValueTime methods.
+func NewRFC3339ValueFromTime(value time.Time) RFC3339 {
+	return NewRFC3339Value(value.Format(time.RFC3339Nano))
+}
+
+// NewRFC3339PointerValueFromTime creates an RFC3339 with a null value if nil or a
+// known value formatted from the given time.Time.
+func NewRFC3339PointerValueFromTime(value *time.Time) RFC3339 {
+	if value == nil {
+		return NewRFC3339Null()
+	}
+
+	return NewRFC3339ValueFromTime(*value)
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestRFC3339StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  timetypes.RFC3339
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"exact-match": {
+			currentValue:  timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+			givenValue:    timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+			expectedMatch: true,
+		},
+		"utc-offset": {
+			currentValue:  timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+			givenValue:    timetypes.NewRFC3339Value("2024-01-01T00:00:00+00:00"),
+			expectedMatch: true,
+		},
+		"different-offset-same-instant": {
+			currentValue:  timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+			givenValue:    timetypes.NewRFC3339Value("2024-01-01T05:30:00+05:30"),
+			expectedMatch: true,
+		},
+		"fractional-zero": {
+			currentValue:  timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+			givenValue:    timetypes.NewRFC3339Value("2024-01-01T00:00:00.000Z"),
+			expectedMatch: true,
+		},
+		"different-instant": {
+			currentValue:  timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+			givenValue:    timetypes.NewRFC3339Value("2024-01-01T00:00:01Z"),
+			expectedMatch: false,
+		},
+		"wrong-value-type": {
+			currentValue:  timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+			givenValue:    basetypes.NewStringValue("2024-01-01T00:00:00Z"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RFC3339\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"invalid": {
+			currentValue:  timetypes.NewRFC3339Value("2024-01-01T00:00:00Z"),
+			givenValue:    timetypes.NewRFC3339Value("not-a-timestamp"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: parsing time \"not-a-timestamp\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"not-a-timestamp\" as \"2006\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339ValueTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.RFC3339
+		expected      time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			value:    timetypes.NewRFC3339Value("2024-01-02T03:04:05Z"),
+			expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		"null": {
+			value: timetypes.NewRFC3339Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RFC3339 ValueTime Error", "RFC3339 string value is null"),
+			},
+		},
+		"unknown": {
+			value: timetypes.NewRFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("RFC3339 ValueTime Error", "RFC3339 string value is unknown"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueTime()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewRFC3339ValueFromTime(t *testing.T) {
+	t.Parallel()
+
+	value := time.Date(2024, 1, 2, 3, 4, 5, 600, time.FixedZone("test", 3600))
+
+	got := timetypes.NewRFC3339ValueFromTime(value)
+
+	if expected := "2024-01-02T03:04:05.0000006+01:00"; got.ValueString() != expected {
+		t.Errorf("expected %q, got %q", expected, got.ValueString())
+	}
+
+	roundTrip, diags := got.ValueTime()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !roundTrip.Equal(value) {
+		t.Errorf("expected %s, got %s", value, roundTrip)
+	}
+
+	if got := timetypes.NewRFC3339PointerValueFromTime(nil); !got.IsNull() {
+		t.Errorf("expected null value, got %s", got)
+	}
+
+	if got := timetypes.NewRFC3339PointerValueFromTime(&value); !got.Equal(timetypes.NewRFC3339ValueFromTime(value)) {
+		t.Errorf("expected known value, got %s", got)
+	}
+}